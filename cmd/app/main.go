@@ -28,6 +28,12 @@ func main() {
 		application.RMQServer.MustRun()
 	}()
 
+	go func() {
+		application.Scheduler.Run()
+	}()
+
+	application.Subscriber.MustRun()
+
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
@@ -35,10 +41,13 @@ func main() {
 	select {
 	case <-stop:
 	case <-application.RMQServer.Notify():
+	case <-application.Subscriber.Notify():
 	}
 
 	log.Info("Starting graceful shutdown")
 
+	application.Streams.Close()
+
 	if err := application.HTTPServer.Shutdown(); err != nil {
 		log.Error("HTTPServer.Shutdown error", sl.Err(err))
 	}
@@ -47,6 +56,18 @@ func main() {
 		log.Error("RMQServer.Shutdown error", sl.Err(err))
 	}
 
+	if err := application.Scheduler.Shutdown(); err != nil {
+		log.Error("Scheduler.Shutdown error", sl.Err(err))
+	}
+
+	if err := application.Subscriber.Shutdown(); err != nil {
+		log.Error("Subscriber.Shutdown error", sl.Err(err))
+	}
+
+	if err := application.Publisher.Close(); err != nil {
+		log.Error("Publisher.Close error", sl.Err(err))
+	}
+
 	if err := application.DB.Close(); err != nil {
 		log.Error("Close db connection error", sl.Err(err))
 	}
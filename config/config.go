@@ -16,24 +16,38 @@ const (
 
 type (
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		PG   `yaml:"pg"`
-		RMQ  `yaml:"rabbitmq"`
-		Log  `yaml:"logger"`
+		App       `yaml:"app"`
+		HTTP      `yaml:"http"`
+		PG        `yaml:"pg"`
+		RMQ       `yaml:"rabbitmq"`
+		Log       `yaml:"logger"`
+		Funding   `yaml:"funding"`
+		Fees      `yaml:"fees"`
+		Auth      `yaml:"auth"`
+		RateLimit `yaml:"rateLimit"`
+		Webhooks  `yaml:"webhooks"`
+		Outbox    `yaml:"outbox"`
+
+		Reconciliation `yaml:"reconciliation"`
 	}
 
 	App struct {
-		Name           string        `env:"APP_NAME"            env-default:"wallet-rielta" yaml:"name"`
-		Version        string        `env:"APP_VERSION"         env-default:"1.0.0"         yaml:"version"`
-		CountWorkers   int           `env:"APP_WORKERS"         env-default:"24"            yaml:"workers"`
-		Timeout        time.Duration `env:"APP_TIMEOUT"         env-default:"5s"            yaml:"timeout"`
-		DefaultBalance uint          `env:"APP_DEFAULT_BALANCE" env-default:"100"           yaml:"defaultBalance"`
+		Name             string        `env:"APP_NAME"              env-default:"wallet-rielta" yaml:"name"`
+		Version          string        `env:"APP_VERSION"           env-default:"1.0.0"         yaml:"version"`
+		CountWorkers     int           `env:"APP_WORKERS"           env-default:"24"            yaml:"workers"`
+		Timeout          time.Duration `env:"APP_TIMEOUT"           env-default:"5s"            yaml:"timeout"`
+		DefaultBalance   int64         `env:"APP_DEFAULT_BALANCE"   env-default:"10000"         yaml:"defaultBalance"`
+		DefaultCurrency  string        `env:"APP_DEFAULT_CURRENCY"  env-default:"USD"           yaml:"defaultCurrency"`
+		HoldTTL          time.Duration `env:"APP_HOLD_TTL"          env-default:"24h"           yaml:"holdTTL"`
+		HoldMaxTTL       time.Duration `env:"APP_HOLD_MAX_TTL"      env-default:"720h"          yaml:"holdMaxTTL"`
+		HoldsExpiry      time.Duration `env:"APP_HOLDS_EXPIRY"      env-default:"1m"            yaml:"holdsExpiry"`
+		BalanceSnapshots time.Duration `env:"APP_BALANCE_SNAPSHOTS" env-default:"24h"           yaml:"balanceSnapshots"`
 	}
 
 	HTTP struct {
-		Port    string        `env:"HTTP_PORT"    env-default:":8080" yaml:"port"`
-		Timeout time.Duration `env:"HTTP_TIMEOUT" env-default:"5s"    yaml:"timeout"`
+		Port           string        `env:"HTTP_PORT"            env-default:":8080" yaml:"port"`
+		Timeout        time.Duration `env:"HTTP_TIMEOUT"         env-default:"5s"    yaml:"timeout"`
+		TrustedProxies []string      `env:"HTTP_TRUSTED_PROXIES"                     yaml:"trustedProxies"`
 	}
 
 	PG struct {
@@ -50,6 +64,98 @@ type (
 	Log struct {
 		Level string `env:"LOG_LEVEL" env-default:"debug" yaml:"logLevel"`
 	}
+
+	// Funding - the provider of the deposits and the withdrawals, the pending ones are settled every settle period.
+	Funding struct {
+		Provider     string        `env:"FUNDING_PROVIDER"      env-default:"fake"      yaml:"provider"`
+		FakeStatus   string        `env:"FUNDING_FAKE_STATUS"   env-default:"completed" yaml:"fakeStatus"`
+		SettlePeriod time.Duration `env:"FUNDING_SETTLE_PERIOD" env-default:"1m"        yaml:"settlePeriod"`
+		SettleDelay  time.Duration `env:"FUNDING_SETTLE_DELAY"  env-default:"1m"        yaml:"settleDelay"`
+	}
+
+	// Fees - fee wallets by currency and the fee rules, the first matching rule is applied.
+	Fees struct {
+		Wallets map[string]string `env:"FEES_WALLETS" yaml:"wallets"`
+		Rules   []FeeRule         `yaml:"rules"`
+	}
+
+	FeeRule struct {
+		Kind        string    `yaml:"kind"`
+		WalletType  string    `yaml:"walletType"`
+		Currency    string    `yaml:"currency"`
+		MinAmount   int64     `yaml:"minAmount"`
+		MaxAmount   int64     `yaml:"maxAmount"`
+		Flat        int64     `yaml:"flat"`
+		BasisPoints int64     `yaml:"basisPoints"`
+		MinFee      int64     `yaml:"minFee"`
+		MaxFee      int64     `yaml:"maxFee"`
+		Tiers       []FeeTier `yaml:"tiers"`
+	}
+
+	FeeTier struct {
+		UpTo        int64 `yaml:"upTo"`
+		Flat        int64 `yaml:"flat"`
+		BasisPoints int64 `yaml:"basisPoints"`
+	}
+
+	// Auth - the API keys and the verification of the JWT bearer tokens by the JWKS file or by the shared secret.
+	Auth struct {
+		APIKeys []APIKey `yaml:"apiKeys"`
+		JWT     `yaml:"jwt"`
+	}
+
+	// APIKey - hex encoded SHA-256 hash of the key and the caller, who is authenticated by it.
+	APIKey struct {
+		Hash    string   `yaml:"hash"`
+		Subject string   `yaml:"subject"`
+		Scopes  []string `yaml:"scopes"`
+	}
+
+	JWT struct {
+		JWKSFile string        `env:"JWT_JWKS_FILE"                   yaml:"jwksFile"`
+		Secret   string        `env:"JWT_SECRET"                      yaml:"secret"`
+		Issuer   string        `env:"JWT_ISSUER"                      yaml:"issuer"`
+		Audience string        `env:"JWT_AUDIENCE"                    yaml:"audience"`
+		Leeway   time.Duration `env:"JWT_LEEWAY"    env-default:"30s" yaml:"leeway"`
+	}
+
+	// RateLimit - the token buckets of the callers, the buckets are kept in the memory or in postgres.
+	RateLimit struct {
+		Store     string        `env:"RATE_LIMIT_STORE"             env-default:"memory" yaml:"store"`
+		Prune     time.Duration `env:"RATE_LIMIT_PRUNE"             env-default:"1h"     yaml:"prune"`
+		Principal RateLimitRule `env-prefix:"RATE_LIMIT_PRINCIPAL_"                      yaml:"principal"`
+		IP        RateLimitRule `env-prefix:"RATE_LIMIT_IP_"                             yaml:"ip"`
+		Wallet    RateLimitRule `env-prefix:"RATE_LIMIT_WALLET_"                         yaml:"wallet"`
+	}
+
+	// RateLimitRule - the requests per second and the burst of the requests, the zero rate disables the limit.
+	RateLimitRule struct {
+		Rate  float64 `env:"RATE"  yaml:"rate"`
+		Burst int     `env:"BURST" yaml:"burst"`
+	}
+
+	// Webhooks - the delivery of the events to the subscribers, the failed deliveries are retried with the exponential backoff.
+	Webhooks struct {
+		Period      time.Duration `env:"WEBHOOKS_PERIOD"       env-default:"5s"  yaml:"period"`
+		Timeout     time.Duration `env:"WEBHOOKS_TIMEOUT"      env-default:"10s" yaml:"timeout"`
+		MaxAttempts int           `env:"WEBHOOKS_MAX_ATTEMPTS" env-default:"8"   yaml:"maxAttempts"`
+		MinBackoff  time.Duration `env:"WEBHOOKS_MIN_BACKOFF"  env-default:"30s" yaml:"minBackoff"`
+		MaxBackoff  time.Duration `env:"WEBHOOKS_MAX_BACKOFF"  env-default:"6h"  yaml:"maxBackoff"`
+	}
+
+	// Outbox - the relay of the domain events to the topic exchange, the published events are kept for the retention.
+	Outbox struct {
+		Exchange  string        `env:"OUTBOX_EXCHANGE"  env-default:"wallet_events" yaml:"exchange"`
+		Period    time.Duration `env:"OUTBOX_PERIOD"    env-default:"1s"            yaml:"period"`
+		Timeout   time.Duration `env:"OUTBOX_TIMEOUT"   env-default:"5s"            yaml:"timeout"`
+		Prune     time.Duration `env:"OUTBOX_PRUNE"     env-default:"1h"            yaml:"prune"`
+		Retention time.Duration `env:"OUTBOX_RETENTION" env-default:"168h"          yaml:"retention"`
+	}
+
+	// Reconciliation - the recomputation of the wallet balances, which is run every period.
+	Reconciliation struct {
+		Period time.Duration `env:"RECONCILIATION_PERIOD" env-default:"24h" yaml:"period"`
+	}
 )
 
 func MustLoad() *Config {
@@ -16,10 +16,12 @@ app:
   countWorkers: 24
   timeout: 5s
   defaultBalance: 100
+  defaultCurrency: "EUR"
 
 http:
   port: ":8080"
   timeout: 10s
+  trustedProxies: ["10.0.0.0/8"]
 
 postgres:
   poolMax: 2
@@ -30,6 +32,60 @@ rabbitmq:
 
 logger:
   logLevel: "info"
+
+funding:
+  provider: "fake"
+  fakeStatus: "pending"
+  settlePeriod: 30s
+
+fees:
+  wallets:
+    USD: "5b53700ed469fa6a09ea72bb78f36fd9"
+  rules:
+    - kind: "percentage"
+      walletType: "business"
+      basisPoints: 150
+      minFee: 50
+    - kind: "tiered"
+      currency: "USD"
+      tiers:
+        - upTo: 100000
+          flat: 25
+        - basisPoints: 10
+
+auth:
+  apiKeys:
+    - hash: "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"
+      subject: "operations"
+      scopes: ["admin", "wallet:send"]
+  jwt:
+    issuer: "https://auth.example.com"
+    audience: "wallet"
+    leeway: 1m
+
+rateLimit:
+  store: "postgres"
+  principal:
+    rate: 20
+    burst: 40
+  ip:
+    rate: 50
+    burst: 100
+  wallet:
+    rate: 0.5
+    burst: 2
+
+webhooks:
+  timeout: 3s
+  maxAttempts: 5
+  minBackoff: 1m
+
+outbox:
+  exchange: "test_events"
+  retention: 24h
+
+reconciliation:
+  period: 6h
 `
 
 var testEnvRequiredStr = `
@@ -43,6 +99,7 @@ APP_VERSION=1.0.0
 APP_WORKERS=24
 APP_TIMEOUT=5s
 APP_DEFAULT_BALANCE=100
+APP_DEFAULT_CURRENCY=EUR
 HTTP_PORT=:8080
 HTTP_TIMEOUT=5s
 PG_POOL_MAX=2
@@ -50,7 +107,9 @@ PG_URL=test-url
 RMQ_RPC_SERVER=rpc_server
 RMQ_RPC_CLIENT=rpc_client
 RMQ_URL=test-url
-LOG_LEVEL=info`
+LOG_LEVEL=info
+RATE_LIMIT_IP_RATE=50
+RATE_LIMIT_IP_BURST=100`
 
 func Test_MustLoadPath_ExistentPath(t *testing.T) {
 	for _, test := range testsMustLoadPath {
@@ -96,11 +155,16 @@ var testsMustLoadPath = []struct {
 		envFile:    testEnvStr,
 		expectedConfig: &Config{
 			App: App{
-				Name:           "test-app",
-				Version:        "1.0.0",
-				CountWorkers:   24,
-				Timeout:        5 * time.Second,
-				DefaultBalance: 100,
+				Name:             "test-app",
+				Version:          "1.0.0",
+				CountWorkers:     24,
+				Timeout:          5 * time.Second,
+				DefaultBalance:   100,
+				DefaultCurrency:  "EUR",
+				HoldTTL:          24 * time.Hour,
+				HoldMaxTTL:       720 * time.Hour,
+				HoldsExpiry:      time.Minute,
+				BalanceSnapshots: 24 * time.Hour,
 			},
 			HTTP: HTTP{
 				Port:    ":8080",
@@ -118,6 +182,42 @@ var testsMustLoadPath = []struct {
 			Log: Log{
 				Level: "info",
 			},
+			Funding: Funding{
+				Provider:     "fake",
+				FakeStatus:   "completed",
+				SettlePeriod: time.Minute,
+				SettleDelay:  time.Minute,
+			},
+			Auth: Auth{
+				JWT: JWT{
+					Leeway: 30 * time.Second,
+				},
+			},
+			RateLimit: RateLimit{
+				Store: "memory",
+				Prune: time.Hour,
+				IP: RateLimitRule{
+					Rate:  50,
+					Burst: 100,
+				},
+			},
+			Webhooks: Webhooks{
+				Period:      5 * time.Second,
+				Timeout:     10 * time.Second,
+				MaxAttempts: 8,
+				MinBackoff:  30 * time.Second,
+				MaxBackoff:  6 * time.Hour,
+			},
+			Outbox: Outbox{
+				Exchange:  "wallet_events",
+				Period:    time.Second,
+				Timeout:   5 * time.Second,
+				Prune:     time.Hour,
+				Retention: 168 * time.Hour,
+			},
+			Reconciliation: Reconciliation{
+				Period: 24 * time.Hour,
+			},
 		},
 	},
 	{
@@ -126,15 +226,21 @@ var testsMustLoadPath = []struct {
 		envFile:    testEnvRequiredStr,
 		expectedConfig: &Config{
 			App: App{
-				Name:           "test-app",
-				Version:        "1.0.0",
-				CountWorkers:   24,
-				Timeout:        5 * time.Second,
-				DefaultBalance: 100,
+				Name:             "test-app",
+				Version:          "1.0.0",
+				CountWorkers:     24,
+				Timeout:          5 * time.Second,
+				DefaultBalance:   100,
+				DefaultCurrency:  "EUR",
+				HoldTTL:          24 * time.Hour,
+				HoldMaxTTL:       720 * time.Hour,
+				HoldsExpiry:      time.Minute,
+				BalanceSnapshots: 24 * time.Hour,
 			},
 			HTTP: HTTP{
-				Port:    ":8080",
-				Timeout: 5 * time.Second,
+				Port:           ":8080",
+				Timeout:        5 * time.Second,
+				TrustedProxies: []string{"10.0.0.0/8"},
 			},
 			PG: PG{
 				PoolMax: 2,
@@ -148,6 +254,64 @@ var testsMustLoadPath = []struct {
 			Log: Log{
 				Level: "info",
 			},
+			Funding: Funding{
+				Provider:     "fake",
+				FakeStatus:   "pending",
+				SettlePeriod: 30 * time.Second,
+				SettleDelay:  time.Minute,
+			},
+			Fees: Fees{
+				Wallets: map[string]string{"USD": "5b53700ed469fa6a09ea72bb78f36fd9"},
+				Rules: []FeeRule{
+					{Kind: "percentage", WalletType: "business", BasisPoints: 150, MinFee: 50},
+					{Kind: "tiered", Currency: "USD", Tiers: []FeeTier{{UpTo: 100000, Flat: 25}, {BasisPoints: 10}}},
+				},
+			},
+			Auth: Auth{
+				APIKeys: []APIKey{{
+					Hash:    "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b",
+					Subject: "operations",
+					Scopes:  []string{"admin", "wallet:send"},
+				}},
+				JWT: JWT{
+					Issuer:   "https://auth.example.com",
+					Audience: "wallet",
+					Leeway:   time.Minute,
+				},
+			},
+			RateLimit: RateLimit{
+				Store: "postgres",
+				Prune: time.Hour,
+				Principal: RateLimitRule{
+					Rate:  20,
+					Burst: 40,
+				},
+				IP: RateLimitRule{
+					Rate:  50,
+					Burst: 100,
+				},
+				Wallet: RateLimitRule{
+					Rate:  0.5,
+					Burst: 2,
+				},
+			},
+			Webhooks: Webhooks{
+				Period:      5 * time.Second,
+				Timeout:     3 * time.Second,
+				MaxAttempts: 5,
+				MinBackoff:  time.Minute,
+				MaxBackoff:  6 * time.Hour,
+			},
+			Outbox: Outbox{
+				Exchange:  "test_events",
+				Period:    time.Second,
+				Timeout:   5 * time.Second,
+				Prune:     time.Hour,
+				Retention: 24 * time.Hour,
+			},
+			Reconciliation: Reconciliation{
+				Period: 6 * time.Hour,
+			},
 		},
 	},
 }
@@ -15,22 +15,103 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/wallet": {
-            "post": {
-                "description": "Создает новый кошелек с уникальным ID. Идентификатор генерируется сервером.\n\nСозданный кошелек должен иметь сумму 100.0 у.е. на балансе",
+        "/admin/audit": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает записи журнала аудита постранично, от новых к старым.\nСледующая страница запрашивается с курсором nextCursor из предыдущей.\nЖурнал содержит все изменяющие запросы, отказы в доступе и действия воркера от имени system.",
                 "tags": [
-                    "Wallet"
+                    "Admin"
+                ],
+                "summary": "Журнал аудита",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Субъект, выполнивший действие",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Действие, например POST /api/v1/wallet/:walletId/send",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало пути ресурса, например /api/v1/wallet/5b53700ed469fa6a09ea72bb78f36fd9",
+                        "name": "resource",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failure",
+                            "denied"
+                        ],
+                        "type": "string",
+                        "description": "Результат действия",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID запроса",
+                        "name": "requestId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода включительно, RFC3339",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода не включительно, RFC3339",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Курсор страницы",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 50, не больше 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Создание кошелька",
                 "responses": {
                     "200": {
-                        "description": "Кошелек создан",
+                        "description": "Страница журнала аудита",
                         "schema": {
-                            "$ref": "#/definitions/entity.Wallet"
+                            "$ref": "#/definitions/entity.AuditPage"
                         }
                     },
+                    "400": {
+                        "description": "Неверный фильтр или курсор"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
                     "500": {
-                        "description": "Не удалось создать кошелек"
+                        "description": "Не удалось выполнить запрос"
                     },
                     "504": {
                         "description": "Время ожидания вышло"
@@ -38,30 +119,86 @@ const docTemplate = `{
                 }
             }
         },
-        "/wallet/{walletId}": {
+        "/admin/permissions": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "tags": [
-                    "Wallet"
+                    "Roles"
                 ],
-                "summary": "Получение текущего состояния кошелька",
+                "summary": "Получение всех разрешений",
+                "responses": {
+                    "200": {
+                        "description": "Разрешения",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    }
+                }
+            }
+        },
+        "/admin/principals/{subject}/roles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Получение ролей субъекта",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID кошелька",
-                        "name": "walletId",
+                        "description": "Субъект API ключа или токена",
+                        "name": "subject",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Роли субъекта",
                         "schema": {
-                            "$ref": "#/definitions/entity.Wallet"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Role"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Указанный кошелек не найден"
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
                     },
                     "500": {
                         "description": "Не удалось выполнить запрос"
@@ -72,34 +209,117 @@ const docTemplate = `{
                 }
             }
         },
-        "/wallet/{walletId}/history": {
-            "get": {
-                "description": "Возвращает историю транзакций по указанному кошельку.",
+        "/admin/principals/{subject}/roles/{role}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Повторное назначение роли ничего не меняет.",
                 "tags": [
-                    "Wallet"
+                    "Roles"
                 ],
-                "summary": "Получение историй входящих и исходящих транзакций",
+                "summary": "Назначение роли субъекту",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID кошелька",
-                        "name": "walletId",
+                        "description": "Субъект API ключа или токена",
+                        "name": "subject",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Название роли",
+                        "name": "role",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "История транзакций получена",
+                        "description": "Роли субъекта",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/entity.Transaction"
+                                "$ref": "#/definitions/entity.Role"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
                     "404": {
-                        "description": "Указанный кошелек не найден"
+                        "description": "Роль не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Отзыв роли у субъекта",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Субъект API ключа или токена",
+                        "name": "subject",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Название роли",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Роль отозвана"
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Роль не назначена субъекту"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
                     },
                     "500": {
                         "description": "Не удалось выполнить запрос"
@@ -110,73 +330,2809 @@ const docTemplate = `{
                 }
             }
         },
-        "/wallet/{walletId}/send": {
+        "/admin/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает кошельки, баланс которых отличается от суммы проводок или от суммы переводов.",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Отчет последней сверки балансов",
+                "responses": {
+                    "200": {
+                        "description": "Отчет сверки",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ReconciliationReport"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Сверка еще не выполнялась"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Пересчитывает балансы всех кошельков по проводкам и по переводам и сравнивает их с текущими.\nСверка выполняется в фоне, ее результат доступен в отчете последней сверки.",
                 "tags": [
-                    "Wallet"
+                    "Admin"
                 ],
-                "summary": "Перевод средств с одного кошелька на другой",
+                "summary": "Запуск сверки балансов",
+                "responses": {
+                    "202": {
+                        "description": "Сверка запущена"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "409": {
+                        "description": "Сверка уже выполняется"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/admin/roles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Получение всех ролей",
+                "responses": {
+                    "200": {
+                        "description": "Роли",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Role"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/admin/roles/{role}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет все разрешения роли. Изменения действуют для всех субъектов, которым назначена роль.",
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Создание или изменение роли",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID кошелька",
-                        "name": "walletId",
+                        "description": "Название роли",
+                        "name": "role",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Запрос перевода средств",
+                        "description": "Запрос установки разрешений роли",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.transactionRequest"
+                            "$ref": "#/definitions/v1.setRoleRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Перевод успешно проведен"
+                        "description": "Роль сохранена",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Role"
+                        }
                     },
                     "400": {
                         "description": "Ошибка в пользовательском запросе"
                     },
-                    "404": {
-                        "description": "Исходящий кошелек не найден"
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
                     },
                     "500": {
-                        "description": "Ошибка перевода"
+                        "description": "Не удалось выполнить запрос"
                     },
                     "504": {
                         "description": "Время ожидания вышло"
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "entity.Transaction": {
-            "description": "Денежный перевод.",
-            "type": "object",
-            "required": [
-                "amount",
-                "from",
-                "time",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Роль отзывается у всех субъектов, которым она назначена.",
+                "tags": [
+                    "Roles"
+                ],
+                "summary": "Удаление роли",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название роли",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Роль удалена"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Роль не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/admin/wallets/{walletId}/close": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит остаток средств на указанный кошелек и закрывает кошелек.\nЗакрыть можно только активный кошелек без активных резервов и незавершенных пополнений и выводов.",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Закрытие кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос закрытия кошелька",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.closeWalletRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кошелек закрыт",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Кошелек или получатель остатка не найден"
+                    },
+                    "409": {
+                        "description": "У кошелька есть активные резервы или незавершенные пополнения и выводы"
+                    },
+                    "410": {
+                        "description": "Кошелек или получатель остатка закрыт"
+                    },
+                    "422": {
+                        "description": "Валюты кошельков различаются"
+                    },
+                    "423": {
+                        "description": "Кошелек или получатель остатка заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/admin/wallets/{walletId}/freeze": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Запрещает любые списания и зачисления по кошельку.",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Заморозка кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кошелек заморожен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/admin/wallets/{walletId}/unfreeze": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Разморозка кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кошелек разморожен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/customers": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает клиента, который может владеть кошельками. Идентификатор генерируется сервером.\nТребуется разрешение customers:manage.",
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Создание клиента",
+                "parameters": [
+                    {
+                        "description": "Запрос создания клиента",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.createCustomerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Клиент создан",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Customer"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось создать клиента"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/customers/{customerId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Получение клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента",
+                        "name": "customerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Клиент",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Customer"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный клиент не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/customers/{customerId}/wallets": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Получение кошельков клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента",
+                        "name": "customerId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кошельки клиента",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Wallet"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный клиент не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает новый кошелек, владельцем которого является клиент.\nОткрыть кошелек может сам клиент, либо обладатель разрешения customers:manage.",
+                "tags": [
+                    "Customers"
+                ],
+                "summary": "Открытие кошелька клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента",
+                        "name": "customerId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос открытия кошелька клиента",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.createCustomerWalletRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Кошелек создан",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный клиент не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось создать кошелек"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/holds/{holdId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Получение резерва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID резерва",
+                        "name": "holdId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Hold"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Резерв не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/holds/{holdId}/capture": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит зарезервированные средства получателю. Остаток частично списанного резерва освобождается.",
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Списание резерва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID резерва",
+                        "name": "holdId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос списания резерва",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.captureHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Резерв списан",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Резерв или кошелек получателя не найден"
+                    },
+                    "409": {
+                        "description": "Резерв уже списан, отменен или истек"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Перевод невозможен: превышен лимит расходов или валюты кошельков различаются",
+                        "schema": {
+                            "$ref": "#/definitions/entity.LimitExceededError"
+                        }
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось списать резерв"
+                    },
+                    "503": {
+                        "description": "Не настроен кошелек комиссий валюты перевода"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/holds/{holdId}/void": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Освобождает зарезервированные средства.",
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Отмена резерва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID резерва",
+                        "name": "holdId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Резерв отменен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Hold"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Резерв не найден"
+                    },
+                    "409": {
+                        "description": "Резерв уже списан, отменен или истек"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось отменить резерв"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает перевод по его уникальному ID.",
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Получение перевода",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID перевода",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Перевод",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Перевод не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/transfers/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Проводит все переводы пакета в одной транзакции: либо проводятся все, либо ни один.\nЕсли пакет отклонен, то в ответе перечислены ошибки всех отклоненных переводов.",
+                "tags": [
+                    "Transfers"
+                ],
+                "summary": "Пакетный перевод средств",
+                "parameters": [
+                    {
+                        "description": "Запрос пакетного перевода средств",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.batchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Переводы успешно проведены",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Transaction"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе или размер пакета"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "422": {
+                        "description": "Пакет отклонен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.BatchError"
+                        }
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Ошибка перевода"
+                    },
+                    "503": {
+                        "description": "Не настроен кошелек комиссий валюты перевода"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает новый кошелек с уникальным ID. Идентификатор генерируется сервером.\nВладелец по умолчанию - клиент из subject вызывающего, другого владельца может указать только обладатель разрешения customers:manage.\n\nСозданный кошелек должен иметь сумму 100.00 в валюте кошелька на балансе (10000 в минимальных единицах)",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Создание кошелька",
+                "parameters": [
+                    {
+                        "description": "Запрос создания кошелька",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.createWalletRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Кошелек создан",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный владелец не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось создать кошелек"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/transactions/{id}/refund": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создает связанный с переводом обратный перевод на всю сумму или ее часть.\nСумма всех возвратов не может превышать сумму исходного перевода.",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Возврат перевода",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID перевода",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос возврата перевода",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.refundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Возврат проведен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Перевод не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Возврат невозможен: превышена сумма перевода, перевод сам является возвратом или недостаточно средств"
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Ошибка возврата"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает баланс кошелька по журналу и доступный баланс за вычетом активных резервов.",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Получение текущего состояния кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Wallet"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/balance": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Баланс восстанавливается по журналу операций кошелька, начиная с начального баланса.",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Получение баланса кошелька на момент времени",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Момент времени, RFC3339",
+                        "name": "at",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Баланс кошелька на момент времени",
+                        "schema": {
+                            "$ref": "#/definitions/entity.HistoricalBalance"
+                        }
+                    },
+                    "400": {
+                        "description": "Момент времени не указан или указан неверно"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/deposit": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Зачисляет средства от платежного провайдера. Пополнение может остаться в обработке у провайдера.\nПополнять кошелек может его владелец, либо тот, кому делегирована отправка с кошелька.",
+                "tags": [
+                    "Funding"
+                ],
+                "summary": "Пополнение кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос пополнения",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.fundingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Пополнение проведено",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "202": {
+                        "description": "Пополнение в обработке у провайдера",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Провайдер отклонил пополнение",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Ошибка пополнения"
+                    },
+                    "502": {
+                        "description": "Платежный провайдер недоступен"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает историю транзакций по указанному кошельку постранично, от новых к старым.\nСледующая страница запрашивается с курсором nextCursor из предыдущей.\nВозвраты содержат ID исходного перевода в поле parentId.",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Получение историй входящих и исходящих транзакций",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "incoming",
+                            "outgoing"
+                        ],
+                        "type": "string",
+                        "description": "Направление транзакций",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID кошелька контрагента",
+                        "name": "counterparty",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Внешняя ссылка перевода",
+                        "name": "reference",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода включительно, RFC3339",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода не включительно, RFC3339",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Минимальная сумма в минимальных единицах валюты",
+                        "name": "minAmount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Максимальная сумма в минимальных единицах валюты",
+                        "name": "maxAmount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор страницы",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Размер страницы, по умолчанию 50, не больше 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница истории транзакций получена",
+                        "schema": {
+                            "$ref": "#/definitions/entity.HistoryPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный фильтр или курсор"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/holds": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Уменьшает доступный баланс кошелька без перевода средств.\nРезерв можно списать или отменить, по истечении времени жизни он отменяется автоматически.",
+                "tags": [
+                    "Hold"
+                ],
+                "summary": "Резервирование средств кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос резервирования средств",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.authorizeHoldRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Средства зарезервированы",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Hold"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Недостаточно доступных средств"
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось зарезервировать средства"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/limits": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Нулевой лимит не ограничивает расходы.",
+                "tags": [
+                    "Limits"
+                ],
+                "summary": "Получение лимитов расходов кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Лимиты расходов кошелька",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SpendingLimits"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заменяет все лимиты кошелька. Лимиты ограничивают переводы и выводы по скользящим окнам: сутки и 30 дней.\nНулевой лимит не ограничивает расходы. Менять лимиты может только тот, кто может отправлять с кошелька.",
+                "tags": [
+                    "Limits"
+                ],
+                "summary": "Установка лимитов расходов кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос установки лимитов расходов",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.setLimitsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Лимиты установлены",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SpendingLimits"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Limits"
+                ],
+                "summary": "Удаление лимитов расходов кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Лимиты удалены"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/send": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Повторный запрос с тем же ключом идемпотентности возвращает результат исходного перевода.",
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Перевод средств с одного кошелька на другой",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности перевода",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Запрос перевода средств",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.transactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Перевод успешно проведен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Исходящий кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Перевод невозможен: превышен лимит расходов, недостаточно средств, валюты кошельков различаются или ключ идемпотентности использован с другим запросом",
+                        "schema": {
+                            "$ref": "#/definitions/entity.LimitExceededError"
+                        }
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Ошибка перевода"
+                    },
+                    "503": {
+                        "description": "Не настроен кошелек комиссий валюты перевода"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/statement": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Выписка содержит баланс на начало периода, операции периода и баланс на конец периода.\nВыписку можно скачать в форматах CSV, OFX и ISO 20022 CAMT.053.",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/x-ofx",
+                    "application/xml"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Получение выписки по кошельку за период",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода включительно, RFC3339",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода не включительно, RFC3339",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "ofx",
+                            "camt053"
+                        ],
+                        "type": "string",
+                        "description": "Формат выписки, по умолчанию json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Выписка по кошельку",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Statement"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный период или формат выписки, либо слишком много операций за период"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/stream": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Первым событием wallet отправляется текущее состояние кошелька, затем события credited и debited\nпри изменении баланса (entity.BalanceChange) и transaction при создании или изменении перевода (entity.Transaction).\nПо умолчанию используется Server-Sent Events, с параметром transport=websocket - WebSocket.\nЕсли клиент не успевает читать события, поток закрывается, и клиенту нужно переподключиться.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Поток изменений кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "sse",
+                            "websocket"
+                        ],
+                        "type": "string",
+                        "description": "Транспорт потока",
+                        "name": "transport",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WalletEvent"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/wallet/{walletId}/withdraw": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Списывает средства в пользу платежного провайдера. Вывод может остаться в обработке у провайдера.\nЕсли провайдер отклонил вывод, то средства возвращаются на кошелек.",
+                "tags": [
+                    "Funding"
+                ],
+                "summary": "Вывод средств из кошелька",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID кошелька",
+                        "name": "walletId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запрос вывода средств",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.fundingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Вывод проведен",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "202": {
+                        "description": "Вывод в обработке у провайдера",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "410": {
+                        "description": "Кошелек закрыт"
+                    },
+                    "422": {
+                        "description": "Провайдер отклонил вывод, недостаточно средств или превышен лимит расходов",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Transaction"
+                        }
+                    },
+                    "423": {
+                        "description": "Кошелек заморожен"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Ошибка вывода"
+                    },
+                    "502": {
+                        "description": "Платежный провайдер недоступен"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получение подписок вызывающего",
+                "responses": {
+                    "200": {
+                        "description": "Подписки",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.WebhookSubscription"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "События отправляются POST запросом с телом entity.WebhookEvent.\nЗаголовок X-Webhook-Signature содержит подпись \"t=\u003cunix время\u003e,v1=\u003cHMAC-SHA256\u003e\" строки \"\u003cunix время\u003e.\u003cтело запроса\u003e\" секретом подписки.\nНеуспешные доставки повторяются с экспоненциальной задержкой. Секрет возвращается только при создании подписки.\nПодписка на все кошельки требует разрешения wallet:read, на один кошелек - права на его чтение.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Создание подписки на события",
+                "parameters": [
+                    {
+                        "description": "Запрос создания подписки",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.createWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Подписка создана",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Указанный кошелек не найден"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось создать подписку"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/webhooks/{webhookId}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получение подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Подписка",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookSubscription"
+                        }
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Подписка не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Вместе с подпиской удаляется журнал ее доставок.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Удаление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Подписка удалена"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Подписка не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/webhooks/{webhookId}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возвращает доставки от новых к старым.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Получение журнала доставок подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Статус доставки",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество доставок, по умолчанию 50, не больше 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Доставки",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный фильтр"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Подписка не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        },
+        "/webhooks/{webhookId}/deliveries/{deliveryId}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Доставка отправляется снова с полным числом попыток, даже если она уже доставлена.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Повторная отправка события",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID доставки",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Доставка запланирована",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Ошибка в пользовательском запросе"
+                    },
+                    "401": {
+                        "description": "Требуется аутентификация"
+                    },
+                    "403": {
+                        "description": "Недостаточно прав"
+                    },
+                    "404": {
+                        "description": "Подписка или доставка не найдена"
+                    },
+                    "429": {
+                        "description": "Слишком много запросов"
+                    },
+                    "500": {
+                        "description": "Не удалось выполнить запрос"
+                    },
+                    "504": {
+                        "description": "Время ожидания вышло"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.AuditEvent": {
+            "description": "Запись журнала аудита.",
+            "type": "object",
+            "required": [
+                "action",
+                "actor",
+                "id",
+                "outcome",
+                "resource",
+                "time"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "POST /api/v1/admin/wallets/:walletId/freeze"
+                },
+                "actor": {
+                    "type": "string",
+                    "example": "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ip": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "outcome": {
+                    "type": "string",
+                    "enum": [
+                        "success",
+                        "failure",
+                        "denied"
+                    ],
+                    "example": "denied"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "missing permission wallet:freeze"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "0f8c2d6e-3b1a-4e7f-9c5d-2a4b6c8d0e1f"
+                },
+                "resource": {
+                    "type": "string",
+                    "example": "/api/v1/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-05-05T12:00:00Z"
+                }
+            }
+        },
+        "entity.AuditPage": {
+            "description": "Страница журнала аудита.",
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.AuditEvent"
+                    }
+                },
+                "nextCursor": {
+                    "type": "integer",
+                    "example": 1024
+                }
+            }
+        },
+        "entity.BatchError": {
+            "description": "Пакет переводов отклонен целиком.",
+            "type": "object",
+            "properties": {
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.LegError"
+                    }
+                }
+            }
+        },
+        "entity.Customer": {
+            "description": "Клиент - владелец кошельков.",
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "RU"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ivan@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ivan Petrov"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+79991234567"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "individual"
+                }
+            }
+        },
+        "entity.HistoricalBalance": {
+            "description": "Баланс кошелька на момент времени.",
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string",
+                    "example": "2024-03-31T23:59:59Z"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.HistoryPage": {
+            "description": "Страница истории транзакций кошелька.",
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "string",
+                    "example": "MjAyNC0wMi0wNFQxNzoyNTozNS40NDhafDdjOWU2Njc5"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Transaction"
+                    }
+                }
+            }
+        },
+        "entity.Hold": {
+            "description": "Резервирование средств кошелька.",
+            "type": "object",
+            "required": [
+                "amount",
+                "createdAt",
+                "currency",
+                "expiresAt",
+                "id",
+                "status",
+                "walletId"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "3000"
+                },
+                "capturedAmount": {
+                    "type": "string",
+                    "example": "2000"
+                },
+                "capturedTo": {
+                    "type": "string",
+                    "example": "eb376add88bf8e70f80787266a0801d5"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0f8fad5b-d9cb-469f-a165-70867728950e"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "authorized"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.LegError": {
+            "description": "Ошибка перевода в составе пакета.",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "not enough funds"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "entity.LimitExceededError": {
+            "description": "Превышен лимит расходов кошелька.",
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "string",
+                    "example": "daily"
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "1500"
+                }
+            }
+        },
+        "entity.ReconciliationReport": {
+            "description": "Отчет сверки балансов кошельков.",
+            "type": "object",
+            "required": [
+                "driftedWallets",
+                "drifts",
+                "finishedAt",
+                "id",
+                "startedAt",
+                "walletsChecked"
+            ],
+            "properties": {
+                "driftedWallets": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "drifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.WalletDrift"
+                    }
+                },
+                "finishedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:37.012Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "startedAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "walletsChecked": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "entity.Role": {
+            "description": "Роль - набор разрешений, который назначается субъектам.",
+            "type": "object",
+            "required": [
+                "name",
+                "permissions"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "support"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "wallet:read",
+                        "wallet:freeze"
+                    ]
+                }
+            }
+        },
+        "entity.SpendingLimits": {
+            "description": "Лимиты расходов кошелька. Нулевой лимит не ограничивает расходы.",
+            "type": "object",
+            "required": [
+                "walletId"
+            ],
+            "properties": {
+                "daily": {
+                    "type": "string",
+                    "example": "20000"
+                },
+                "monthly": {
+                    "type": "string",
+                    "example": "100000"
+                },
+                "perTransaction": {
+                    "type": "string",
+                    "example": "5000"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.Statement": {
+            "description": "Выписка по кошельку за период.",
+            "type": "object",
+            "properties": {
+                "closingBalance": {
+                    "type": "string",
+                    "example": "7000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StatementEntry"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "generatedAt": {
+                    "type": "string",
+                    "example": "2024-04-02T09:00:00Z"
+                },
+                "openingBalance": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-04-01T00:00:00Z"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.StatementEntry": {
+            "description": "Операция выписки.",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "3000"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "7000"
+                },
+                "counterparty": {
+                    "type": "string",
+                    "example": "eb376add88bf8e70f80787266a0801d5"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Salary for April"
+                },
+                "direction": {
+                    "type": "string",
+                    "example": "debit"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "INV-2024-0042"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2024-03-04T17:25:35.448Z"
+                },
+                "transactionId": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "transfer"
+                }
+            }
+        },
+        "entity.Transaction": {
+            "description": "Денежный перевод.",
+            "type": "object",
+            "required": [
+                "amount",
+                "currency",
+                "from",
+                "id",
+                "time",
+                "to",
+                "type"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "3000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Salary for April"
+                },
+                "fee": {
+                    "type": "string",
+                    "example": "30"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "0f8fad5b-d9cb-469f-a165-70867728950e"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "fake"
+                },
+                "providerReference": {
+                    "type": "string",
+                    "example": "fake-4f0b0c4e"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "INV-2024-0042"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "completed"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "eb376add88bf8e70f80787266a0801d5"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "transfer"
+                }
+            }
+        },
+        "entity.TransferLeg": {
+            "description": "Перевод в составе пакета.",
+            "type": "object",
+            "required": [
+                "amount",
+                "from",
                 "to"
             ],
             "properties": {
                 "amount": {
-                    "type": "integer",
-                    "example": 30
+                    "type": "string",
+                    "example": "3000"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Salary for April"
                 },
                 "from": {
                     "type": "string",
                     "example": "5b53700ed469fa6a09ea72bb78f36fd9"
                 },
-                "time": {
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "reference": {
                     "type": "string",
-                    "format": "date-time",
-                    "example": "2024-02-04T17:25:35.448Z"
+                    "example": "INV-2024-0042"
                 },
                 "to": {
                     "type": "string",
@@ -187,21 +3143,445 @@ const docTemplate = `{
         "entity.Wallet": {
             "description": "Состояние кошелька.",
             "type": "object",
+            "required": [
+                "available",
+                "balance",
+                "currency",
+                "held",
+                "id",
+                "status",
+                "type"
+            ],
+            "properties": {
+                "available": {
+                    "type": "string",
+                    "example": "7000"
+                },
+                "balance": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "held": {
+                    "type": "string",
+                    "example": "3000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "active"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "personal"
+                }
+            }
+        },
+        "entity.WalletDrift": {
+            "description": "Расхождение баланса кошелька с пересчитанным.",
+            "type": "object",
             "required": [
                 "balance",
-                "id"
+                "currency",
+                "ledgerBalance",
+                "ledgerDrift",
+                "transactionsBalance",
+                "transactionsDrift",
+                "walletId"
             ],
             "properties": {
                 "balance": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "ledgerBalance": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "ledgerDrift": {
+                    "type": "string",
+                    "example": "0"
+                },
+                "transactionsBalance": {
+                    "type": "string",
+                    "example": "9500"
+                },
+                "transactionsDrift": {
+                    "type": "string",
+                    "example": "500"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.WalletEvent": {
+            "description": "Событие потока кошелька.",
+            "type": "object",
+            "required": [
+                "data",
+                "type",
+                "walletId"
+            ],
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "42"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "credited"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "entity.WebhookDelivery": {
+            "description": "Доставка события подписчику.",
+            "type": "object",
+            "required": [
+                "attempts",
+                "createdAt",
+                "eventId",
+                "eventType",
+                "id",
+                "payload",
+                "status",
+                "webhookId"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "deliveredAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "eventId": {
+                    "type": "string",
+                    "example": "6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e"
+                },
+                "eventType": {
+                    "type": "string",
+                    "example": "transfer.completed"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "lastError": {
+                    "type": "string",
+                    "example": "unexpected status 503"
+                },
+                "lastStatusCode": {
                     "type": "integer",
-                    "example": 100
+                    "example": 503
+                },
+                "nextAttemptAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "failed"
+                },
+                "webhookId": {
+                    "type": "string",
+                    "example": "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19"
+                }
+            }
+        },
+        "entity.WebhookSubscription": {
+            "description": "Подписка на события кошельков.",
+            "type": "object",
+            "required": [
+                "createdAt",
+                "eventTypes",
+                "id",
+                "owner",
+                "url"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-02-04T17:25:35.448Z"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "transfer.completed",
+                        "wallet.frozen"
+                    ]
                 },
                 "id": {
+                    "type": "string",
+                    "example": "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19"
+                },
+                "owner": {
+                    "type": "string",
+                    "example": "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "whsec_5f2b8c1d9e4a7b3c6d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/wallet"
+                },
+                "walletId": {
+                    "type": "string",
+                    "example": "5b53700ed469fa6a09ea72bb78f36fd9"
+                }
+            }
+        },
+        "v1.authorizeHoldRequest": {
+            "description": "Запрос резервирования средств.",
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "3000"
+                },
+                "ttl": {
+                    "type": "integer",
+                    "example": 3600
+                }
+            }
+        },
+        "v1.batchRequest": {
+            "description": "Запрос пакетного перевода средств.",
+            "type": "object",
+            "required": [
+                "legs"
+            ],
+            "properties": {
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TransferLeg"
+                    }
+                }
+            }
+        },
+        "v1.captureHoldRequest": {
+            "description": "Запрос списания резерва.",
+            "type": "object",
+            "required": [
+                "to"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "2000"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "eb376add88bf8e70f80787266a0801d5"
+                }
+            }
+        },
+        "v1.closeWalletRequest": {
+            "description": "Запрос закрытия кошелька.",
+            "type": "object",
+            "required": [
+                "to"
+            ],
+            "properties": {
+                "to": {
+                    "type": "string",
+                    "example": "eb376add88bf8e70f80787266a0801d5"
+                }
+            }
+        },
+        "v1.createCustomerRequest": {
+            "description": "Запрос создания клиента.",
+            "type": "object",
+            "required": [
+                "name",
+                "type"
+            ],
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "RU"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ivan@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ivan Petrov"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+79991234567"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "individual"
+                }
+            }
+        },
+        "v1.createCustomerWalletRequest": {
+            "description": "Запрос открытия кошелька клиента.",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "business"
+                }
+            }
+        },
+        "v1.createWalletRequest": {
+            "description": "Запрос создания кошелька.",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "business"
+                }
+            }
+        },
+        "v1.createWebhookRequest": {
+            "description": "Запрос создания подписки на события.",
+            "type": "object",
+            "required": [
+                "eventTypes",
+                "url"
+            ],
+            "properties": {
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "transfer.completed",
+                        "wallet.frozen"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/hooks/wallet"
+                },
+                "walletId": {
                     "type": "string",
                     "example": "5b53700ed469fa6a09ea72bb78f36fd9"
                 }
             }
         },
+        "v1.fundingRequest": {
+            "description": "Запрос пополнения или вывода средств.",
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "10000"
+                }
+            }
+        },
+        "v1.refundRequest": {
+            "description": "Запрос возврата перевода.",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "1000"
+                }
+            }
+        },
+        "v1.setLimitsRequest": {
+            "description": "Запрос установки лимитов расходов.",
+            "type": "object",
+            "properties": {
+                "daily": {
+                    "type": "string",
+                    "example": "20000"
+                },
+                "monthly": {
+                    "type": "string",
+                    "example": "100000"
+                },
+                "perTransaction": {
+                    "type": "string",
+                    "example": "5000"
+                }
+            }
+        },
+        "v1.setRoleRequest": {
+            "description": "Запрос установки разрешений роли.",
+            "type": "object",
+            "required": [
+                "permissions"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "wallet:read",
+                        "wallet:freeze"
+                    ]
+                }
+            }
+        },
         "v1.transactionRequest": {
             "description": "Запрос перевода средств.",
             "type": "object",
@@ -211,8 +3591,22 @@ const docTemplate = `{
             ],
             "properties": {
                 "amount": {
-                    "type": "integer",
-                    "example": 100
+                    "type": "string",
+                    "example": "10000"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Salary for April"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "INV-2024-0042"
                 },
                 "to": {
                     "type": "string",
@@ -220,6 +3614,19 @@ const docTemplate = `{
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT в формате \"Bearer \u003ctoken\u003e\", subject токена - ID клиента",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
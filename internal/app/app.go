@@ -1,25 +1,78 @@
 package app
 
 import (
+	"context"
 	"log/slog"
+	"os"
 
 	"github.com/egor-denisov/wallet-rielta/config"
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/auth"
 	v1 "github.com/egor-denisov/wallet-rielta/internal/wallet/controller/http/v1"
 	gateway "github.com/egor-denisov/wallet-rielta/internal/wallet/gateway/rabbitmq"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/stream"
 	walletUC "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
 	amqprpc "github.com/egor-denisov/wallet-rielta/internal/walletWorker/controller/amqp_rpc"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/gateway/events"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/gateway/funding"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/gateway/webhook"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/metrics"
 	repo "github.com/egor-denisov/wallet-rielta/internal/walletWorker/repository/postgres"
 	workerUC "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
 	"github.com/egor-denisov/wallet-rielta/pkg/httpserver"
+	"github.com/egor-denisov/wallet-rielta/pkg/jwt"
 	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/publisher"
 	rmqclient "github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/client"
 	rmqserver "github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/subscriber"
+	"github.com/egor-denisov/wallet-rielta/pkg/scheduler"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
+// Binding key of all events of the wallets.
+const _walletEventsBindingKey = "wallet.#"
+
+// Schema migrations, which are applied at the start in the given order.
+var _migrations = []string{
+	"./migrations/20240418133357_init.up.sql",
+	"./migrations/20240420120000_idempotency_keys.up.sql",
+	"./migrations/20240421120000_ledger.up.sql",
+	"./migrations/20240422120000_currencies.up.sql",
+	"./migrations/20240423120000_money_bigint.up.sql",
+	"./migrations/20240424120000_holds.up.sql",
+	"./migrations/20240425120000_refunds.up.sql",
+	"./migrations/20240426120000_wallet_status.up.sql",
+	"./migrations/20240427120000_wallet_limits.up.sql",
+	"./migrations/20240428120000_funding.up.sql",
+	"./migrations/20240429120000_idempotency_transaction.up.sql",
+	"./migrations/20240430120000_transfer_details.up.sql",
+	"./migrations/20240501120000_history_indexes.up.sql",
+	"./migrations/20240502120000_balance_snapshots.up.sql",
+	"./migrations/20240503120000_fees.up.sql",
+	"./migrations/20240504120000_customers.up.sql",
+	"./migrations/20240505120000_rbac.up.sql",
+	"./migrations/20240506120000_rate_limits.up.sql",
+	"./migrations/20240507120000_webhooks.up.sql",
+	"./migrations/20240508120000_outbox.up.sql",
+	"./migrations/20240509120000_reconciliation.up.sql",
+	"./migrations/20240510120000_audit_log.up.sql",
+	"./migrations/20240511120000_customers_manage.up.sql",
+	"./migrations/20240512120000_idempotency_error_details.up.sql",
+	"./migrations/20240513120000_ledger_opened_at.up.sql",
+	"./migrations/20240514120000_ledger_system_accounts.up.sql",
+	"./migrations/20240515120000_transaction_types.up.sql",
+}
+
 type App struct {
 	HTTPServer *httpserver.Server
 	RMQServer  *rmqserver.Server
+	Scheduler  *scheduler.Scheduler
+	Publisher  *publisher.Publisher
+	Subscriber *subscriber.Subscriber
+	Streams    *stream.Hub
 	DB         *postgres.Postgres
 }
 
@@ -33,9 +86,10 @@ func New(
 		panic("app - Run - postgres.New: " + err.Error())
 	}
 	// Migrate database schema
-	err = pg.Migrate("./migrations/20240418133357_init.up.sql")
-	if err != nil {
-		panic("app - Run - pg.Migrate: " + err.Error())
+	for _, migration := range _migrations {
+		if err = pg.Migrate(migration); err != nil {
+			panic("app - Run - pg.Migrate: " + err.Error())
+		}
 	}
 	// Connect to rabbitmq
 	rmqClient, err := rmqclient.New(cfg.RMQ.URL, cfg.RMQ.ServerExchange, cfg.RMQ.ClientExchange)
@@ -43,19 +97,53 @@ func New(
 		panic("app - Run - rmqServer - server.New" + err.Error())
 	}
 
+	eventsPublisher, err := publisher.New(cfg.RMQ.URL, cfg.Outbox.Exchange, publisher.Timeout(cfg.Outbox.Timeout))
+	if err != nil {
+		panic("app - Run - publisher.New: " + err.Error())
+	}
+
+	// The events of the wallets are received by every replica and sent to the streams of its clients
+	hub := stream.New()
+
+	eventsSubscriber, err := subscriber.New(cfg.RMQ.URL, cfg.Outbox.Exchange, []string{_walletEventsBindingKey}, hub.HandleMessage)
+	if err != nil {
+		panic("app - Run - subscriber.New: " + err.Error())
+	}
+
 	// Use cases
 	walletUseCase := walletUC.NewWallet(
 		gateway.New(rmqClient),
 		walletUC.Timeout(cfg.App.Timeout),
-		walletUC.DefaultBalance(cfg.App.DefaultBalance),
+		walletUC.DefaultBalance(entity.Money(cfg.App.DefaultBalance)),
+		walletUC.DefaultCurrency(cfg.App.DefaultCurrency),
 	)
 
 	workerUseCase := workerUC.NewWalletWorker(
 		repo.New(pg),
+		workerUC.HoldTTL(cfg.App.HoldTTL),
+		workerUC.HoldMaxTTL(cfg.App.HoldMaxTTL),
+		workerUC.Funding(newFundingProvider(cfg.Funding)),
+		workerUC.Fees(newFeeSchedule(cfg.Fees)),
+		workerUC.Webhooks(webhook.NewSender(webhook.Timeout(cfg.Webhooks.Timeout))),
+		workerUC.WebhookRetries(entity.WebhookRetryPolicy{
+			MaxAttempts: cfg.Webhooks.MaxAttempts,
+			MinBackoff:  cfg.Webhooks.MinBackoff,
+			MaxBackoff:  cfg.Webhooks.MaxBackoff,
+		}),
+		workerUC.Events(events.New(eventsPublisher)),
+		workerUC.ReconciliationMetrics(metrics.NewReconciliation(prometheus.DefaultRegisterer)),
 	)
+	// The fee wallets are checked once, instead of failing the first transfer with the fee
+	if err = workerUseCase.CheckFees(context.Background()); err != nil {
+		panic("app - Run - workerUseCase.CheckFees: " + err.Error())
+	}
 	// Init http server
+	rateLimitStore := newRateLimitStore(cfg.RateLimit, pg)
 	handler := gin.New()
-	v1.NewRouter(handler, log, walletUseCase)
+	if err = handler.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		panic("app - Run - handler.SetTrustedProxies: " + err.Error())
+	}
+	v1.NewRouter(handler, log, walletUseCase, newAuthenticator(cfg.Auth), newRateLimiter(cfg.RateLimit, rateLimitStore), hub)
 	httpServer := httpserver.New(log, handler, httpserver.Port(cfg.HTTP.Port), httpserver.WriteTimeout(cfg.HTTP.Timeout))
 
 	// Init rabbitMQ RPC Server
@@ -72,9 +160,167 @@ func New(
 		panic("app - Run - rmqServer - server.New" + err.Error())
 	}
 
+	// Init background jobs
+	jobs := scheduler.New(log)
+	jobs.Add("expireHolds", cfg.App.HoldsExpiry, workerUseCase.ExpireHolds)
+	jobs.Add("snapshotBalances", cfg.App.BalanceSnapshots, workerUseCase.SnapshotBalances)
+	jobs.Add("deliverWebhooks", cfg.Webhooks.Period, workerUseCase.DeliverWebhooks)
+	jobs.Add("relayOutbox", cfg.Outbox.Period, workerUseCase.RelayOutbox)
+	jobs.Add("pruneOutbox", cfg.Outbox.Prune, func(ctx context.Context) error {
+		return workerUseCase.PruneOutbox(ctx, cfg.Outbox.Retention)
+	})
+	jobs.Add("reconcileBalances", cfg.Reconciliation.Period, workerUseCase.Reconcile)
+	jobs.Add("settleFundings", cfg.Funding.SettlePeriod, func(ctx context.Context) error {
+		return workerUseCase.SettleFundings(ctx, cfg.Funding.SettleDelay)
+	})
+
+	if store, ok := rateLimitStore.(*ratelimit.PostgresStore); ok {
+		jobs.Add("pruneRateLimits", cfg.RateLimit.Prune, func(ctx context.Context) error {
+			return store.Prune(ctx, cfg.RateLimit.Prune)
+		})
+	}
+
 	return &App{
 		HTTPServer: httpServer,
 		RMQServer:  rmqServer,
+		Scheduler:  jobs,
+		Publisher:  eventsPublisher,
+		Subscriber: eventsSubscriber,
+		Streams:    hub,
 		DB:         pg,
 	}
 }
+
+// Creating the funding provider of the deposits and the withdrawals by the config.
+func newFundingProvider(cfg config.Funding) workerUC.FundingProvider {
+	switch cfg.Provider {
+	case "fake":
+		provider, err := funding.NewFake(cfg.FakeStatus)
+		if err != nil {
+			panic("app - Run - funding.NewFake: " + err.Error())
+		}
+
+		return provider
+	default:
+		panic("app - Run - unknown funding provider: " + cfg.Provider)
+	}
+}
+
+// Creating the schedule of the transfer fees by the config.
+func newFeeSchedule(cfg config.Fees) *entity.FeeSchedule {
+	schedule := &entity.FeeSchedule{
+		Wallets: cfg.Wallets,
+		Rules:   make([]entity.FeeRule, 0, len(cfg.Rules)),
+	}
+
+	for _, rule := range cfg.Rules {
+		tiers := make([]entity.FeeTier, 0, len(rule.Tiers))
+		for _, tier := range rule.Tiers {
+			tiers = append(tiers, entity.FeeTier{
+				UpTo:        entity.Money(tier.UpTo),
+				Flat:        entity.Money(tier.Flat),
+				BasisPoints: tier.BasisPoints,
+			})
+		}
+
+		schedule.Rules = append(schedule.Rules, entity.FeeRule{
+			Kind:        rule.Kind,
+			WalletType:  rule.WalletType,
+			Currency:    rule.Currency,
+			MinAmount:   entity.Money(rule.MinAmount),
+			MaxAmount:   entity.Money(rule.MaxAmount),
+			Flat:        entity.Money(rule.Flat),
+			BasisPoints: rule.BasisPoints,
+			MinFee:      entity.Money(rule.MinFee),
+			MaxFee:      entity.Money(rule.MaxFee),
+			Tiers:       tiers,
+		})
+	}
+
+	if !schedule.IsValid() {
+		panic("app - Run - wrong fee schedule")
+	}
+
+	return schedule
+}
+
+// Creating the authenticator of the API callers by the config.
+func newAuthenticator(cfg config.Auth) *auth.Authenticator {
+	opts := make([]auth.Option, 0, len(cfg.APIKeys)+1)
+
+	for _, key := range cfg.APIKeys {
+		opts = append(opts, auth.APIKey(key.Hash, key.Subject, key.Scopes))
+	}
+
+	if verifier := newJWTVerifier(cfg.JWT); verifier != nil {
+		opts = append(opts, auth.JWT(verifier))
+	}
+
+	return auth.New(opts...)
+}
+
+// Creating the verifier of the bearer tokens by the JWKS file or by the shared secret.
+// Returns nil if both are empty, then the bearer tokens are rejected.
+func newJWTVerifier(cfg config.JWT) *jwt.Verifier {
+	opts := []jwt.Option{
+		jwt.Issuer(cfg.Issuer),
+		jwt.Audience(cfg.Audience),
+		jwt.Leeway(cfg.Leeway),
+	}
+
+	switch {
+	case cfg.JWKSFile != "" && cfg.Secret != "":
+		panic("app - Run - both JWKS file and JWT secret are set")
+	case cfg.JWKSFile != "":
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			panic("app - Run - os.ReadFile: " + err.Error())
+		}
+
+		verifier, err := jwt.NewJWKS(data, opts...)
+		if err != nil {
+			panic("app - Run - jwt.NewJWKS: " + err.Error())
+		}
+
+		return verifier
+	case cfg.Secret != "":
+		verifier, err := jwt.NewHMAC([]byte(cfg.Secret), opts...)
+		if err != nil {
+			panic("app - Run - jwt.NewHMAC: " + err.Error())
+		}
+
+		return verifier
+	}
+
+	return nil
+}
+
+// Creating the storage of the rate limit buckets by the config.
+func newRateLimitStore(cfg config.RateLimit, pg *postgres.Postgres) ratelimit.Store {
+	switch cfg.Store {
+	case "memory":
+		return ratelimit.NewMemoryStore()
+	case "postgres":
+		return ratelimit.NewPostgresStore(pg)
+	default:
+		panic("app - Run - unknown rate limit store: " + cfg.Store)
+	}
+}
+
+// Creating the limiter of the API requests by the config.
+func newRateLimiter(cfg config.RateLimit, store ratelimit.Store) *ratelimit.Limiter {
+	principal := ratelimit.Limit{Rate: cfg.Principal.Rate, Burst: cfg.Principal.Burst}
+	ip := ratelimit.Limit{Rate: cfg.IP.Rate, Burst: cfg.IP.Burst}
+	wallet := ratelimit.Limit{Rate: cfg.Wallet.Rate, Burst: cfg.Wallet.Burst}
+
+	if !principal.IsValid() || !ip.IsValid() || !wallet.IsValid() {
+		panic("app - Run - wrong rate limits")
+	}
+
+	return ratelimit.New(
+		store,
+		ratelimit.Principal(principal),
+		ratelimit.IP(ip),
+		ratelimit.Wallet(wallet),
+	)
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Outcomes of the audited actions.
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	AuditOutcomeDenied  = "denied"
+)
+
+// Actor and actions, which are performed by the worker itself.
+// The resources of these actions are the paths of the api, so they are found together with the requests.
+const (
+	AuditActorSystem            = "system"
+	AuditActionExpireHold       = "expireHold"
+	AuditActionReconcile        = "reconcileBalances"
+	AuditResourceHolds          = "/api/v1/holds/"
+	AuditResourceReconciliation = "/api/v1/admin/reconciliation"
+)
+
+// Page size of the audit log.
+const (
+	DefaultAuditLimit = 50
+	MaxAuditLimit     = 100
+)
+
+// @Description Запись журнала аудита.
+type AuditEvent struct {
+	ID        int64           `json:"id"                  example:"1"                                                             description:"Уникальный ID записи"                   validate:"required"     pg:"id,pk"`                     //nolint:lll,tagalign // вот так то лучше
+	Time      time.Time       `json:"time"                example:"2024-05-05T12:00:00Z"                                          description:"Дата и время события"                   validate:"required"     format:"date-time"`             //nolint:lll,tagalign // вот так то лучше
+	Actor     string          `json:"actor"               example:"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"                          description:"Субъект, выполнивший действие"          validate:"required"`                                    //nolint:lll,tagalign // вот так то лучше
+	Action    string          `json:"action"              example:"POST /api/v1/admin/wallets/:walletId/freeze"                   description:"Действие"                               validate:"required"`                                    //nolint:lll,tagalign // вот так то лучше
+	Resource  string          `json:"resource"            example:"/api/v1/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze" description:"Ресурс, над которым выполнено действие" validate:"required"`                                    //nolint:lll,tagalign // вот так то лучше
+	Outcome   string          `json:"outcome"             example:"denied"                                                        description:"Результат действия"                     validate:"required"     enums:"success,failure,denied"` //nolint:lll,tagalign // вот так то лучше
+	Reason    string          `json:"reason,omitempty"    example:"missing permission wallet:freeze"                              description:"Причина результата"                     validate:"optional"`                                    //nolint:lll,tagalign // вот так то лучше
+	RequestID string          `json:"requestId,omitempty" example:"0f8c2d6e-3b1a-4e7f-9c5d-2a4b6c8d0e1f"                          description:"ID запроса, заголовок X-Request-Id"     validate:"optional"     pg:"request_id"`                //nolint:lll,tagalign // вот так то лучше
+	IP        string          `json:"ip,omitempty"        example:"203.0.113.7"                                                   description:"IP адрес клиента"                       validate:"optional"     pg:"ip"`                        //nolint:lll,tagalign // вот так то лучше
+	Payload   json.RawMessage `json:"payload,omitempty"   description:"Тело запроса или параметры действия воркера"               validate:"optional"                                  pg:"payload,type:jsonb" swaggertype:"object"`           //nolint:lll,tagalign // вот так то лучше
+}
+
+// Filter of the audit log. Empty fields are not applied.
+// Events are sorted from the newest to the oldest and are returned by pages,
+// the next page starts after the event with the id of the cursor.
+type AuditFilter struct {
+	Actor     string    `json:"actor,omitempty"`
+	Action    string    `json:"action,omitempty"`
+	Resource  string    `json:"resource,omitempty"`
+	Outcome   string    `json:"outcome,omitempty"`
+	RequestID string    `json:"requestId,omitempty"`
+	Since     time.Time `json:"since,omitempty"`
+	Until     time.Time `json:"until,omitempty"`
+	Cursor    int64     `json:"cursor,omitempty"`
+	Limit     int       `json:"limit,omitempty"`
+}
+
+// @Description Страница журнала аудита.
+type AuditPage struct {
+	Events     []AuditEvent `json:"events"               description:"Записи страницы от новых к старым"`                                                                            //nolint:lll,tagalign // вот так то лучше
+	NextCursor int64        `json:"nextCursor,omitempty" example:"1024"                                  description:"Курсор следующей страницы, отсутствует на последней странице"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking the values of the filter.
+func (f *AuditFilter) IsValid() bool {
+	switch f.Outcome {
+	case "", AuditOutcomeSuccess, AuditOutcomeFailure, AuditOutcomeDenied:
+	default:
+		return false
+	}
+
+	if !f.Since.IsZero() && !f.Until.IsZero() && !f.Since.Before(f.Until) {
+		return false
+	}
+
+	return f.Cursor >= 0 && f.Limit >= 0 && f.Limit <= MaxAuditLimit
+}
+
+// Getting the number of the events on the page.
+func (f *AuditFilter) PageSize() int {
+	if f.Limit == 0 {
+		return DefaultAuditLimit
+	}
+
+	return f.Limit
+}
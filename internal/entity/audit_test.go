@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_AuditFilterIsValid(t *testing.T) {
+	for _, test := range testsAuditFilterIsValid {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.filter.IsValid(), test.expected)
+		})
+	}
+}
+
+var testsAuditFilterIsValid = []struct {
+	name     string
+	filter   AuditFilter
+	expected bool
+}{
+	{
+		name:     "Empty",
+		filter:   AuditFilter{},
+		expected: true,
+	},
+	{
+		name: "Ok",
+		filter: AuditFilter{
+			Actor:   "operations",
+			Outcome: AuditOutcomeDenied,
+			Since:   time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC),
+			Until:   time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+			Cursor:  10,
+			Limit:   MaxAuditLimit,
+		},
+		expected: true,
+	},
+	{
+		name:     "Unknown outcome",
+		filter:   AuditFilter{Outcome: "unknown"},
+		expected: false,
+	},
+	{
+		name: "Empty period",
+		filter: AuditFilter{
+			Since: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC),
+		},
+		expected: false,
+	},
+	{
+		name:     "Negative cursor",
+		filter:   AuditFilter{Cursor: -1},
+		expected: false,
+	},
+	{
+		name:     "Too large limit",
+		filter:   AuditFilter{Limit: MaxAuditLimit + 1},
+		expected: false,
+	},
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// Balance of the wallet saved at the moment. Balances in the past are restored
+// from the latest snapshot before the moment and the ledger postings after it.
+type BalanceSnapshot struct {
+	tableName struct{} `pg:"wallet_balance_snapshots"` //nolint:unused // table name for go-pg
+
+	WalletID string    `pg:"wallet_id,pk"`
+	Time     time.Time `pg:"time,pk"`
+	Balance  Money     `pg:"balance,use_zero"`
+}
+
+// @Description Баланс кошелька на момент времени.
+type HistoricalBalance struct {
+	WalletID string    `json:"walletId" example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID кошелька"`                                                                          //nolint:lll,tagalign // вот так то лучше
+	At       time.Time `json:"at"       example:"2024-03-31T23:59:59Z"             description:"Момент времени"`                                                                       //nolint:lll,tagalign // вот так то лучше
+	Balance  Money     `json:"balance"  example:"10000"                            description:"Баланс кошелька на момент времени в минимальных единицах валюты" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Currency string    `json:"currency" example:"USD"                              description:"Валюта кошелька ISO 4217"`                                                             //nolint:lll,tagalign // вот так то лучше
+}
@@ -0,0 +1,71 @@
+package entity
+
+// Maximum number of the transfers in one batch.
+const MaxBatchSize = 1000
+
+// @Description Перевод в составе пакета.
+type TransferLeg struct {
+	From   string `json:"from"   example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID исходящего кошелька"                       validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	To     string `json:"to"     example:"eb376add88bf8e70f80787266a0801d5" description:"ID входящего кошелька"                        validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Amount Money  `json:"amount" example:"3000"                             description:"Сумма перевода в минимальных единицах валюты" validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+
+	TransferDetails
+}
+
+// @Description Ошибка перевода в составе пакета.
+type LegError struct {
+	Index int    `json:"index" example:"0"                description:"Номер перевода в пакете, начиная с нуля"` //nolint:lll,tagalign // вот так то лучше
+	Error string `json:"error" example:"not enough funds" description:"Причина отклонения перевода"`             //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Description Пакет переводов отклонен целиком.
+type BatchError struct {
+	Legs []LegError `json:"legs" description:"Ошибки переводов пакета"` //nolint:lll,tagalign // вот так то лучше
+}
+
+func (e *BatchError) Error() string {
+	return ErrBatchRejected.Error()
+}
+
+func (e *BatchError) Is(target error) bool {
+	return target == ErrBatchRejected
+}
+
+// Details - the errors of the legs are passed through rmq rpc with the error.
+func (e *BatchError) Details() interface{} {
+	return e
+}
+
+// Adding the error of the leg to the batch error.
+func (e *BatchError) Add(index int, err error) {
+	e.Legs = append(e.Legs, LegError{Index: index, Error: err.Error()})
+}
+
+// ValidateTransferLegs - checking the size of the batch and the transfers, which can be checked without wallets.
+// Returns *BatchError with the errors of all invalid legs.
+func ValidateTransferLegs(legs []TransferLeg) error {
+	if len(legs) == 0 || len(legs) > MaxBatchSize {
+		return ErrWrongBatchSize
+	}
+
+	batchErr := new(BatchError)
+
+	for i, leg := range legs {
+		switch {
+		case len(leg.From) == 0 || len(leg.To) == 0:
+			batchErr.Add(i, ErrEmptyWallet)
+		case leg.From == leg.To:
+			batchErr.Add(i, ErrSenderIsReceiver)
+		case !leg.Amount.IsPositive():
+			batchErr.Add(i, ErrWrongAmount)
+		case !leg.TransferDetails.IsValid():
+			batchErr.Add(i, ErrWrongTransferDetails)
+		}
+	}
+
+	if len(batchErr.Legs) > 0 {
+		return batchErr
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_ValidateTransferLegs(t *testing.T) {
+	for _, test := range testsValidateTransferLegs {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateTransferLegs(test.legs)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			var batchErr *BatchError
+
+			errors.As(err, &batchErr)
+			assert.Equal(t, batchErr, test.expectedBatchErr)
+		})
+	}
+}
+
+var testsValidateTransferLegs = []struct {
+	name             string
+	legs             []TransferLeg
+	expectedError    error
+	expectedBatchErr *BatchError
+}{
+	{
+		name: "Ok",
+		legs: []TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+		},
+		expectedError: nil,
+	},
+	{
+		name:          "Empty batch",
+		legs:          nil,
+		expectedError: ErrWrongBatchSize,
+	},
+	{
+		name:          "Too large batch",
+		legs:          make([]TransferLeg, MaxBatchSize+1),
+		expectedError: ErrWrongBatchSize,
+	},
+	{
+		name: "Errors of all invalid legs",
+		legs: []TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "", Amount: 100},
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "5b53700ed469fa6a09ea72bb78f36fd9", Amount: 100},
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 0},
+		},
+		expectedError: ErrBatchRejected,
+		expectedBatchErr: &BatchError{Legs: []LegError{
+			{Index: 0, Error: ErrEmptyWallet.Error()},
+			{Index: 2, Error: ErrSenderIsReceiver.Error()},
+			{Index: 3, Error: ErrWrongAmount.Error()},
+		}},
+	},
+}
@@ -0,0 +1,45 @@
+package entity
+
+// Currency codes (ISO 4217) with the number of digits after the decimal separator.
+var currencies = map[string]int{
+	"AED": 2,
+	"AMD": 2,
+	"AUD": 2,
+	"BYN": 2,
+	"BRL": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"GEL": 2,
+	"HKD": 2,
+	"INR": 2,
+	"JPY": 0,
+	"KGS": 2,
+	"KRW": 0,
+	"KZT": 2,
+	"NOK": 2,
+	"PLN": 2,
+	"RUB": 2,
+	"SEK": 2,
+	"SGD": 2,
+	"TRY": 2,
+	"UAH": 2,
+	"USD": 2,
+	"UZS": 2,
+}
+
+// Checking that the currency code is a supported ISO 4217 code.
+func IsValidCurrency(code string) bool {
+	_, ok := currencies[code]
+
+	return ok
+}
+
+// Getting the number of digits after the decimal separator of the currency.
+func CurrencyExponent(code string) int {
+	return currencies[code]
+}
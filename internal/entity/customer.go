@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"net/mail"
+	"time"
+	"unicode/utf8"
+)
+
+// Types of the customers.
+const (
+	CustomerTypeIndividual = "individual"
+	CustomerTypeCompany    = "company"
+)
+
+// Size limits of the customer profile.
+const (
+	MaxCustomerNameLength  = 255
+	MaxCustomerEmailLength = 254
+	MaxCustomerPhoneLength = 32
+)
+
+// @Description Клиент - владелец кошельков.
+type Customer struct {
+	ID        string    `json:"id"                example:"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21" description:"Уникальный ID клиента"               validate:"required" pg:"id,pk,type:uuid"` //nolint:lll,tagalign // вот так то лучше
+	Type      string    `json:"type"              example:"individual"                           description:"Тип клиента: individual или company" validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Name      string    `json:"name"              example:"Ivan Petrov"                          description:"Имя человека или название компании"  validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Email     string    `json:"email,omitempty"   example:"ivan@example.com"                     description:"Адрес электронной почты"             validate:"optional"`                      //nolint:lll,tagalign // вот так то лучше
+	Phone     string    `json:"phone,omitempty"   example:"+79991234567"                         description:"Номер телефона"                      validate:"optional"`                      //nolint:lll,tagalign // вот так то лучше
+	Country   string    `json:"country,omitempty" example:"RU"                                   description:"Страна клиента ISO 3166-1 alpha-2"   validate:"optional"`                      //nolint:lll,tagalign // вот так то лучше
+	CreatedAt time.Time `json:"createdAt"         example:"2024-02-04T17:25:35.448Z"             description:"Дата и время создания клиента"       validate:"required" format:"date-time"`   //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking that the profile of the customer is complete and fits the size limits.
+func (c *Customer) IsValid() bool {
+	if c.Type != CustomerTypeIndividual && c.Type != CustomerTypeCompany {
+		return false
+	}
+
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > MaxCustomerNameLength ||
+		len(c.Email) > MaxCustomerEmailLength ||
+		len(c.Phone) > MaxCustomerPhoneLength {
+		return false
+	}
+
+	if c.Email != "" {
+		if address, err := mail.ParseAddress(c.Email); err != nil || address.Address != c.Email {
+			return false
+		}
+	}
+
+	return c.Country == "" || isCountryCode(c.Country)
+}
+
+// Checking that the code looks like ISO 3166-1 alpha-2: two uppercase latin letters.
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
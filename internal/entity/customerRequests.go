@@ -0,0 +1,5 @@
+package entity
+
+type GetCustomerByIDRequest struct {
+	CustomerID string `json:"customerId"`
+}
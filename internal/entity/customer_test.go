@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_CustomerIsValid(t *testing.T) {
+	for _, test := range testsCustomerIsValid {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.customer.IsValid(), test.expected)
+		})
+	}
+}
+
+var testsCustomerIsValid = []struct {
+	name     string
+	customer Customer
+	expected bool
+}{
+	{
+		name:     "Ok - full profile",
+		customer: Customer{Type: CustomerTypeIndividual, Name: "Ivan Petrov", Email: "ivan@example.com", Phone: "+79991234567", Country: "RU"},
+		expected: true,
+	},
+	{
+		name:     "Ok - only name",
+		customer: Customer{Type: CustomerTypeCompany, Name: "Acme"},
+		expected: true,
+	},
+	{
+		name:     "Unknown type",
+		customer: Customer{Type: "robot", Name: "Acme"},
+		expected: false,
+	},
+	{
+		name:     "Empty name",
+		customer: Customer{Type: CustomerTypeCompany},
+		expected: false,
+	},
+	{
+		name:     "Too long name",
+		customer: Customer{Type: CustomerTypeCompany, Name: strings.Repeat("a", MaxCustomerNameLength+1)},
+		expected: false,
+	},
+	{
+		name:     "Email with display name",
+		customer: Customer{Type: CustomerTypeIndividual, Name: "Ivan", Email: "Ivan <ivan@example.com>"},
+		expected: false,
+	},
+	{
+		name:     "Lowercase country",
+		customer: Customer{Type: CustomerTypeIndividual, Name: "Ivan", Country: "ru"},
+		expected: false,
+	},
+}
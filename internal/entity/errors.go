@@ -2,7 +2,9 @@ package entity
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 
 	rmqrpc "github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc"
 )
@@ -11,10 +13,212 @@ var (
 	// Wallet errors.
 	ErrWalletNotFound   = errors.New("wallet not found")
 	ErrWrongAmount      = errors.New("wrong amount")
+	ErrAmountOverflow   = errors.New("amount overflow")
+	ErrNotEnoughFunds   = errors.New("not enough funds")
 	ErrSenderIsReceiver = errors.New("sender is receiver")
 	ErrEmptyWallet      = errors.New("wallet address is empty")
+	ErrWalletFrozen     = errors.New("wallet is frozen")
+	ErrWalletClosed     = errors.New("wallet is closed")
+	ErrWalletHasHolds   = errors.New("wallet has active holds")
+	ErrWalletHasFunding = errors.New("wallet has pending fundings")
+	ErrWrongBalanceTime = errors.New("wrong balance time")
+	ErrWrongWalletType  = errors.New("wrong wallet type")
+
+	// Customer errors.
+	ErrCustomerNotFound = errors.New("customer not found")
+	ErrWrongCustomer    = errors.New("wrong customer profile")
+
+	// Access errors.
+	ErrRoleNotFound = errors.New("role not found")
+	ErrWrongRole    = errors.New("wrong role")
+	ErrWrongSubject = errors.New("wrong subject")
+
+	// Audit errors.
+	ErrWrongAuditFilter = errors.New("wrong audit filter")
+
+	// Webhook errors.
+	ErrWebhookNotFound            = errors.New("webhook not found")
+	ErrWrongWebhook               = errors.New("wrong webhook subscription")
+	ErrWebhookDeliveryNotFound    = errors.New("webhook delivery not found")
+	ErrWrongWebhookDeliveryFilter = errors.New("wrong webhook delivery filter")
+
+	// Reconciliation errors.
+	ErrReconciliationNotFound = errors.New("reconciliation report not found")
+	ErrReconciliationRunning  = errors.New("reconciliation is already running")
+
+	// Currency errors.
+	ErrWrongCurrency    = errors.New("wrong currency")
+	ErrCurrencyMismatch = errors.New("currencies of wallets are different")
+
+	// Limit errors.
+	ErrLimitExceeded = errors.New("spending limit exceeded")
+	ErrWrongLimit    = errors.New("wrong spending limit")
+
+	// Fee errors.
+	ErrFeeWalletNotConfigured = errors.New("fee wallet is not configured")
+
+	// Batch errors.
+	ErrBatchRejected  = errors.New("batch is rejected")
+	ErrWrongBatchSize = errors.New("wrong batch size")
+
+	// Funding errors.
+	ErrFundingUnavailable = errors.New("funding provider is unavailable")
+	ErrFundingNotPending  = errors.New("funding is not pending")
+
+	// Transaction errors.
+	ErrTransactionNotFound      = errors.New("transaction not found")
+	ErrWrongTransferDetails     = errors.New("wrong transfer details")
+	ErrWrongHistoryFilter       = errors.New("wrong history filter")
+	ErrTransactionNotRefundable = errors.New("transaction is not refundable")
+	ErrRefundExceedsAmount      = errors.New("refund exceeds transaction amount")
+
+	// Statement errors.
+	ErrWrongStatementPeriod = errors.New("wrong statement period")
+	ErrStatementTooLarge    = errors.New("statement is too large")
+
+	// Hold errors.
+	ErrHoldNotFound  = errors.New("hold not found")
+	ErrHoldNotActive = errors.New("hold is not active")
+	ErrWrongHoldTTL  = errors.New("wrong hold ttl")
+
+	// Ledger errors.
+	ErrUnbalancedEntry = errors.New("journal entry is not balanced")
+
+	// Idempotency errors.
+	ErrWrongIdempotencyKey  = errors.New("wrong idempotency key")
+	ErrIdempotencyKeyReused = errors.New("idempotency key is reused with another request")
 
 	// Requset errors.
-	ErrTimeout  = context.DeadlineExceeded
-	ErrNotFound = rmqrpc.ErrNotFound
+	ErrTimeout    = context.DeadlineExceeded
+	ErrNotFound   = rmqrpc.ErrNotFound
+	ErrCallStatus = rmqrpc.ErrCallStatus
 )
+
+// Domain errors, which are passed through rmq rpc as call status.
+var remoteErrors = []error{
+	ErrWalletNotFound,
+	ErrWrongAmount,
+	ErrAmountOverflow,
+	ErrNotEnoughFunds,
+	ErrSenderIsReceiver,
+	ErrEmptyWallet,
+	ErrWalletFrozen,
+	ErrWalletClosed,
+	ErrWalletHasHolds,
+	ErrWalletHasFunding,
+	ErrWrongBalanceTime,
+	ErrWrongWalletType,
+	ErrCustomerNotFound,
+	ErrWrongCustomer,
+	ErrRoleNotFound,
+	ErrWrongRole,
+	ErrWrongSubject,
+	ErrWrongAuditFilter,
+	ErrWebhookNotFound,
+	ErrWrongWebhook,
+	ErrWebhookDeliveryNotFound,
+	ErrWrongWebhookDeliveryFilter,
+	ErrReconciliationNotFound,
+	ErrReconciliationRunning,
+	ErrWrongCurrency,
+	ErrCurrencyMismatch,
+	ErrLimitExceeded,
+	ErrWrongLimit,
+	ErrBatchRejected,
+	ErrWrongBatchSize,
+	ErrFundingUnavailable,
+	ErrFundingNotPending,
+	ErrTransactionNotFound,
+	ErrWrongTransferDetails,
+	ErrWrongHistoryFilter,
+	ErrTransactionNotRefundable,
+	ErrRefundExceedsAmount,
+	ErrHoldNotFound,
+	ErrHoldNotActive,
+	ErrWrongHoldTTL,
+	ErrWrongIdempotencyKey,
+	ErrIdempotencyKeyReused,
+	ErrWrongStatementPeriod,
+	ErrStatementTooLarge,
+	ErrFeeWalletNotConfigured,
+}
+
+// Domain errors, which details are passed through rmq rpc as well.
+var detailedErrors = map[error]func() rmqrpc.DetailedError{
+	ErrLimitExceeded: func() rmqrpc.DetailedError { return new(LimitExceededError) },
+	ErrBatchRejected: func() rmqrpc.DetailedError { return new(BatchError) },
+}
+
+// ToRemoteError - finding the domain error in the chain, which can be passed through rmq rpc.
+// Returns nil if there is no such error.
+func ToRemoteError(err error) error {
+	var detailed rmqrpc.DetailedError
+	if errors.As(err, &detailed) {
+		return detailed
+	}
+
+	for _, remoteErr := range remoteErrors {
+		if errors.Is(err, remoteErr) {
+			return remoteErr
+		}
+	}
+
+	return nil
+}
+
+// FromRemoteError - restoring the domain error from the rmq rpc call status.
+// Returns nil if the call status does not contain a domain error.
+func FromRemoteError(err error) error {
+	if !errors.Is(err, ErrCallStatus) {
+		return nil
+	}
+
+	var details []byte
+
+	var callErr *rmqrpc.CallError
+	if errors.As(err, &callErr) {
+		details = callErr.Details
+	}
+
+	return ErrorWithDetails(strings.TrimPrefix(err.Error(), ErrCallStatus.Error()+": "), details)
+}
+
+// ErrorWithDetails - restoring the domain error by its message and the JSON of its details.
+// Returns nil if the error is unknown, and the error without details if they can't be restored.
+func ErrorWithDetails(message string, details []byte) error {
+	domainErr := ErrorByMessage(message)
+	// Restoring the details of the error, if they are passed
+	newDetailed, ok := detailedErrors[domainErr]
+	if !ok || len(details) == 0 {
+		return domainErr
+	}
+
+	detailed := newDetailed()
+	if json.Unmarshal(details, detailed) != nil {
+		return domainErr
+	}
+
+	return detailed
+}
+
+// ErrorDetails - getting the JSON of the error details, so the error can be restored by ErrorWithDetails.
+// Returns nil if the error has no details.
+func ErrorDetails(err error) ([]byte, error) {
+	var detailed rmqrpc.DetailedError
+	if !errors.As(err, &detailed) {
+		return nil, nil
+	}
+
+	return json.Marshal(detailed.Details())
+}
+
+// ErrorByMessage - getting the domain error by its message. Returns nil if it is unknown.
+func ErrorByMessage(message string) error {
+	for _, remoteErr := range remoteErrors {
+		if remoteErr.Error() == message {
+			return remoteErr
+		}
+	}
+
+	return nil
+}
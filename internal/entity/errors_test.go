@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	rmqrpc "github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_FromRemoteError(t *testing.T) {
+	for _, test := range testsFromRemoteError {
+		t.Run(test.name, func(t *testing.T) {
+			err := FromRemoteError(test.err)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			var limitErr *LimitExceededError
+
+			errors.As(err, &limitErr)
+			assert.Equal(t, limitErr, test.expectedLimitErr)
+		})
+	}
+}
+
+var testsFromRemoteError = []struct {
+	name             string
+	err              error
+	expectedError    error
+	expectedLimitErr *LimitExceededError
+}{
+	{
+		name:          "Domain error",
+		err:           &rmqrpc.CallError{Status: ErrNotEnoughFunds.Error()},
+		expectedError: ErrNotEnoughFunds,
+	},
+	{
+		name: "Domain error with details",
+		err: &rmqrpc.CallError{
+			Status:  ErrLimitExceeded.Error(),
+			Details: []byte(`{"limit":"monthly","remaining":"250"}`),
+		},
+		expectedError:    ErrLimitExceeded,
+		expectedLimitErr: &LimitExceededError{Limit: LimitMonthly, Remaining: 250},
+	},
+	{
+		name: "Domain error with broken details",
+		err: &rmqrpc.CallError{
+			Status:  ErrLimitExceeded.Error(),
+			Details: []byte(`{"limit":`),
+		},
+		expectedError: ErrLimitExceeded,
+	},
+	{
+		name:          "Unknown call status",
+		err:           &rmqrpc.CallError{Status: "something went wrong"},
+		expectedError: nil,
+	},
+	{
+		name:          "Not a call status",
+		err:           errors.New("something went wrong"),
+		expectedError: nil,
+	},
+}
+
+func Test_ErrorDetails(t *testing.T) {
+	for _, test := range testsErrorDetails {
+		t.Run(test.name, func(t *testing.T) {
+			details, err := ErrorDetails(test.err)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, string(details), test.expectedDetails)
+			// The stored error is restored with its details
+			restored := ErrorWithDetails(test.err.Error(), details)
+			assert.Equal(t, restored, test.err)
+		})
+	}
+}
+
+var testsErrorDetails = []struct {
+	name            string
+	err             error
+	expectedDetails string
+}{
+	{
+		name:            "Error with details",
+		err:             &LimitExceededError{Limit: LimitDaily, Remaining: 1500},
+		expectedDetails: `{"limit":"daily","remaining":"1500"}`,
+	},
+	{
+		name:            "Error without details",
+		err:             ErrNotEnoughFunds,
+		expectedDetails: "",
+	},
+}
@@ -0,0 +1,180 @@
+package entity
+
+// Kinds of the fee rules.
+const (
+	FeeRuleFlat       = "flat"
+	FeeRulePercentage = "percentage"
+	FeeRuleTiered     = "tiered"
+)
+
+// Percentage fees are set in basis points, hundredths of a percent.
+const BasisPointsInWhole = 10000
+
+// Rule of the transfer fee. The rule is applied to the transfers from the wallets of the type,
+// in the currency and with the amount in the range. Empty conditions match all transfers.
+type FeeRule struct {
+	Kind       string
+	WalletType string
+	Currency   string
+	MinAmount  Money
+	MaxAmount  Money
+	// Fee of the flat rule.
+	Flat Money
+	// Fee of the percentage rule in basis points and its bounds. Zero maximum does not bound the fee.
+	BasisPoints int64
+	MinFee      Money
+	MaxFee      Money
+	// Tiers of the tiered rule in the ascending order of the amounts.
+	Tiers []FeeTier
+}
+
+// Tier of the tiered fee rule. The fee of the tier is applied to the transfers
+// with the amount up to the bound. Zero bound of the last tier does not bound the amount.
+type FeeTier struct {
+	UpTo        Money
+	Flat        Money
+	BasisPoints int64
+}
+
+// Schedule of the transfer fees. The first matching rule is applied,
+// the fee goes to the fee wallet of the transfer currency.
+type FeeSchedule struct {
+	Wallets map[string]string
+	Rules   []FeeRule
+}
+
+// Checking that the rule is complete and its fees are not negative.
+func (r *FeeRule) IsValid() bool {
+	if r.MinAmount < 0 || r.MaxAmount < 0 || (r.MaxAmount > 0 && r.MaxAmount < r.MinAmount) {
+		return false
+	}
+
+	switch r.Kind {
+	case FeeRuleFlat:
+		return r.Flat >= 0
+	case FeeRulePercentage:
+		return isValidBasisPoints(r.BasisPoints) &&
+			r.MinFee >= 0 && r.MaxFee >= 0 && (r.MaxFee == 0 || r.MaxFee >= r.MinFee)
+	case FeeRuleTiered:
+		return areValidTiers(r.Tiers)
+	}
+
+	return false
+}
+
+// Checking that the rule is applied to the transfer from the wallet of the type.
+func (r *FeeRule) Matches(walletType, currency string, amount Money) bool {
+	return (r.WalletType == "" || r.WalletType == walletType) &&
+		(r.Currency == "" || r.Currency == currency) &&
+		amount >= r.MinAmount &&
+		(r.MaxAmount == 0 || amount <= r.MaxAmount)
+}
+
+// Calculating the fee of the transfer amount by the rule.
+func (r *FeeRule) Fee(amount Money) (Money, error) {
+	switch r.Kind {
+	case FeeRuleFlat:
+		return r.Flat, nil
+	case FeeRulePercentage:
+		fee := percentage(amount, r.BasisPoints)
+		if fee < r.MinFee {
+			fee = r.MinFee
+		}
+
+		if r.MaxFee > 0 && fee > r.MaxFee {
+			fee = r.MaxFee
+		}
+
+		return fee, nil
+	case FeeRuleTiered:
+		for _, tier := range r.Tiers {
+			if tier.UpTo == 0 || amount <= tier.UpTo {
+				return tier.Flat.Add(percentage(amount, tier.BasisPoints))
+			}
+		}
+		// The amount is above the last tier
+		return 0, nil
+	}
+
+	return 0, nil
+}
+
+// Checking that all rules of the schedule are valid and the fee wallets are set.
+func (s *FeeSchedule) IsValid() bool {
+	for currency, walletID := range s.Wallets {
+		if !IsValidCurrency(currency) || walletID == "" {
+			return false
+		}
+	}
+
+	for i := range s.Rules {
+		if !s.Rules[i].IsValid() {
+			return false
+		}
+		// The fees of the rule in the explicit currency must have the wallet to go to
+		if _, ok := s.Wallets[s.Rules[i].Currency]; s.Rules[i].Currency != "" && !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Getting the fee of the transfer by the first matching rule. Returns zero if no rule matches.
+func (s *FeeSchedule) Fee(walletType, currency string, amount Money) (Money, error) {
+	for i := range s.Rules {
+		if s.Rules[i].Matches(walletType, currency, amount) {
+			return s.Rules[i].Fee(amount)
+		}
+	}
+
+	return 0, nil
+}
+
+// Getting the wallet, which collects the fees in the currency.
+func (s *FeeSchedule) Wallet(currency string) (string, bool) {
+	walletID, ok := s.Wallets[currency]
+
+	return walletID, ok
+}
+
+// Calculating the share of the amount in basis points rounded half up.
+// The amount is split to avoid the overflow of the multiplication.
+func percentage(amount Money, basisPoints int64) Money {
+	whole, rest := int64(amount)/BasisPointsInWhole, int64(amount)%BasisPointsInWhole
+
+	return Money(whole*basisPoints + (rest*basisPoints+BasisPointsInWhole/2)/BasisPointsInWhole)
+}
+
+// Checking that the percentage is not negative and does not exceed the whole amount.
+func isValidBasisPoints(basisPoints int64) bool {
+	return basisPoints >= 0 && basisPoints <= BasisPointsInWhole
+}
+
+// Checking that the tiers are set in the ascending order and only the last one is unbounded.
+func areValidTiers(tiers []FeeTier) bool {
+	if len(tiers) == 0 {
+		return false
+	}
+
+	var previous Money
+
+	for i, tier := range tiers {
+		if tier.Flat < 0 || !isValidBasisPoints(tier.BasisPoints) {
+			return false
+		}
+
+		last := i == len(tiers)-1
+		if tier.UpTo == 0 && last {
+			continue
+		}
+
+		if tier.UpTo <= previous {
+			return false
+		}
+
+		previous = tier.UpTo
+	}
+
+	return true
+}
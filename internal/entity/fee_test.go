@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+var testFeeSchedule = FeeSchedule{
+	Wallets: map[string]string{"USD": "5b53700ed469fa6a09ea72bb78f36fd9", "EUR": "0c7c1d9f5e219b2f4a368c0e4b8e9a55"},
+	Rules: []FeeRule{
+		{Kind: FeeRuleFlat, WalletType: WalletTypeMerchant, Flat: 10},
+		{Kind: FeeRulePercentage, WalletType: WalletTypeBusiness, BasisPoints: 150, MinFee: 50, MaxFee: 1000},
+		{Kind: FeeRuleFlat, Currency: "EUR", MaxAmount: 1000, Flat: 0},
+		{Kind: FeeRuleTiered, Tiers: []FeeTier{
+			{UpTo: 10000, Flat: 25},
+			{UpTo: 100000, Flat: 25, BasisPoints: 50},
+			{BasisPoints: 25},
+		}},
+	},
+}
+
+func Test_FeeScheduleFee(t *testing.T) {
+	for _, test := range testsFeeScheduleFee {
+		t.Run(test.name, func(t *testing.T) {
+			fee, err := testFeeSchedule.Fee(test.walletType, test.currency, test.amount)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+
+			assert.Equal(t, fee, test.expected)
+		})
+	}
+}
+
+var testsFeeScheduleFee = []struct {
+	name       string
+	walletType string
+	currency   string
+	amount     Money
+	expected   Money
+}{
+	{
+		name:       "Flat by wallet type",
+		walletType: WalletTypeMerchant,
+		currency:   "USD",
+		amount:     1000000,
+		expected:   10,
+	},
+	{
+		name:       "Percentage",
+		walletType: WalletTypeBusiness,
+		currency:   "USD",
+		amount:     10000,
+		expected:   150,
+	},
+	{
+		name:       "Percentage is rounded half up",
+		walletType: WalletTypeBusiness,
+		currency:   "USD",
+		amount:     4034,
+		expected:   61,
+	},
+	{
+		name:       "Percentage below the minimum fee",
+		walletType: WalletTypeBusiness,
+		currency:   "USD",
+		amount:     1000,
+		expected:   50,
+	},
+	{
+		name:       "Percentage above the maximum fee",
+		walletType: WalletTypeBusiness,
+		currency:   "USD",
+		amount:     1000000,
+		expected:   1000,
+	},
+	{
+		name:       "Free by amount",
+		walletType: WalletTypePersonal,
+		currency:   "EUR",
+		amount:     1000,
+		expected:   0,
+	},
+	{
+		name:       "First tier",
+		walletType: WalletTypePersonal,
+		currency:   "EUR",
+		amount:     10000,
+		expected:   25,
+	},
+	{
+		name:       "Second tier",
+		walletType: WalletTypePersonal,
+		currency:   "USD",
+		amount:     50000,
+		expected:   275,
+	},
+	{
+		name:       "Last unbounded tier",
+		walletType: WalletTypePersonal,
+		currency:   "USD",
+		amount:     1000000,
+		expected:   2500,
+	},
+}
+
+func Test_FeeScheduleIsValid(t *testing.T) {
+	assert.Equal(t, testFeeSchedule.IsValid(), true)
+	assert.Equal(t, (&FeeSchedule{}).IsValid(), true)
+
+	for _, wrong := range []FeeSchedule{
+		{Wallets: map[string]string{"usd": "5b53700ed469fa6a09ea72bb78f36fd9"}},
+		{Wallets: map[string]string{"USD": ""}},
+		{Rules: []FeeRule{{Kind: "free"}}},
+		{Rules: []FeeRule{{Kind: FeeRuleFlat, Currency: "EUR", Flat: 10}}},
+		{Rules: []FeeRule{{Kind: FeeRuleFlat, Flat: -1}}},
+		{Rules: []FeeRule{{Kind: FeeRuleFlat, MinAmount: 100, MaxAmount: 10}}},
+		{Rules: []FeeRule{{Kind: FeeRulePercentage, BasisPoints: BasisPointsInWhole + 1}}},
+		{Rules: []FeeRule{{Kind: FeeRulePercentage, BasisPoints: 100, MinFee: 100, MaxFee: 10}}},
+		{Rules: []FeeRule{{Kind: FeeRuleTiered}}},
+		{Rules: []FeeRule{{Kind: FeeRuleTiered, Tiers: []FeeTier{{UpTo: 100}, {UpTo: 100}}}}},
+		{Rules: []FeeRule{{Kind: FeeRuleTiered, Tiers: []FeeTier{{Flat: 10}, {UpTo: 100}}}}},
+	} {
+		assert.Equal(t, wrong.IsValid(), false)
+	}
+}
@@ -0,0 +1,18 @@
+package entity
+
+// Outcome of the deposit or the withdrawal reported by the funding provider.
+// Status is one of the transaction statuses.
+type FundingResult struct {
+	Status    string
+	Reference string
+}
+
+// Checking that the status of the funding outcome is known.
+func (r *FundingResult) IsValid() bool {
+	switch r.Status {
+	case TransactionStatusPending, TransactionStatusCompleted, TransactionStatusFailed:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+// Directions of the transactions relative to the wallet.
+const (
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+)
+
+// Page size of the wallet transactions history.
+const (
+	DefaultHistoryLimit = 50
+	MaxHistoryLimit     = 100
+)
+
+// Filter of the wallet transactions history. Empty fields are not applied.
+// Transactions are sorted from the newest to the oldest and are returned by pages,
+// the next page starts after the transaction encoded in the cursor.
+type HistoryFilter struct {
+	Reference    string    `json:"reference,omitempty"`
+	Direction    string    `json:"direction,omitempty"`
+	Counterparty string    `json:"counterparty,omitempty"`
+	Since        time.Time `json:"since,omitempty"`
+	Until        time.Time `json:"until,omitempty"`
+	MinAmount    Money     `json:"minAmount,omitempty"`
+	MaxAmount    Money     `json:"maxAmount,omitempty"`
+	Cursor       string    `json:"cursor,omitempty"`
+	Limit        int       `json:"limit,omitempty"`
+}
+
+// @Description Страница истории транзакций кошелька.
+type HistoryPage struct {
+	Transactions []Transaction `json:"transactions"         description:"Транзакции страницы от новых к старым"`                                                                               //nolint:lll,tagalign // вот так то лучше
+	NextCursor   string        `json:"nextCursor,omitempty" example:"MjAyNC0wMi0wNFQxNzoyNTozNS40NDhafDdjOWU2Njc5" description:"Курсор следующей страницы, отсутствует на последней странице"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// Position of the transaction in the history, after which the next page starts.
+type HistoryCursor struct {
+	Time time.Time
+	ID   string
+}
+
+// Checking the filter values, which can be checked without wallets.
+func (f *HistoryFilter) IsValid() bool {
+	if f.Direction != "" && f.Direction != DirectionIncoming && f.Direction != DirectionOutgoing {
+		return false
+	}
+
+	if f.Limit < 0 || f.Limit > MaxHistoryLimit || f.MinAmount < 0 || f.MaxAmount < 0 {
+		return false
+	}
+
+	if f.MinAmount > 0 && f.MaxAmount > 0 && f.MinAmount > f.MaxAmount {
+		return false
+	}
+
+	if !f.Since.IsZero() && !f.Until.IsZero() && !f.Since.Before(f.Until) {
+		return false
+	}
+
+	if f.Cursor != "" {
+		if _, err := ParseHistoryCursor(f.Cursor); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Getting the page size, the default one is used if the limit is not set.
+func (f *HistoryFilter) PageSize() int {
+	if f.Limit == 0 {
+		return DefaultHistoryLimit
+	}
+
+	return f.Limit
+}
+
+// Encoding the cursor to the opaque string, which is passed to the client.
+func (c HistoryCursor) String() string {
+	raw := c.Time.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decoding the cursor, which is received from the client.
+func ParseHistoryCursor(cursor string) (HistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return HistoryCursor{}, ErrWrongHistoryFilter
+	}
+
+	timePart, id, found := strings.Cut(string(raw), "|")
+	if !found || id == "" {
+		return HistoryCursor{}, ErrWrongHistoryFilter
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, timePart)
+	if err != nil {
+		return HistoryCursor{}, ErrWrongHistoryFilter
+	}
+
+	return HistoryCursor{Time: t, ID: id}, nil
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_HistoryCursor(t *testing.T) {
+	cursor := HistoryCursor{
+		Time: time.Date(2024, 2, 4, 17, 25, 35, 448123000, time.UTC),
+		ID:   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+	}
+
+	parsed, err := ParseHistoryCursor(cursor.String())
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	assert.Equal(t, parsed.Time.Equal(cursor.Time), true)
+	assert.Equal(t, parsed.ID, cursor.ID)
+
+	for _, wrong := range []string{"not a cursor", "MjAyNC0wMi0wNA", "fDdjOWU2Njc5"} {
+		if _, err = ParseHistoryCursor(wrong); !errors.Is(err, ErrWrongHistoryFilter) {
+			t.Errorf("expected %v, got %v", ErrWrongHistoryFilter, err)
+		}
+	}
+}
+
+func Test_HistoryFilterIsValid(t *testing.T) {
+	for _, test := range testsHistoryFilterIsValid {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.filter.IsValid(), test.expected)
+		})
+	}
+}
+
+var testsHistoryFilterIsValid = []struct {
+	name     string
+	filter   HistoryFilter
+	expected bool
+}{
+	{
+		name:     "Empty",
+		filter:   HistoryFilter{},
+		expected: true,
+	},
+	{
+		name: "All fields",
+		filter: HistoryFilter{
+			Direction:    DirectionIncoming,
+			Counterparty: "eb376add88bf8e70f80787266a0801d5",
+			Since:        time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			Until:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			MinAmount:    10,
+			MaxAmount:    10,
+			Cursor:       HistoryCursor{Time: time.Now(), ID: "7c9e6679-7425-40de-944b-e07fc1f90ae7"}.String(),
+			Limit:        MaxHistoryLimit,
+		},
+		expected: true,
+	},
+	{
+		name:     "Wrong direction",
+		filter:   HistoryFilter{Direction: "sideways"},
+		expected: false,
+	},
+	{
+		name:     "Negative limit",
+		filter:   HistoryFilter{Limit: -1},
+		expected: false,
+	},
+	{
+		name:     "Too large limit",
+		filter:   HistoryFilter{Limit: MaxHistoryLimit + 1},
+		expected: false,
+	},
+	{
+		name:     "Min amount is greater than max amount",
+		filter:   HistoryFilter{MinAmount: 100, MaxAmount: 10},
+		expected: false,
+	},
+	{
+		name: "Empty date range",
+		filter: HistoryFilter{
+			Since: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Until: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		expected: false,
+	},
+	{
+		name:     "Wrong cursor",
+		filter:   HistoryFilter{Cursor: "not a cursor"},
+		expected: false,
+	},
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// Statuses of the hold.
+const (
+	HoldStatusAuthorized = "authorized"
+	HoldStatusCaptured   = "captured"
+	HoldStatusVoided     = "voided"
+	HoldStatusExpired    = "expired"
+)
+
+// @Description Резервирование средств кошелька.
+type Hold struct {
+	ID             string    `json:"id"                       example:"0f8fad5b-d9cb-469f-a165-70867728950e" description:"Уникальный ID резерва"                            validate:"required" pg:"id,pk,type:uuid"`                      //nolint:lll,tagalign // вот так то лучше
+	WalletID       string    `json:"walletId"                 example:"5b53700ed469fa6a09ea72bb78f36fd9"     description:"ID кошелька"                                      validate:"required" pg:"wallet_id"`                            //nolint:lll,tagalign // вот так то лучше
+	Amount         Money     `json:"amount"                   example:"3000"                                 description:"Зарезервированная сумма в минимальных единицах"   validate:"required" pg:"amount"          swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Currency       string    `json:"currency"                 example:"USD"                                  description:"Валюта резерва ISO 4217"                          validate:"required" pg:"currency"`                             //nolint:lll,tagalign // вот так то лучше
+	Status         string    `json:"status"                   example:"authorized"                           description:"Статус: authorized, captured, voided или expired" validate:"required" pg:"status"`                               //nolint:lll,tagalign // вот так то лучше
+	CapturedTo     string    `json:"capturedTo,omitempty"     example:"eb376add88bf8e70f80787266a0801d5"     description:"ID кошелька, куда списаны средства"               validate:"optional" pg:"captured_to"`                          //nolint:lll,tagalign // вот так то лучше
+	CapturedAmount Money     `json:"capturedAmount,omitempty" example:"2000"                                 description:"Списанная сумма в минимальных единицах"           validate:"optional" pg:"captured_amount" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	ExpiresAt      time.Time `json:"expiresAt"                example:"2024-02-04T17:25:35.448Z"             description:"Дата и время истечения резерва"                   validate:"required" pg:"expires_at"      format:"date-time"`   //nolint:lll,tagalign // вот так то лучше
+	CreatedAt      time.Time `json:"createdAt"                example:"2024-02-04T17:25:35.448Z"             description:"Дата и время создания резерва"                    validate:"required" pg:"created_at"      format:"date-time"`   //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking that the hold still reserves funds.
+func (h *Hold) IsActive(now time.Time) bool {
+	return h.Status == HoldStatusAuthorized && now.Before(h.ExpiresAt)
+}
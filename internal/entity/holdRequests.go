@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+type AuthorizeHoldRequest struct {
+	WalletID string        `json:"walletId"`
+	Amount   Money         `json:"amount"`
+	TTL      time.Duration `json:"ttl"`
+}
+
+type CaptureHoldRequest struct {
+	HoldID string `json:"holdId"`
+	To     string `json:"to"`
+	Amount Money  `json:"amount"`
+}
+
+type VoidHoldRequest struct {
+	HoldID string `json:"holdId"`
+}
+
+type GetHoldByIDRequest struct {
+	HoldID string `json:"holdId"`
+}
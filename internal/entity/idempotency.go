@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// Idempotency key of the sending funds request. Keys are unique within the sender wallet.
+type IdempotencyKey struct {
+	WalletID      string    `pg:"wallet_id,pk"`
+	Key           string    `pg:"key,pk"`
+	RequestHash   string    `pg:"request_hash"`
+	Error         string    `pg:"error,use_zero"`
+	ErrorDetails  string    `pg:"error_details,use_zero"`
+	TransactionID string    `pg:"transaction_id,type:uuid"`
+	CreatedAt     time.Time `pg:"created_at"`
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// Types of the ledger accounts.
+const (
+	AccountTypeWallet = "wallet"
+	AccountTypeSystem = "system"
+)
+
+// System ledger accounts for money entering and leaving the system.
+const (
+	SystemAccountIssuance   = "system:issuance"
+	SystemAccountRedemption = "system:redemption"
+	SystemAccountFunding    = "system:funding"
+)
+
+// Types of the journal entries.
+const (
+	EntryTypeOpening    = "opening"
+	EntryTypeTransfer   = "transfer"
+	EntryTypeRefund     = "refund"
+	EntryTypeSweep      = "sweep"
+	EntryTypeDeposit    = "deposit"
+	EntryTypeWithdrawal = "withdrawal"
+	EntryTypeFee        = "fee"
+	EntryTypeReversal   = "reversal"
+)
+
+// Account of the double-entry ledger. Every wallet has its own account,
+// system accounts are opened for every currency separately.
+type LedgerAccount struct {
+	ID       string `pg:"id,pk"`
+	Type     string `pg:"type"`
+	Currency string `pg:"currency"`
+}
+
+// Journal entry of the double-entry ledger. Sum of its postings is always zero.
+type JournalEntry struct {
+	ID       int64     `pg:"id,pk"`
+	Time     time.Time `pg:"time"`
+	Type     string    `pg:"type"`
+	Postings []Posting `pg:"-"`
+}
+
+// Change of the ledger account balance.
+// Debit postings have negative amount, credit postings have positive one.
+type Posting struct {
+	ID        int64  `pg:"id,pk"`
+	EntryID   int64  `pg:"entry_id"`
+	AccountID string `pg:"account_id"`
+	Amount    Money  `pg:"amount"`
+}
+
+// Getting the ID of the system account in the currency.
+func SystemAccount(account, currency string) string {
+	return account + ":" + currency
+}
+
+// Checking that the ledger account is a system one and not a wallet.
+func IsSystemAccount(accountID string) bool {
+	return strings.HasPrefix(accountID, AccountTypeSystem+":")
+}
+
+// Checking that the sum of the postings is zero.
+func (e *JournalEntry) IsBalanced() bool {
+	var (
+		sum Money
+		err error
+	)
+
+	for _, posting := range e.Postings {
+		if sum, err = sum.Add(posting.Amount); err != nil {
+			return false
+		}
+	}
+
+	return sum == 0
+}
+
+// Creating the entry, which moves the amount from one ledger account to another.
+func NewJournalEntry(entryType, from, to string, amount Money) *JournalEntry {
+	return &JournalEntry{
+		Type: entryType,
+		Postings: []Posting{
+			{AccountID: from, Amount: amount.Neg()},
+			{AccountID: to, Amount: amount},
+		},
+	}
+}
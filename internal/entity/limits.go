@@ -0,0 +1,42 @@
+package entity
+
+// Kinds of the spending limits.
+const (
+	LimitPerTransaction = "perTransaction"
+	LimitDaily          = "daily"
+	LimitMonthly        = "monthly"
+)
+
+// @Description Лимиты расходов кошелька. Нулевой лимит не ограничивает расходы.
+type SpendingLimits struct {
+	tableName struct{} `pg:"wallet_limits"` //nolint:unused // table name for go-pg
+
+	WalletID       string `json:"walletId"       example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID кошелька"                                       validate:"required" pg:"wallet_id,pk"`                         //nolint:lll,tagalign // вот так то лучше
+	PerTransaction Money  `json:"perTransaction" example:"5000"                             description:"Максимальная сумма одного перевода"                validate:"optional" pg:"per_transaction" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Daily          Money  `json:"daily"          example:"20000"                            description:"Максимальная сумма переводов за последние сутки"   validate:"optional" pg:"daily"           swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Monthly        Money  `json:"monthly"        example:"100000"                           description:"Максимальная сумма переводов за последние 30 дней" validate:"optional" pg:"monthly"         swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Description Превышен лимит расходов кошелька.
+type LimitExceededError struct {
+	Limit     string `json:"limit"     example:"daily" description:"Превышенный лимит: perTransaction, daily или monthly"`                      //nolint:lll,tagalign // вот так то лучше
+	Remaining Money  `json:"remaining" example:"1500"  description:"Сумма, которую еще можно перевести"                   swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+func (e *LimitExceededError) Error() string {
+	return ErrLimitExceeded.Error()
+}
+
+func (e *LimitExceededError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
+
+// Details - the remaining allowance is passed through rmq rpc with the error.
+func (e *LimitExceededError) Details() interface{} {
+	return e
+}
+
+// Checking that the spending limits are not negative.
+func (l *SpendingLimits) IsValid() bool {
+	return l.PerTransaction >= 0 && l.Daily >= 0 && l.Monthly >= 0
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount of money in the minor units of the currency (cents, kopecks).
+// In JSON it is represented as a string with the integer number of minor units,
+// but the integer JSON number is accepted too.
+type Money int64
+
+// Parsing the amount from the string with the integer number of minor units.
+func ParseMoney(value string) (Money, error) {
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange { //nolint:errorlint // NumError is not wrapped
+			return 0, ErrAmountOverflow
+		}
+
+		return 0, ErrWrongAmount
+	}
+
+	return Money(amount), nil
+}
+
+// Checking that the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m > 0
+}
+
+// Adding the amount with the overflow check.
+func (m Money) Add(other Money) (Money, error) {
+	if (other > 0 && m > math.MaxInt64-other) || (other < 0 && m < math.MinInt64-other) {
+		return 0, ErrAmountOverflow
+	}
+
+	return m + other, nil
+}
+
+// Subtracting the amount with the overflow check.
+func (m Money) Sub(other Money) (Money, error) {
+	if (other < 0 && m > math.MaxInt64+other) || (other > 0 && m < math.MinInt64+other) {
+		return 0, ErrAmountOverflow
+	}
+
+	return m - other, nil
+}
+
+// Getting the negative amount.
+func (m Money) Neg() Money {
+	return -m
+}
+
+func (m Money) String() string {
+	return strconv.FormatInt(int64(m), 10)
+}
+
+// Formatting the amount as a decimal number in the major units of the currency.
+func (m Money) Format(currency string) string {
+	exponent := CurrencyExponent(currency)
+	if exponent == 0 {
+		return m.String()
+	}
+
+	digits := strconv.FormatInt(int64(m), 10)
+
+	sign := ""
+	if m < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(m.String())), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value := string(bytes.Trim(data, `"`))
+	if value == "null" {
+		return nil
+	}
+
+	amount, err := ParseMoney(value)
+	if err != nil {
+		return fmt.Errorf("Money - UnmarshalJSON - ParseMoney: %w", err)
+	}
+
+	*m = amount
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_MoneyArithmetic(t *testing.T) {
+	for _, test := range testsMoneyArithmetic {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.operation()
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, result, test.expectedResult)
+		})
+	}
+}
+
+var testsMoneyArithmetic = []struct {
+	name           string
+	operation      func() (Money, error)
+	expectedResult Money
+	expectedError  error
+}{
+	{
+		name:           "Add",
+		operation:      func() (Money, error) { return Money(100).Add(50) },
+		expectedResult: 150,
+	},
+	{
+		name:          "Add - overflow",
+		operation:     func() (Money, error) { return Money(math.MaxInt64).Add(1) },
+		expectedError: ErrAmountOverflow,
+	},
+	{
+		name:           "Sub",
+		operation:      func() (Money, error) { return Money(100).Sub(150) },
+		expectedResult: -50,
+	},
+	{
+		name:          "Sub - overflow",
+		operation:     func() (Money, error) { return Money(math.MinInt64).Sub(1) },
+		expectedError: ErrAmountOverflow,
+	},
+}
+
+func Test_MoneyJSON(t *testing.T) {
+	for _, test := range testsMoneyJSON {
+		t.Run(test.name, func(t *testing.T) {
+			var amount Money
+
+			err := json.Unmarshal([]byte(test.json), &amount)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, amount, test.expectedAmount)
+		})
+	}
+}
+
+var testsMoneyJSON = []struct {
+	name           string
+	json           string
+	expectedAmount Money
+	expectedError  error
+}{
+	{
+		name:           "String",
+		json:           `"9223372036854775807"`,
+		expectedAmount: math.MaxInt64,
+	},
+	{
+		name:           "Number",
+		json:           `100`,
+		expectedAmount: 100,
+	},
+	{
+		name:          "Overflow",
+		json:          `"9223372036854775808"`,
+		expectedError: ErrAmountOverflow,
+	},
+	{
+		name:          "Fraction",
+		json:          `10.5`,
+		expectedError: ErrWrongAmount,
+	},
+	{
+		name:          "Not number",
+		json:          `"abc"`,
+		expectedError: ErrWrongAmount,
+	},
+}
+
+func Test_MoneyFormat(t *testing.T) {
+	assert.Equal(t, Money(12345).Format("USD"), "123.45")
+	assert.Equal(t, Money(5).Format("EUR"), "0.05")
+	assert.Equal(t, Money(-5).Format("EUR"), "-0.05")
+	assert.Equal(t, Money(12345).Format("JPY"), "12345")
+
+	body, _ := json.Marshal(Money(12345))
+	assert.Equal(t, string(body), `"12345"`)
+}
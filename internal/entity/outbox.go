@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Events of the wallets, which are published to the topic exchange.
+const (
+	OutboxEventCredited     = "credited"
+	OutboxEventDebited      = "debited"
+	OutboxEventTransaction  = "transaction"
+	_walletRoutingKeyPrefix = "wallet."
+)
+
+// OutboxEvent - the domain event, which is written in the db transaction of the change
+// and published to the message broker by the relay after the commit.
+type OutboxEvent struct {
+	ID          int64           `pg:"id,pk"`
+	RoutingKey  string          `pg:"routing_key"`
+	EventType   string          `pg:"event_type"`
+	Payload     json.RawMessage `pg:"payload,type:jsonb"`
+	CreatedAt   time.Time       `pg:"created_at"`
+	LockedUntil time.Time       `pg:"locked_until"`
+	PublishedAt time.Time       `pg:"published_at"`
+}
+
+// BalanceChange - the payload of the credited and debited events of the wallet.
+type BalanceChange struct {
+	WalletID  string    `json:"walletId"`
+	Amount    Money     `json:"amount"`
+	Balance   Money     `json:"balance"`
+	Currency  string    `json:"currency"`
+	EntryID   int64     `json:"entryId"`
+	EntryType string    `json:"entryType"`
+	Time      time.Time `json:"time"`
+}
+
+// Getting the routing key of the wallet event: wallet.<id>.<event>.
+func WalletRoutingKey(walletID, event string) string {
+	return _walletRoutingKeyPrefix + walletID + "." + event
+}
+
+// Parsing the routing key of the wallet event into the wallet id and the event.
+func ParseWalletRoutingKey(key string) (walletID, event string, ok bool) {
+	rest, found := strings.CutPrefix(key, _walletRoutingKeyPrefix)
+	if !found {
+		return "", "", false
+	}
+
+	walletID, event, found = strings.Cut(rest, ".")
+	if !found || walletID == "" || event == "" || strings.Contains(event, ".") {
+		return "", "", false
+	}
+
+	return walletID, event, true
+}
+
+// Creating the event of the balance change, the debit is published with the positive amount.
+func NewBalanceChangeEvent(change *BalanceChange) (*OutboxEvent, error) {
+	event := OutboxEventCredited
+	if change.Amount < 0 {
+		event = OutboxEventDebited
+		change.Amount = -change.Amount
+	}
+
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{
+		RoutingKey: WalletRoutingKey(change.WalletID, event),
+		EventType:  _walletRoutingKeyPrefix + event,
+		Payload:    payload,
+	}, nil
+}
+
+// Creating the events of the new or the updated transaction for every wallet of the transaction.
+func NewTransactionEvents(transaction *Transaction) ([]OutboxEvent, error) {
+	payload, err := json.Marshal(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]OutboxEvent, 0, 2)
+
+	for _, walletID := range []string{transaction.From, transaction.To} {
+		if walletID == "" || IsSystemAccount(walletID) {
+			continue
+		}
+
+		events = append(events, OutboxEvent{
+			RoutingKey: WalletRoutingKey(walletID, OutboxEventTransaction),
+			EventType:  _walletRoutingKeyPrefix + OutboxEventTransaction,
+			Payload:    payload,
+		})
+	}
+
+	return events, nil
+}
@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_NewBalanceChangeEvent(t *testing.T) {
+	for _, test := range testsNewBalanceChangeEvent {
+		t.Run(test.name, func(t *testing.T) {
+			event, err := NewBalanceChangeEvent(test.change)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, event.RoutingKey, test.expectedRoutingKey)
+			assert.Equal(t, event.EventType, test.expectedEventType)
+			assert.Equal(t, string(event.Payload), test.expectedPayload)
+		})
+	}
+}
+
+var testsNewBalanceChangeEvent = []struct {
+	name               string
+	change             *BalanceChange
+	expectedRoutingKey string
+	expectedEventType  string
+	expectedPayload    string
+}{
+	{
+		name: "Credit",
+		change: &BalanceChange{
+			WalletID:  "5b53700ed469fa6a09ea72bb78f36fd9",
+			Amount:    1500,
+			Balance:   2500,
+			Currency:  "USD",
+			EntryID:   7,
+			EntryType: "transfer",
+			Time:      time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC),
+		},
+		expectedRoutingKey: "wallet.5b53700ed469fa6a09ea72bb78f36fd9.credited",
+		expectedEventType:  "wallet.credited",
+		expectedPayload:    `{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"1500","balance":"2500","currency":"USD","entryId":7,"entryType":"transfer","time":"2024-05-08T12:00:00Z"}`,
+	},
+	{
+		name: "Debit is published with the positive amount",
+		change: &BalanceChange{
+			WalletID:  "eb376add88bf8e70f80787266a0801d5",
+			Amount:    -1500,
+			Balance:   0,
+			Currency:  "USD",
+			EntryID:   7,
+			EntryType: "transfer",
+			Time:      time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC),
+		},
+		expectedRoutingKey: "wallet.eb376add88bf8e70f80787266a0801d5.debited",
+		expectedEventType:  "wallet.debited",
+		expectedPayload:    `{"walletId":"eb376add88bf8e70f80787266a0801d5","amount":"1500","balance":"0","currency":"USD","entryId":7,"entryType":"transfer","time":"2024-05-08T12:00:00Z"}`,
+	},
+}
+
+func Test_ParseWalletRoutingKey(t *testing.T) {
+	for _, test := range testsParseWalletRoutingKey {
+		t.Run(test.name, func(t *testing.T) {
+			walletID, event, ok := ParseWalletRoutingKey(test.key)
+
+			assert.Equal(t, walletID, test.expectedWalletID)
+			assert.Equal(t, event, test.expectedEvent)
+			assert.Equal(t, ok, test.expectedOk)
+		})
+	}
+}
+
+var testsParseWalletRoutingKey = []struct {
+	name             string
+	key              string
+	expectedWalletID string
+	expectedEvent    string
+	expectedOk       bool
+}{
+	{
+		name:             "Ok",
+		key:              "wallet.5b53700ed469fa6a09ea72bb78f36fd9.credited",
+		expectedWalletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedEvent:    "credited",
+		expectedOk:       true,
+	},
+	{
+		name:       "Another entity",
+		key:        "customer.5b53700ed469fa6a09ea72bb78f36fd9.credited",
+		expectedOk: false,
+	},
+	{
+		name:       "Without event",
+		key:        "wallet.5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedOk: false,
+	},
+	{
+		name:       "Nested event",
+		key:        "wallet.5b53700ed469fa6a09ea72bb78f36fd9.credited.fee",
+		expectedOk: false,
+	},
+}
+
+func Test_NewTransactionEvents(t *testing.T) {
+	for _, test := range testsNewTransactionEvents {
+		t.Run(test.name, func(t *testing.T) {
+			events, err := NewTransactionEvents(test.transaction)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			keys := make([]string, 0, len(events))
+			for _, event := range events {
+				keys = append(keys, event.RoutingKey)
+			}
+
+			assert.Equal(t, keys, test.expectedRoutingKeys)
+		})
+	}
+}
+
+var testsNewTransactionEvents = []struct {
+	name                string
+	transaction         *Transaction
+	expectedRoutingKeys []string
+}{
+	{
+		name: "Transfer",
+		transaction: &Transaction{
+			Type: TransactionTypeTransfer,
+			From: "5b53700ed469fa6a09ea72bb78f36fd9",
+			To:   "eb376add88bf8e70f80787266a0801d5",
+		},
+		expectedRoutingKeys: []string{
+			"wallet.5b53700ed469fa6a09ea72bb78f36fd9.transaction",
+			"wallet.eb376add88bf8e70f80787266a0801d5.transaction",
+		},
+	},
+	{
+		name: "Deposit",
+		transaction: &Transaction{
+			Type: TransactionTypeDeposit,
+			To:   "eb376add88bf8e70f80787266a0801d5",
+		},
+		expectedRoutingKeys: []string{
+			"wallet.eb376add88bf8e70f80787266a0801d5.transaction",
+		},
+	},
+}
@@ -0,0 +1,81 @@
+package entity
+
+import "strings"
+
+// Scopes of the callers. The permissions can be granted as the scopes as well.
+const (
+	// All permissions.
+	ScopeAdmin = "admin"
+	// Sending from any wallet, e.g. by the payment service.
+	ScopeSend = "wallet:send"
+)
+
+// Authenticated caller of the API. The subject is the ID of the customer, who owns wallets,
+// or the operator, who has the permissions of the roles assigned to the subject.
+type Principal struct {
+	Subject string
+	Scopes  []string
+	// Permissions of the assigned roles, nil until they are loaded.
+	Permissions []string
+	// Identity of the credential, e.g. of the API key, which is set even without the subject.
+	Credential string
+}
+
+// RateLimitKey - getting the key, which the requests of the caller are limited by.
+// The callers without the subject are limited by their credential.
+func (p *Principal) RateLimitKey() string {
+	if p.Subject != "" {
+		return p.Subject
+	}
+
+	return p.Credential
+}
+
+// Scope delegating the sending from the wallet to the caller, who does not own it.
+func WalletSendScope(walletID string) string {
+	return ScopeSend + ":" + walletID
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checking that the permission is granted to the caller by the scopes or by the roles.
+func (p *Principal) HasPermission(permission string) bool {
+	if p.HasScope(ScopeAdmin) || p.HasScope(permission) {
+		return true
+	}
+
+	for _, granted := range p.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Granting the permissions of the roles to the caller.
+func (p *Principal) GrantRoles(roles []Role) {
+	p.Permissions = make([]string, 0)
+
+	for _, role := range roles {
+		p.Permissions = append(p.Permissions, role.Permissions...)
+	}
+}
+
+// Checking that the caller owns the wallet.
+func (p *Principal) Owns(wallet *Wallet) bool {
+	return p.Subject != "" && strings.EqualFold(wallet.OwnerID, p.Subject)
+}
+
+// Checking that the sending from the wallet is delegated to the caller.
+func (p *Principal) CanSendFrom(walletID string) bool {
+	return p.HasScope(ScopeSend) || p.HasScope(WalletSendScope(walletID))
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// Permissions of the operators, which are granted through the roles.
+const (
+	PermissionWalletRead      = "wallet:read"
+	PermissionWalletFreeze    = "wallet:freeze"
+	PermissionWalletClose     = "wallet:close"
+	PermissionLedgerAdjust    = "ledger:adjust"
+	PermissionLedgerReconcile = "ledger:reconcile"
+	PermissionAuditRead       = "audit:read"
+	PermissionRolesManage     = "roles:manage"
+	PermissionCustomersManage = "customers:manage"
+)
+
+// Size limits of the roles and their assignments.
+const (
+	MaxRoleNameLength = 64
+	MaxSubjectLength  = 255
+)
+
+var _permissions = []string{
+	PermissionWalletRead,
+	PermissionWalletFreeze,
+	PermissionWalletClose,
+	PermissionLedgerAdjust,
+	PermissionLedgerReconcile,
+	PermissionAuditRead,
+	PermissionRolesManage,
+	PermissionCustomersManage,
+}
+
+// @Description Роль - набор разрешений, который назначается субъектам.
+type Role struct {
+	Name        string   `json:"name"        example:"support"                   description:"Название роли"   validate:"required" pg:"name,pk"`           //nolint:lll,tagalign // вот так то лучше
+	Permissions []string `json:"permissions" example:"wallet:read,wallet:freeze" description:"Разрешения роли" validate:"required" pg:"permissions,array"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// Assignment of the role to the subject of the API keys or the tokens.
+type RoleAssignment struct {
+	Subject   string    `pg:"subject,pk"`
+	Role      string    `pg:"role,pk"`
+	CreatedAt time.Time `pg:"created_at"`
+}
+
+// Getting all known permissions.
+func Permissions() []string {
+	return append([]string(nil), _permissions...)
+}
+
+func IsValidPermission(permission string) bool {
+	for _, p := range _permissions {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checking that the name of the role is a lowercase slug and all permissions are known and unique.
+func (r *Role) IsValid() bool {
+	if !isSlug(r.Name, MaxRoleNameLength) || len(r.Permissions) == 0 {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(r.Permissions))
+
+	for _, permission := range r.Permissions {
+		if _, ok := seen[permission]; ok || !IsValidPermission(permission) {
+			return false
+		}
+
+		seen[permission] = struct{}{}
+	}
+
+	return true
+}
+
+// Checking that the subject fits the size limit.
+func IsValidSubject(subject string) bool {
+	return subject != "" && utf8.RuneCountInString(subject) <= MaxSubjectLength
+}
+
+func isSlug(value string, maxLength int) bool {
+	if value == "" || len(value) > maxLength {
+		return false
+	}
+
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,14 @@
+package entity
+
+type RoleRequest struct {
+	Name string `json:"name"`
+}
+
+type SubjectRequest struct {
+	Subject string `json:"subject"`
+}
+
+type RoleAssignmentRequest struct {
+	Subject string `json:"subject"`
+	Role    string `json:"role"`
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_RoleIsValid(t *testing.T) {
+	for _, test := range testsRoleIsValid {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.role.IsValid(), test.expected)
+		})
+	}
+}
+
+var testsRoleIsValid = []struct {
+	name     string
+	role     Role
+	expected bool
+}{
+	{
+		name:     "Ok",
+		role:     Role{Name: "support-l2", Permissions: []string{PermissionWalletRead, PermissionWalletFreeze}},
+		expected: true,
+	},
+	{
+		name:     "Uppercase name",
+		role:     Role{Name: "Support", Permissions: []string{PermissionWalletRead}},
+		expected: false,
+	},
+	{
+		name:     "Without permissions",
+		role:     Role{Name: "support"},
+		expected: false,
+	},
+	{
+		name:     "Unknown permission",
+		role:     Role{Name: "support", Permissions: []string{"wallet:delete"}},
+		expected: false,
+	},
+	{
+		name:     "Duplicate permission",
+		role:     Role{Name: "support", Permissions: []string{PermissionWalletRead, PermissionWalletRead}},
+		expected: false,
+	},
+}
+
+func Test_PrincipalHasPermission(t *testing.T) {
+	for _, test := range testsPrincipalHasPermission {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.principal.HasPermission(PermissionAuditRead), test.expected)
+		})
+	}
+}
+
+var testsPrincipalHasPermission = []struct {
+	name      string
+	principal Principal
+	expected  bool
+}{
+	{
+		name:      "Admin scope",
+		principal: Principal{Scopes: []string{ScopeAdmin}},
+		expected:  true,
+	},
+	{
+		name:      "Permission scope",
+		principal: Principal{Scopes: []string{PermissionAuditRead}},
+		expected:  true,
+	},
+	{
+		name:      "Role permission",
+		principal: Principal{Permissions: []string{PermissionWalletRead, PermissionAuditRead}},
+		expected:  true,
+	},
+	{
+		name:      "Other permissions",
+		principal: Principal{Scopes: []string{ScopeSend}, Permissions: []string{PermissionWalletRead}},
+		expected:  false,
+	},
+}
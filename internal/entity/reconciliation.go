@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+)
+
+// Sources of the expected balance of the wallet.
+const (
+	ReconciliationSourceLedger       = "ledger"
+	ReconciliationSourceTransactions = "transactions"
+)
+
+// Drifted wallets, which are kept in the report. The rest are only counted.
+const MaxReconciliationDrifts = 1000
+
+// @Description Расхождение баланса кошелька с пересчитанным.
+type WalletDrift struct {
+	WalletID            string `json:"walletId"            example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID кошелька"                                             validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Currency            string `json:"currency"            example:"USD"                              description:"Валюта кошелька ISO 4217"                                validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Balance             Money  `json:"balance"             example:"10000"                            description:"Баланс кошелька в минимальных единицах валюты"           validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	LedgerBalance       Money  `json:"ledgerBalance"       example:"10000"                            description:"Сумма проводок по кошельку"                              validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	TransactionsBalance Money  `json:"transactionsBalance" example:"9500"                             description:"Начальный баланс кошелька и сумма проведенных переводов" validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	LedgerDrift         Money  `json:"ledgerDrift"         example:"0"                                description:"Разница баланса и суммы проводок"                        validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	TransactionsDrift   Money  `json:"transactionsDrift"   example:"500"                              description:"Разница баланса и суммы переводов"                       validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Description Отчет сверки балансов кошельков.
+type ReconciliationReport struct {
+	ID             int64         `json:"id"             example:"7"                                           description:"Уникальный ID отчета"                 validate:"required"    pg:"id,pk"`                    //nolint:lll,tagalign // вот так то лучше
+	StartedAt      time.Time     `json:"startedAt"      example:"2024-02-04T17:25:35.448Z"                    description:"Дата и время начала сверки"           validate:"required"    format:"date-time"`            //nolint:lll,tagalign // вот так то лучше
+	FinishedAt     time.Time     `json:"finishedAt"     example:"2024-02-04T17:25:37.012Z"                    description:"Дата и время окончания сверки"        validate:"required"    format:"date-time"`            //nolint:lll,tagalign // вот так то лучше
+	WalletsChecked int           `json:"walletsChecked" example:"1000"                                        description:"Количество проверенных кошельков"     validate:"required"    pg:"wallets_checked,use_zero"` //nolint:lll,tagalign // вот так то лучше
+	DriftedWallets int           `json:"driftedWallets" example:"3"                                           description:"Количество кошельков с расхождениями" validate:"required"    pg:"drifted_wallets,use_zero"` //nolint:lll,tagalign // вот так то лучше
+	Drifts         []WalletDrift `json:"drifts"         description:"Кошельки с расхождениями, не более 1000" validate:"required"                                pg:"drifts,type:jsonb"`                               //nolint:lll,tagalign // вот так то лучше
+}
+
+// Calculating the drifts of the wallet balance from the expected ones.
+func (d *WalletDrift) Calculate() {
+	d.LedgerDrift = d.Balance - d.LedgerBalance
+	d.TransactionsDrift = d.Balance - d.TransactionsBalance
+}
+
+// Checking that the balance of the wallet differs from one of the expected ones.
+func (d *WalletDrift) IsDrifted() bool {
+	return d.LedgerDrift != 0 || d.TransactionsDrift != 0
+}
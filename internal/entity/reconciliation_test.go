@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_WalletDriftCalculate(t *testing.T) {
+	for _, test := range testsWalletDriftCalculate {
+		t.Run(test.name, func(t *testing.T) {
+			drift := test.drift
+			drift.Calculate()
+
+			assert.Equal(t, drift.LedgerDrift, test.expectedLedgerDrift)
+			assert.Equal(t, drift.TransactionsDrift, test.expectedTransactionsDrift)
+			assert.Equal(t, drift.IsDrifted(), test.expectedDrifted)
+		})
+	}
+}
+
+var testsWalletDriftCalculate = []struct {
+	name                      string
+	drift                     WalletDrift
+	expectedLedgerDrift       Money
+	expectedTransactionsDrift Money
+	expectedDrifted           bool
+}{
+	{
+		name:                      "Ok",
+		drift:                     WalletDrift{Balance: 100, LedgerBalance: 100, TransactionsBalance: 100},
+		expectedLedgerDrift:       0,
+		expectedTransactionsDrift: 0,
+		expectedDrifted:           false,
+	},
+	{
+		name:                      "Balance above ledger",
+		drift:                     WalletDrift{Balance: 150, LedgerBalance: 100, TransactionsBalance: 150},
+		expectedLedgerDrift:       50,
+		expectedTransactionsDrift: 0,
+		expectedDrifted:           true,
+	},
+	{
+		name:                      "Balance below transactions",
+		drift:                     WalletDrift{Balance: 100, LedgerBalance: 100, TransactionsBalance: 130},
+		expectedLedgerDrift:       0,
+		expectedTransactionsDrift: -30,
+		expectedDrifted:           true,
+	},
+}
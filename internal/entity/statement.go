@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// Maximum number of the entries in one statement.
+const MaxStatementEntries = 10000
+
+// Directions of the statement entries relative to the wallet.
+const (
+	EntryDirectionCredit = "credit"
+	EntryDirectionDebit  = "debit"
+)
+
+// @Description Выписка по кошельку за период.
+type Statement struct {
+	WalletID       string           `json:"walletId"       example:"5b53700ed469fa6a09ea72bb78f36fd9"       description:"ID кошелька"`                                                                 //nolint:lll,tagalign // вот так то лучше
+	Currency       string           `json:"currency"       example:"USD"                                    description:"Валюта кошелька ISO 4217"`                                                    //nolint:lll,tagalign // вот так то лучше
+	From           time.Time        `json:"from"           example:"2024-03-01T00:00:00Z"                   description:"Начало периода включительно"`                                                 //nolint:lll,tagalign // вот так то лучше
+	To             time.Time        `json:"to"             example:"2024-04-01T00:00:00Z"                   description:"Конец периода не включительно"`                                               //nolint:lll,tagalign // вот так то лучше
+	GeneratedAt    time.Time        `json:"generatedAt"    example:"2024-04-02T09:00:00Z"                   description:"Время формирования выписки"`                                                  //nolint:lll,tagalign // вот так то лучше
+	OpeningBalance Money            `json:"openingBalance" example:"10000"                                  description:"Баланс на начало периода в минимальных единицах валюты" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	ClosingBalance Money            `json:"closingBalance" example:"7000"                                   description:"Баланс на конец периода в минимальных единицах валюты"  swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Entries        []StatementEntry `json:"entries"        description:"Операции периода от старых к новым"`                                                                                           //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Description Операция выписки.
+type StatementEntry struct {
+	TransactionID string    `json:"transactionId"          example:"7c9e6679-7425-40de-944b-e07fc1f90ae7" description:"ID транзакции"`                                                            //nolint:lll,tagalign // вот так то лучше
+	Time          time.Time `json:"time"                   example:"2024-03-04T17:25:35.448Z"             description:"Время операции"`                                                           //nolint:lll,tagalign // вот так то лучше
+	Type          string    `json:"type"                   example:"transfer"                             description:"Тип транзакции"`                                                           //nolint:lll,tagalign // вот так то лучше
+	Status        string    `json:"status,omitempty"       example:"completed"                            description:"Статус транзакции"`                                                        //nolint:lll,tagalign // вот так то лучше
+	Direction     string    `json:"direction"              example:"debit"                                description:"Направление: credit - зачисление, debit - списание"`                       //nolint:lll,tagalign // вот так то лучше
+	Counterparty  string    `json:"counterparty,omitempty" example:"eb376add88bf8e70f80787266a0801d5"     description:"Кошелек контрагента или платежный провайдер"`                              //nolint:lll,tagalign // вот так то лучше
+	Amount        Money     `json:"amount"                 example:"3000"                                 description:"Сумма операции в минимальных единицах валюты"        swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Balance       Money     `json:"balance"                example:"7000"                                 description:"Баланс после операции в минимальных единицах валюты" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Description   string    `json:"description,omitempty"  example:"Salary for April"                     description:"Описание перевода"`                                                        //nolint:lll,tagalign // вот так то лучше
+	Reference     string    `json:"reference,omitempty"    example:"INV-2024-0042"                        description:"Внешняя ссылка перевода"`                                                  //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking that the period of the statement is set and is not empty.
+func IsValidStatementPeriod(from, to time.Time) bool {
+	return !from.IsZero() && !to.IsZero() && from.Before(to)
+}
+
+// NewStatement - building the statement of the wallet from the balances and the transactions of the period.
+// Transactions, which have not changed the balance, are skipped: failed ones and pending deposits.
+// The balances after the entries are restored back from the closing balance, so they are right
+// even if the balance is credited without the transaction in the period, e.g. when the wallet is created.
+func NewStatement(
+	walletID string,
+	currency string,
+	from, to time.Time,
+	openingBalance, closingBalance Money,
+	transactions []Transaction,
+) (*Statement, error) {
+	statement := &Statement{
+		WalletID:       walletID,
+		Currency:       currency,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		ClosingBalance: closingBalance,
+		Entries:        make([]StatementEntry, 0, len(transactions)),
+	}
+
+	sorted := make([]Transaction, len(transactions))
+	copy(sorted, transactions)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Time.Equal(sorted[j].Time) {
+			return sorted[i].ID < sorted[j].ID
+		}
+
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	for i := range sorted {
+		if sorted[i].IsBooked() {
+			statement.Entries = append(statement.Entries, newStatementEntry(walletID, &sorted[i]))
+		}
+	}
+
+	balance := closingBalance
+
+	for i := len(statement.Entries) - 1; i >= 0; i-- {
+		entry := &statement.Entries[i]
+		entry.Balance = balance
+
+		change := entry.Amount
+		if entry.Direction == EntryDirectionCredit {
+			change = change.Neg()
+		}
+
+		var err error
+		if balance, err = balance.Add(change); err != nil {
+			return nil, err
+		}
+	}
+
+	return statement, nil
+}
+
+// Creating the entry of the transaction relative to the wallet.
+func newStatementEntry(walletID string, transaction *Transaction) StatementEntry {
+	entry := StatementEntry{
+		TransactionID: transaction.ID,
+		Time:          transaction.Time,
+		Type:          transaction.Type,
+		Status:        transaction.Status,
+		Direction:     EntryDirectionCredit,
+		Counterparty:  transaction.From,
+		Amount:        transaction.Amount,
+		Description:   transaction.Description,
+		Reference:     transaction.Reference,
+	}
+
+	if transaction.From == walletID {
+		entry.Direction = EntryDirectionDebit
+		entry.Counterparty = transaction.To
+	}
+	// Deposits and withdrawals have the funding provider on the other side
+	if entry.Counterparty == "" {
+		entry.Counterparty = transaction.Provider
+	}
+
+	return entry
+}
+
+// Total amounts of the credit and the debit entries of the statement.
+func (s *Statement) Totals() (credit, debit Money, credits, debits int) {
+	for _, entry := range s.Entries {
+		if entry.Direction == EntryDirectionCredit {
+			credit += entry.Amount
+			credits++
+		} else {
+			debit += entry.Amount
+			debits++
+		}
+	}
+
+	return credit, debit, credits, debits
+}
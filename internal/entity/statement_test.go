@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_NewStatement(t *testing.T) {
+	const walletID = "5b53700ed469fa6a09ea72bb78f36fd9"
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// History comes from the newest to the oldest transaction
+	transactions := []Transaction{
+		{
+			ID: "4", Time: from.Add(72 * time.Hour), From: walletID, Amount: 500,
+			Type: TransactionTypeWithdrawal, Status: TransactionStatusFailed, Provider: "sandbox",
+		},
+		{
+			ID: "3", Time: from.Add(48 * time.Hour), To: walletID, Amount: 2000,
+			Type: TransactionTypeDeposit, Status: TransactionStatusPending, Provider: "sandbox",
+		},
+		{
+			ID: "2", Time: from.Add(24 * time.Hour), From: walletID, Amount: 1000,
+			Type: TransactionTypeWithdrawal, Status: TransactionStatusPending, Provider: "sandbox",
+		},
+		{
+			ID: "1", Time: from.Add(time.Hour), From: "eb376add88bf8e70f80787266a0801d5", To: walletID, Amount: 3000,
+			Type: TransactionTypeTransfer, Status: TransactionStatusCompleted,
+			TransferDetails: TransferDetails{Description: "Salary for April", Reference: "INV-2024-0042"},
+		},
+	}
+
+	statement, err := NewStatement(walletID, "USD", from, to, 10000, 12000, transactions)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	assert.Equal(t, statement.OpeningBalance, Money(10000))
+	assert.Equal(t, statement.ClosingBalance, Money(12000))
+	assert.Equal(t, statement.Entries, []StatementEntry{
+		{
+			TransactionID: "1",
+			Time:          from.Add(time.Hour),
+			Type:          TransactionTypeTransfer,
+			Status:        TransactionStatusCompleted,
+			Direction:     EntryDirectionCredit,
+			Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+			Amount:        3000,
+			Balance:       13000,
+			Description:   "Salary for April",
+			Reference:     "INV-2024-0042",
+		},
+		{
+			TransactionID: "2",
+			Time:          from.Add(24 * time.Hour),
+			Type:          TransactionTypeWithdrawal,
+			Status:        TransactionStatusPending,
+			Direction:     EntryDirectionDebit,
+			Counterparty:  "sandbox",
+			Amount:        1000,
+			Balance:       12000,
+		},
+	})
+
+	credit, debit, credits, debits := statement.Totals()
+	assert.Equal(t, credit, Money(3000))
+	assert.Equal(t, debit, Money(1000))
+	assert.Equal(t, credits, 1)
+	assert.Equal(t, debits, 1)
+}
+
+func Test_IsValidStatementPeriod(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, IsValidStatementPeriod(from, from.AddDate(0, 1, 0)), true)
+	assert.Equal(t, IsValidStatementPeriod(from, from), false)
+	assert.Equal(t, IsValidStatementPeriod(from.AddDate(0, 1, 0), from), false)
+	assert.Equal(t, IsValidStatementPeriod(time.Time{}, from), false)
+	assert.Equal(t, IsValidStatementPeriod(from, time.Time{}), false)
+}
@@ -1,11 +1,100 @@
 package entity
 
-import "time"
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// Types of the transactions.
+const (
+	TransactionTypeTransfer   = "transfer"
+	TransactionTypeRefund     = "refund"
+	TransactionTypeSweep      = "sweep"
+	TransactionTypeDeposit    = "deposit"
+	TransactionTypeWithdrawal = "withdrawal"
+	TransactionTypeFee        = "fee"
+)
+
+// Size limits of the transfer details.
+const (
+	MaxDescriptionLength   = 255
+	MaxReferenceLength     = 64
+	MaxMetadataKeys        = 16
+	MaxMetadataKeyLength   = 40
+	MaxMetadataValueLength = 255
+)
+
+// Statuses of the transactions. Only deposits and withdrawals can be pending or failed,
+// because they are settled by the external funding provider.
+const (
+	TransactionStatusPending   = "pending"
+	TransactionStatusCompleted = "completed"
+	TransactionStatusFailed    = "failed"
+)
 
 // @Description Денежный перевод.
 type Transaction struct {
-	Time   time.Time `json:"time"   example:"2024-02-04T17:25:35.448Z"         description:"Дата и время перевода"  validate:"required" format:"date-time"`  //nolint:lll,tagalign // вот так то лучше
-	From   string    `json:"from"   example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID исходящего кошелька" validate:"required" pg:"from_wallet_id"` //nolint:lll,tagalign // вот так то лучше
-	To     string    `json:"to"     example:"eb376add88bf8e70f80787266a0801d5" description:"ID входящего кошелька"  validate:"required" pg:"to_wallet_id"`   //nolint:lll,tagalign // вот так то лучше
-	Amount uint      `json:"amount" example:"30"                               description:"Сумма перевода"         validate:"required"`                     //nolint:lll,tagalign // вот так то лучше
+	ID                string    `json:"id"                          example:"7c9e6679-7425-40de-944b-e07fc1f90ae7" description:"Уникальный ID перевода"                                             validate:"required" pg:"id,pk,type:uuid"`                       //nolint:lll,tagalign // вот так то лучше
+	Time              time.Time `json:"time"                        example:"2024-02-04T17:25:35.448Z"             description:"Дата и время перевода"                                              validate:"required" format:"date-time"`                         //nolint:lll,tagalign // вот так то лучше
+	Type              string    `json:"type"                        example:"transfer"                             description:"Тип перевода: transfer, refund, sweep, deposit, withdrawal или fee" validate:"required"`                                            //nolint:lll,tagalign // вот так то лучше
+	From              string    `json:"from"                        example:"5b53700ed469fa6a09ea72bb78f36fd9"     description:"ID исходящего кошелька"                                             validate:"required" pg:"from_wallet_id"`                        //nolint:lll,tagalign // вот так то лучше
+	To                string    `json:"to"                          example:"eb376add88bf8e70f80787266a0801d5"     description:"ID входящего кошелька"                                              validate:"required" pg:"to_wallet_id"`                          //nolint:lll,tagalign // вот так то лучше
+	Amount            Money     `json:"amount"                      example:"3000"                                 description:"Сумма перевода в минимальных единицах валюты"                       validate:"required" swaggertype:"string"`                       //nolint:lll,tagalign // вот так то лучше
+	Fee               Money     `json:"fee,omitempty"               example:"30"                                   description:"Комиссия перевода в минимальных единицах валюты"                    validate:"optional" swaggertype:"string"     pg:"fee,use_zero"` //nolint:lll,tagalign // вот так то лучше
+	Currency          string    `json:"currency"                    example:"USD"                                  description:"Валюта перевода ISO 4217"                                           validate:"required"`                                            //nolint:lll,tagalign // вот так то лучше
+	ParentID          string    `json:"parentId,omitempty"          example:"0f8fad5b-d9cb-469f-a165-70867728950e" description:"ID исходного перевода, если это возврат или комиссия"               validate:"optional" pg:"parent_id,type:uuid"`                   //nolint:lll,tagalign // вот так то лучше
+	Status            string    `json:"status,omitempty"            example:"completed"                            description:"Статус перевода: pending, completed или failed"                     validate:"optional"`                                            //nolint:lll,tagalign // вот так то лучше
+	Provider          string    `json:"provider,omitempty"          example:"fake"                                 description:"Платежный провайдер пополнения или вывода"                          validate:"optional"`                                            //nolint:lll,tagalign // вот так то лучше
+	ProviderReference string    `json:"providerReference,omitempty" example:"fake-4f0b0c4e"                        description:"Ссылка на операцию у платежного провайдера"                         validate:"optional"`                                            //nolint:lll,tagalign // вот так то лучше
+
+	TransferDetails
+}
+
+// @Description Описание перевода для учета.
+type TransferDetails struct {
+	Description string            `json:"description,omitempty" example:"Salary for April"                                        description:"Описание перевода"                    validate:"optional"`      //nolint:lll,tagalign // вот так то лучше
+	Reference   string            `json:"reference,omitempty"   example:"INV-2024-0042"                                           description:"Внешняя ссылка, например номер счета" validate:"optional"`      //nolint:lll,tagalign // вот так то лучше
+	Metadata    map[string]string `json:"metadata,omitempty"    description:"Дополнительные данные перевода в виде ключ-значение" validate:"optional"                                pg:"metadata,type:jsonb"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking the size limits of the transfer details.
+func (d *TransferDetails) IsValid() bool {
+	if utf8.RuneCountInString(d.Description) > MaxDescriptionLength ||
+		utf8.RuneCountInString(d.Reference) > MaxReferenceLength ||
+		len(d.Metadata) > MaxMetadataKeys {
+		return false
+	}
+
+	for key, value := range d.Metadata {
+		if len(key) == 0 ||
+			utf8.RuneCountInString(key) > MaxMetadataKeyLength ||
+			utf8.RuneCountInString(value) > MaxMetadataValueLength {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Checking that the transaction has changed the balances of the wallets.
+// Failed transactions have not moved the funds, pending deposits have not moved them yet,
+// while pending withdrawals are debited at the start.
+func (t *Transaction) IsBooked() bool {
+	switch {
+	case t.Status == TransactionStatusFailed:
+		return false
+	case t.Status == TransactionStatusPending && t.Type == TransactionTypeDeposit:
+		return false
+	}
+
+	return true
+}
+
+// Getting the wallet of the deposit or the withdrawal.
+func (t *Transaction) FundedWallet() string {
+	if t.Type == TransactionTypeDeposit {
+		return t.To
+	}
+
+	return t.From
 }
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_TransferDetailsIsValid(t *testing.T) {
+	for _, test := range testsTransferDetailsIsValid {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.details.IsValid(), test.expected)
+		})
+	}
+}
+
+func metadataOfSize(size int) map[string]string {
+	metadata := make(map[string]string, size)
+	for i := 0; i < size; i++ {
+		metadata["key"+strconv.Itoa(i)] = "value"
+	}
+
+	return metadata
+}
+
+var testsTransferDetailsIsValid = []struct {
+	name     string
+	details  TransferDetails
+	expected bool
+}{
+	{
+		name:     "Empty",
+		details:  TransferDetails{},
+		expected: true,
+	},
+	{
+		name: "Maximum sizes",
+		details: TransferDetails{
+			Description: strings.Repeat("д", MaxDescriptionLength),
+			Reference:   strings.Repeat("x", MaxReferenceLength),
+			Metadata:    metadataOfSize(MaxMetadataKeys),
+		},
+		expected: true,
+	},
+	{
+		name:     "Too long description",
+		details:  TransferDetails{Description: strings.Repeat("x", MaxDescriptionLength+1)},
+		expected: false,
+	},
+	{
+		name:     "Too long reference",
+		details:  TransferDetails{Reference: strings.Repeat("x", MaxReferenceLength+1)},
+		expected: false,
+	},
+	{
+		name:     "Too many metadata keys",
+		details:  TransferDetails{Metadata: metadataOfSize(MaxMetadataKeys + 1)},
+		expected: false,
+	},
+	{
+		name:     "Empty metadata key",
+		details:  TransferDetails{Metadata: map[string]string{"": "value"}},
+		expected: false,
+	},
+	{
+		name:     "Too long metadata key",
+		details:  TransferDetails{Metadata: map[string]string{strings.Repeat("x", MaxMetadataKeyLength+1): "value"}},
+		expected: false,
+	},
+	{
+		name:     "Too long metadata value",
+		details:  TransferDetails{Metadata: map[string]string{"key": strings.Repeat("x", MaxMetadataValueLength+1)}},
+		expected: false,
+	},
+}
@@ -1,7 +1,54 @@
 package entity
 
+// Lifecycle statuses of the wallet.
+const (
+	WalletStatusActive = "active"
+	WalletStatusFrozen = "frozen"
+	WalletStatusClosed = "closed"
+)
+
+// Types of the wallets. Transfer fees are chosen by the type of the sender wallet.
+const (
+	WalletTypePersonal = "personal"
+	WalletTypeBusiness = "business"
+	WalletTypeMerchant = "merchant"
+)
+
 // @Description Состояние кошелька.
 type Wallet struct {
-	ID      string `json:"id"       example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"Уникальный ID кошелька" validate:"required"` //nolint:lll,tagalign // вот так то лучше
-	Balance uint   `json:"balance"  example:"100"                              description:"Баланс кошелька"        validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	ID        string `json:"id"                example:"5b53700ed469fa6a09ea72bb78f36fd9"     description:"Уникальный ID кошелька"                        validate:"required"`                                //nolint:lll,tagalign // вот так то лучше
+	Balance   Money  `json:"balance"           example:"10000"                                description:"Баланс кошелька в минимальных единицах валюты" validate:"required" swaggertype:"string"`           //nolint:lll,tagalign // вот так то лучше
+	Held      Money  `json:"held"              example:"3000"                                 description:"Сумма активных резервов"                       validate:"required" swaggertype:"string"`           //nolint:lll,tagalign // вот так то лучше
+	Available Money  `json:"available"         example:"7000"                                 description:"Доступный баланс за вычетом резервов"          validate:"required" swaggertype:"string"    pg:"-"` //nolint:lll,tagalign // вот так то лучше
+	Currency  string `json:"currency"          example:"USD"                                  description:"Валюта кошелька ISO 4217"                      validate:"required"`                                //nolint:lll,tagalign // вот так то лучше
+	Status    string `json:"status"            example:"active"                               description:"Статус кошелька: active, frozen или closed"    validate:"required"`                                //nolint:lll,tagalign // вот так то лучше
+	Type      string `json:"type"              example:"personal"                             description:"Тип кошелька: personal, business или merchant" validate:"required"`                                //nolint:lll,tagalign // вот так то лучше
+	OwnerID   string `json:"ownerId,omitempty" example:"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21" description:"ID клиента - владельца кошелька"               validate:"optional" pg:"owner_id,type:uuid"`        //nolint:lll,tagalign // вот так то лучше
+}
+
+// Getting the balance, which is not reserved by the holds.
+func (w *Wallet) AvailableBalance() Money {
+	return w.Balance - w.Held
+}
+
+// Checking that the funds of the wallet can be moved.
+func (w *Wallet) CheckStatus() error {
+	switch w.Status {
+	case WalletStatusFrozen:
+		return ErrWalletFrozen
+	case WalletStatusClosed:
+		return ErrWalletClosed
+	}
+
+	return nil
+}
+
+// Checking that the type of the wallet is known.
+func IsValidWalletType(walletType string) bool {
+	switch walletType {
+	case WalletTypePersonal, WalletTypeBusiness, WalletTypeMerchant:
+		return true
+	}
+
+	return false
 }
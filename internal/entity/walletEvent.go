@@ -0,0 +1,14 @@
+package entity
+
+import "encoding/json"
+
+// Event of the stream with the current state of the wallet, which is sent first.
+const WalletEventSnapshot = "wallet"
+
+// @Description Событие потока кошелька.
+type WalletEvent struct {
+	ID       string          `json:"id,omitempty" example:"42"                                         description:"ID события, пустой у состояния кошелька"                validate:"optional"`  //nolint:lll,tagalign // вот так то лучше
+	Type     string          `json:"type"         example:"credited"                                   description:"Тип события: wallet, credited, debited или transaction" validate:"required"`  //nolint:lll,tagalign // вот так то лучше
+	WalletID string          `json:"walletId"     example:"5b53700ed469fa6a09ea72bb78f36fd9"           description:"ID кошелька"                                            validate:"required"`  //nolint:lll,tagalign // вот так то лучше
+	Data     json.RawMessage `json:"data"         description:"Кошелек, изменение баланса или перевод" validate:"required"                                                  swaggertype:"object"` //nolint:lll,tagalign // вот так то лучше
+}
@@ -1,19 +1,63 @@
 package entity
 
+import "time"
+
 type CreateNewWalletWithBalanceRequest struct {
-	Balance uint `json:"balance"`
+	Balance  Money  `json:"balance"`
+	Currency string `json:"currency"`
+	Type     string `json:"type"`
+	OwnerID  string `json:"ownerId,omitempty"`
 }
 
 type SendFundsRequest struct {
-	From   string `json:"from"`
-	To     string `json:"to"`
-	Amount uint   `json:"amount"`
+	From           string `json:"from"`
+	To             string `json:"to"`
+	Amount         Money  `json:"amount"`
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	TransferDetails
 }
 
 type GetWalletHistoryByIDRequest struct {
-	WalletID string `json:"walletId"`
+	WalletID string        `json:"walletId"`
+	Filter   HistoryFilter `json:"filter"`
 }
 
 type GetWalletByIDRequest struct {
 	WalletID string `json:"walletId"`
 }
+
+type WalletBalanceAtRequest struct {
+	WalletID string    `json:"walletId"`
+	At       time.Time `json:"at"`
+}
+
+type GetTransactionByIDRequest struct {
+	TransactionID string `json:"transactionId"`
+}
+
+type RefundTransactionRequest struct {
+	TransactionID string `json:"transactionId"`
+	Amount        Money  `json:"amount"`
+}
+
+type ChangeWalletStatusRequest struct {
+	WalletID string `json:"walletId"`
+}
+
+type CloseWalletRequest struct {
+	WalletID string `json:"walletId"`
+	To       string `json:"to"`
+}
+
+type WalletLimitsRequest struct {
+	WalletID string `json:"walletId"`
+}
+
+type SendFundsBatchRequest struct {
+	Legs []TransferLeg `json:"legs"`
+}
+
+type FundingRequest struct {
+	WalletID string `json:"walletId"`
+	Amount   Money  `json:"amount"`
+}
@@ -0,0 +1,245 @@
+package entity
+
+import (
+	"encoding/json"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of the events, which are delivered by the webhooks.
+const (
+	WebhookEventTransferCompleted = "transfer.completed"
+	WebhookEventWalletFrozen      = "wallet.frozen"
+	WebhookEventWalletUnfrozen    = "wallet.unfrozen"
+	WebhookEventWalletClosed      = "wallet.closed"
+	WebhookEventHoldExpired       = "hold.expired"
+)
+
+// Statuses of the webhook delivery.
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Size limits of the webhook subscription.
+const (
+	MaxWebhookURLLength  = 2048
+	MaxWebhookEventTypes = 16
+)
+
+// Page size of the webhook delivery log.
+const (
+	DefaultWebhookDeliveriesLimit = 50
+	MaxWebhookDeliveriesLimit     = 100
+)
+
+// Getting all types of the webhook events.
+func WebhookEventTypes() []string {
+	return []string{
+		WebhookEventTransferCompleted,
+		WebhookEventWalletFrozen,
+		WebhookEventWalletUnfrozen,
+		WebhookEventWalletClosed,
+		WebhookEventHoldExpired,
+	}
+}
+
+func IsValidWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// @Description Подписка на события кошельков.
+type WebhookSubscription struct {
+	ID         string    `json:"id"                 example:"3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19"                                   description:"Уникальный ID подписки"                                                validate:"required" pg:"id,pk,type:uuid"`   //nolint:lll,tagalign // вот так то лучше
+	Owner      string    `json:"owner"              example:"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"                                   description:"Subject создателя подписки"                                            validate:"required"`                        //nolint:lll,tagalign // вот так то лучше
+	URL        string    `json:"url"                example:"https://example.com/hooks/wallet"                                       description:"Адрес, на который отправляются события"                                validate:"required"`                        //nolint:lll,tagalign // вот так то лучше
+	EventTypes []string  `json:"eventTypes"         example:"transfer.completed,wallet.frozen"                                       description:"Типы событий"                                                          validate:"required" pg:"event_types,array"` //nolint:lll,tagalign // вот так то лучше
+	WalletID   string    `json:"walletId,omitempty" example:"5b53700ed469fa6a09ea72bb78f36fd9"                                       description:"ID кошелька, по которому отправляются события, иначе по всем"          validate:"optional"`                        //nolint:lll,tagalign // вот так то лучше
+	Secret     string    `json:"secret,omitempty"   example:"whsec_5f2b8c1d9e4a7b3c6d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c" description:"Секрет подписи HMAC-SHA256, возвращается только при создании подписки" validate:"optional"`                        //nolint:lll,tagalign // вот так то лучше
+	CreatedAt  time.Time `json:"createdAt"          example:"2024-02-04T17:25:35.448Z"                                               description:"Дата и время создания подписки"                                        validate:"required" format:"date-time"`     //nolint:lll,tagalign // вот так то лучше
+}
+
+// Checking that the subscription has the absolute https url of the public host and the known event types without duplicates.
+func (s *WebhookSubscription) IsValid() bool {
+	if len(s.URL) > MaxWebhookURLLength {
+		return false
+	}
+
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return false
+	}
+	// The names are checked again after resolving by the sender
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicWebhookAddr(addr) {
+		return false
+	}
+
+	if len(s.EventTypes) == 0 || len(s.EventTypes) > MaxWebhookEventTypes {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(s.EventTypes))
+	for _, eventType := range s.EventTypes {
+		if _, ok := seen[eventType]; ok || !IsValidWebhookEventType(eventType) {
+			return false
+		}
+
+		seen[eventType] = struct{}{}
+	}
+
+	return true
+}
+
+// Ranges of the special purpose addresses, which are not covered by the netip.Addr checks.
+var _nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// IsPublicWebhookAddr - checking that the events can be sent to the address,
+// so the subscriptions can't reach the loopback, private and link-local networks.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range _nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// @Description Событие, отправляемое подписчикам.
+type WebhookEvent struct {
+	ID   string          `json:"id"   example:"6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e"                          description:"Уникальный ID события, одинаковый для всех подписок" validate:"required"`                     //nolint:lll,tagalign // вот так то лучше
+	Type string          `json:"type" example:"transfer.completed"                                            description:"Тип события"                                         validate:"required"`                     //nolint:lll,tagalign // вот так то лучше
+	Time time.Time       `json:"time" example:"2024-02-04T17:25:35.448Z"                                      description:"Дата и время события"                                validate:"required"  format:"date-time"` //nolint:lll,tagalign // вот так то лучше
+	Data json.RawMessage `json:"data" description:"Перевод, кошелек или резерв, к которому относится событие" validate:"required"                                               swaggertype:"object"`                    //nolint:lll,tagalign // вот так то лучше
+}
+
+// Creating the event with the new ID, the data is the transaction, the wallet or the hold.
+func NewWebhookEvent(eventType string, data interface{}) (*WebhookEvent, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookEvent{
+		ID:   uuid.NewString(),
+		Type: eventType,
+		Time: time.Now().UTC(),
+		Data: encoded,
+	}, nil
+}
+
+// @Description Доставка события подписчику.
+type WebhookDelivery struct {
+	ID             int64           `json:"id"                       example:"42"                                   description:"Уникальный ID доставки"                validate:"required"     pg:"id,pk"`                     //nolint:lll,tagalign // вот так то лучше
+	SubscriptionID string          `json:"webhookId"                example:"3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19" description:"ID подписки"                           validate:"required"     pg:"subscription_id,type:uuid"` //nolint:lll,tagalign // вот так то лучше
+	EventID        string          `json:"eventId"                  example:"6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e" description:"ID события"                            validate:"required"     pg:"event_id,type:uuid"`        //nolint:lll,tagalign // вот так то лучше
+	EventType      string          `json:"eventType"                example:"transfer.completed"                   description:"Тип события"                           validate:"required"`                                    //nolint:lll,tagalign // вот так то лучше
+	Payload        json.RawMessage `json:"payload"                  description:"Тело запроса - событие"           validate:"required"                                 pg:"payload,type:jsonb" swaggertype:"object"`           //nolint:lll,tagalign // вот так то лучше
+	Status         string          `json:"status"                   example:"failed"                               description:"Статус: pending, delivered или failed" validate:"required"`                                    //nolint:lll,tagalign // вот так то лучше
+	Attempts       int             `json:"attempts"                 example:"3"                                    description:"Количество попыток доставки"           validate:"required"     pg:"attempts,use_zero"`         //nolint:lll,tagalign // вот так то лучше
+	NextAttemptAt  time.Time       `json:"nextAttemptAt,omitempty"  example:"2024-02-04T17:25:35.448Z"             description:"Дата и время следующей попытки"        validate:"optional"     format:"date-time"`             //nolint:lll,tagalign // вот так то лучше
+	LastStatusCode int             `json:"lastStatusCode,omitempty" example:"503"                                  description:"HTTP статус последней попытки"         validate:"optional"     pg:"last_status_code,use_zero"` //nolint:lll,tagalign // вот так то лучше
+	LastError      string          `json:"lastError,omitempty"      example:"unexpected status 503"                description:"Ошибка последней попытки"              validate:"optional"     pg:"last_error,use_zero"`       //nolint:lll,tagalign // вот так то лучше
+	CreatedAt      time.Time       `json:"createdAt"                example:"2024-02-04T17:25:35.448Z"             description:"Дата и время создания доставки"        validate:"required"     format:"date-time"`             //nolint:lll,tagalign // вот так то лучше
+	DeliveredAt    time.Time       `json:"deliveredAt,omitempty"    example:"2024-02-04T17:25:35.448Z"             description:"Дата и время успешной доставки"        validate:"optional"     format:"date-time"`             //nolint:lll,tagalign // вот так то лучше
+
+	// The subscription is set for the delivery, which is claimed for sending
+	Subscription *WebhookSubscription `json:"-" pg:"-"`
+}
+
+// Filter of the webhook delivery log. Empty fields are not applied.
+type WebhookDeliveryFilter struct {
+	Status string `json:"status,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+}
+
+func (f *WebhookDeliveryFilter) IsValid() bool {
+	switch f.Status {
+	case "", WebhookDeliveryPending, WebhookDeliveryDelivered, WebhookDeliveryFailed:
+	default:
+		return false
+	}
+
+	return f.Limit >= 0 && f.Limit <= MaxWebhookDeliveriesLimit
+}
+
+// Retries of the failed webhook deliveries with the exponential backoff.
+type WebhookRetryPolicy struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Getting the delay after the failed attempt: the minimal backoff is doubled after every attempt.
+func (p WebhookRetryPolicy) Backoff(attempt int) time.Duration {
+	backoff := p.MinBackoff
+
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, p.MaxBackoff)
+}
+
+// Succeed - marking the delivery as delivered after the attempt.
+func (d *WebhookDelivery) Succeed(now time.Time, statusCode int) {
+	d.Attempts++
+	d.Status = WebhookDeliveryDelivered
+	d.LastStatusCode = statusCode
+	d.LastError = ""
+	d.DeliveredAt = now
+	d.NextAttemptAt = time.Time{}
+}
+
+// Fail - scheduling the next attempt of the delivery by the policy or marking it as failed,
+// if all attempts are used. The status code is zero, if the subscriber is unavailable.
+func (d *WebhookDelivery) Fail(now time.Time, statusCode int, reason string, policy WebhookRetryPolicy) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = reason
+
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = time.Time{}
+
+		return
+	}
+
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(policy.Backoff(d.Attempts))
+}
+
+// Replay - scheduling the delivery once again with all attempts of the policy.
+func (d *WebhookDelivery) Replay(now time.Time) {
+	d.Status = WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+}
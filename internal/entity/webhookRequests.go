@@ -0,0 +1,18 @@
+package entity
+
+type WebhookRequest struct {
+	Owner     string `json:"owner"`
+	WebhookID string `json:"webhookId"`
+}
+
+type WebhookDeliveriesRequest struct {
+	Owner     string                `json:"owner"`
+	WebhookID string                `json:"webhookId"`
+	Filter    WebhookDeliveryFilter `json:"filter"`
+}
+
+type ReplayWebhookDeliveryRequest struct {
+	Owner      string `json:"owner"`
+	WebhookID  string `json:"webhookId"`
+	DeliveryID int64  `json:"deliveryId"`
+}
@@ -0,0 +1,108 @@
+// Package auth implements authentication of the API callers by the API keys and by the JWT bearer tokens.
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/jwt"
+)
+
+const (
+	APIKeyHeader  = "X-API-Key"
+	_bearerPrefix = "Bearer "
+)
+
+var (
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+type apiKey struct {
+	hash      [sha256.Size]byte
+	principal entity.Principal
+}
+
+type Authenticator struct {
+	apiKeys  []apiKey
+	verifier *jwt.Verifier
+}
+
+func New(opts ...Option) *Authenticator {
+	a := &Authenticator{}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Authenticating the caller by the API key header or by the bearer token.
+func (a *Authenticator) Authenticate(r *http.Request) (*entity.Principal, error) {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return a.authenticateAPIKey(key)
+	}
+
+	if header := r.Header.Get("Authorization"); header != "" {
+		token, ok := strings.CutPrefix(header, _bearerPrefix)
+		if !ok {
+			return nil, ErrInvalidCredentials
+		}
+
+		return a.authenticateToken(strings.TrimSpace(token))
+	}
+
+	return nil, ErrNoCredentials
+}
+
+// Only the hashes of the keys are kept, so the keys are compared by the hash in the constant time.
+func (a *Authenticator) authenticateAPIKey(key string) (*entity.Principal, error) {
+	hash := sha256.Sum256([]byte(key))
+
+	for i := range a.apiKeys {
+		if subtle.ConstantTimeCompare(hash[:], a.apiKeys[i].hash[:]) == 1 {
+			principal := a.apiKeys[i].principal
+
+			return &principal, nil
+		}
+	}
+
+	return nil, ErrInvalidCredentials
+}
+
+func (a *Authenticator) authenticateToken(token string) (*entity.Principal, error) {
+	if a.verifier == nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	claims, err := a.verifier.Verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	return &entity.Principal{
+		Subject: claims.Subject,
+		Scopes:  claims.AllScopes(),
+	}, nil
+}
+
+// Decoding the SHA-256 hash of the API key from hex.
+func decodeHash(hash string) ([sha256.Size]byte, error) {
+	var result [sha256.Size]byte
+
+	data, err := hex.DecodeString(hash)
+	if err != nil || len(data) != sha256.Size {
+		return result, fmt.Errorf("auth - wrong API key hash %q", hash)
+	}
+
+	copy(result[:], data)
+
+	return result, nil
+}
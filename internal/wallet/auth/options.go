@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"encoding/hex"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/jwt"
+)
+
+type Option func(*Authenticator)
+
+// The API key is identified by the beginning of its hash, so the key itself is not exposed.
+const (
+	_apiKeyCredential     = "apikey:"
+	_apiKeyCredentialSize = 8
+)
+
+// APIKey - the key by its hex encoded SHA-256 hash and the caller, who is authenticated by it.
+// Panics if the hash is malformed, because the keys are set once by the config.
+func APIKey(hash, subject string, scopes []string) Option {
+	return func(a *Authenticator) {
+		decoded, err := decodeHash(hash)
+		if err != nil {
+			panic(err.Error())
+		}
+
+		a.apiKeys = append(a.apiKeys, apiKey{
+			hash: decoded,
+			principal: entity.Principal{
+				Subject:    subject,
+				Scopes:     scopes,
+				Credential: _apiKeyCredential + hex.EncodeToString(decoded[:_apiKeyCredentialSize]),
+			},
+		})
+	}
+}
+
+// JWT - the verifier of the bearer tokens.
+func JWT(verifier *jwt.Verifier) Option {
+	return func(a *Authenticator) {
+		a.verifier = verifier
+	}
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type adminRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newAdminRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &adminRoutes{w, l}
+
+	h := handler.Group("/admin/wallets")
+	{
+		h.POST("/:walletId/freeze", requirePermission(w, l, entity.PermissionWalletFreeze), r.freezeWallet)
+		h.POST("/:walletId/unfreeze", requirePermission(w, l, entity.PermissionWalletFreeze), r.unfreezeWallet)
+		h.POST("/:walletId/close", requirePermission(w, l, entity.PermissionWalletClose), r.closeWallet)
+	}
+}
+
+// @Summary     Заморозка кошелька
+// @Description Запрещает любые списания и зачисления по кошельку.
+// @Tags  	    Admin
+// @Param walletId path string true "ID кошелька"
+// @Success     200 {object} entity.Wallet "Кошелек заморожен"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/wallets/{walletId}/freeze [post].
+func (r *adminRoutes) freezeWallet(c *gin.Context) {
+	wallet, err := r.w.FreezeWallet(c.Request.Context(), c.Param("walletId"))
+	if err != nil {
+		r.abortWithError(c, "freezeWallet", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, wallet)
+}
+
+// @Summary     Разморозка кошелька
+// @Tags  	    Admin
+// @Param walletId path string true "ID кошелька"
+// @Success     200 {object} entity.Wallet "Кошелек разморожен"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/wallets/{walletId}/unfreeze [post].
+func (r *adminRoutes) unfreezeWallet(c *gin.Context) {
+	wallet, err := r.w.UnfreezeWallet(c.Request.Context(), c.Param("walletId"))
+	if err != nil {
+		r.abortWithError(c, "unfreezeWallet", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, wallet)
+}
+
+// @Description Запрос закрытия кошелька.
+type closeWalletRequest struct {
+	To string `json:"to" example:"eb376add88bf8e70f80787266a0801d5" description:"ID кошелька, куда нужно перевести остаток" validate:"required"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Закрытие кошелька
+// @Description Переводит остаток средств на указанный кошелек и закрывает кошелек.
+// @Description Закрыть можно только активный кошелек без активных резервов и незавершенных пополнений и выводов.
+// @Tags  	    Admin
+// @Param walletId path string true "ID кошелька"
+// @Param input body closeWalletRequest true "Запрос закрытия кошелька"
+// @Success     200 {object} entity.Wallet "Кошелек закрыт"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Кошелек или получатель остатка не найден"
+// @Failure     409 "У кошелька есть активные резервы или незавершенные пополнения и выводы"
+// @Failure     410 "Кошелек или получатель остатка закрыт"
+// @Failure     422 "Валюты кошельков различаются"
+// @Failure     423 "Кошелек или получатель остатка заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/wallets/{walletId}/close [post].
+func (r *adminRoutes) closeWallet(c *gin.Context) {
+	var request closeWalletRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	wallet, err := r.w.CloseWallet(c.Request.Context(), c.Param("walletId"), request.To)
+	if err != nil {
+		r.abortWithError(c, "closeWallet", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, wallet)
+}
+
+// Aborting the request with the http status of the admin operation error.
+func (r *adminRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrSenderIsReceiver),
+		errors.Is(err, entity.ErrEmptyWallet):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrWalletHasHolds),
+		errors.Is(err, entity.ErrWalletHasFunding):
+		c.AbortWithStatus(http.StatusConflict)
+	case errors.Is(err, entity.ErrWalletClosed):
+		c.AbortWithStatus(http.StatusGone)
+	case errors.Is(err, entity.ErrCurrencyMismatch),
+		errors.Is(err, entity.ErrAmountOverflow):
+		c.AbortWithStatus(http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrWalletFrozen):
+		c.AbortWithStatus(http.StatusLocked)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
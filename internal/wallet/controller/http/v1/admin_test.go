@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_freezeWallet(t *testing.T) {
+	for _, test := range testsFreezeWallet {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := adminRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:walletId/freeze", handler.freezeWallet)
+			r.POST("/:walletId/unfreeze", handler.unfreezeWallet)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/%s/%s", test.id, test.action), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsFreezeWallet = []struct {
+	name                 string
+	id                   string
+	action               string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:   "Ok - freeze",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "freeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().FreezeWallet(context.Background(), id).Return(&entity.Wallet{
+				ID:        id,
+				Balance:   100,
+				Available: 100,
+				Currency:  "USD",
+				Status:    entity.WalletStatusFrozen,
+				Type:      entity.WalletTypePersonal,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100",` +
+			`"currency":"USD","status":"frozen","type":"personal"}`,
+	},
+	{
+		name:   "Ok - unfreeze",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "unfreeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().UnfreezeWallet(context.Background(), id).Return(&entity.Wallet{
+				ID:        id,
+				Balance:   100,
+				Available: 100,
+				Currency:  "USD",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypePersonal,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100",` +
+			`"currency":"USD","status":"active","type":"personal"}`,
+	},
+	{
+		name:   "Not found",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "freeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().FreezeWallet(context.Background(), id).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:   "Wallet is closed",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "unfreeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().UnfreezeWallet(context.Background(), id).Return(nil, entity.ErrWalletClosed)
+		},
+		expectedStatusCode:   410,
+		expectedResponseBody: "",
+	},
+	{
+		name:   "Timeout",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "freeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().FreezeWallet(context.Background(), id).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+	{
+		name:   "Something went wrong",
+		id:     "5b53700ed469fa6a09ea72bb78f36fd9",
+		action: "freeze",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().FreezeWallet(context.Background(), id).Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_closeWallet(t *testing.T) {
+	for _, test := range testsCloseWallet {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := adminRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:walletId/close", handler.closeWallet)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/%s/close", test.id), bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsCloseWallet = []struct {
+	name                 string
+	id                   string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "eb376add88bf8e70f80787266a0801d5").Return(&entity.Wallet{
+				ID:       id,
+				Currency: "USD",
+				Status:   entity.WalletStatusClosed,
+				Type:     entity.WalletTypePersonal,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"0","held":"0","available":"0",` +
+			`"currency":"USD","status":"closed","type":"personal"}`,
+	},
+	{
+		name:                 "Wrong input - not json",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `to`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wrong input - without receiver",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "").Return(nil, entity.ErrEmptyWallet)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wallet has active holds",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "eb376add88bf8e70f80787266a0801d5").
+				Return(nil, entity.ErrWalletHasHolds)
+		},
+		expectedStatusCode:   409,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wallet has pending fundings",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "eb376add88bf8e70f80787266a0801d5").
+				Return(nil, entity.ErrWalletHasFunding)
+		},
+		expectedStatusCode:   409,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wallet is frozen",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "eb376add88bf8e70f80787266a0801d5").
+				Return(nil, entity.ErrWalletFrozen)
+		},
+		expectedStatusCode:   423,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Currencies of wallets are different",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CloseWallet(context.Background(), id, "eb376add88bf8e70f80787266a0801d5").
+				Return(nil, entity.ErrCurrencyMismatch)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+}
@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	_requestIDHeader = "X-Request-Id"
+	_requestIDKey    = "requestId"
+	_maxRequestID    = 64
+	// The request is already logged, for example as the denial.
+	_auditedKey = "audited"
+	// Request bodies, which are larger, are logged without the payload.
+	_maxAuditPayload = 16 << 10
+)
+
+// Setting the id of every request, which is taken from the header of the client or generated.
+// The id is returned in the header of the response and is written to the audit log.
+func requestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(_requestIDHeader)
+		if !isValidRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(_requestIDKey, id)
+		c.Header(_requestIDHeader, id)
+		c.Next()
+	}
+}
+
+// Appending every state-changing request of the authenticated caller to the audit log
+// with its payload and outcome. The read-only requests are logged only if they are denied.
+func audit(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		payload := readAuditPayload(c)
+
+		c.Next()
+
+		if c.GetBool(_auditedKey) {
+			return
+		}
+
+		status := c.Writer.Status()
+
+		outcome, reason := entity.AuditOutcomeSuccess, ""
+		if status >= http.StatusBadRequest {
+			outcome, reason = entity.AuditOutcomeFailure, fmt.Sprintf("%d %s", status, http.StatusText(status))
+		}
+
+		event := newAuditEvent(c, outcome, reason)
+		event.Payload = payload
+
+		recordAuditEvent(c, w, l, event)
+	}
+}
+
+// Reading the body of the request, which is passed to the handler afterwards.
+// Returns nil if the body is too large or is not json.
+func readAuditPayload(c *gin.Context) json.RawMessage {
+	if c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, _maxAuditPayload+1))
+	c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), c.Request.Body))
+
+	if err != nil || len(body) > _maxAuditPayload {
+		return nil
+	}
+
+	var payload bytes.Buffer
+	if err := json.Compact(&payload, body); err != nil {
+		return nil
+	}
+
+	return payload.Bytes()
+}
+
+// Creating the audit event of the request.
+func newAuditEvent(c *gin.Context, outcome, reason string) *entity.AuditEvent {
+	return &entity.AuditEvent{
+		Actor:     principalFrom(c).Subject,
+		Action:    c.Request.Method + " " + c.FullPath(),
+		Resource:  c.Request.URL.Path,
+		Outcome:   outcome,
+		Reason:    reason,
+		RequestID: c.GetString(_requestIDKey),
+		IP:        c.ClientIP(),
+	}
+}
+
+// Appending the event to the audit log. The request is not failed, if the audit log is unavailable.
+func recordAuditEvent(c *gin.Context, w usecase.Wallet, l *slog.Logger, event *entity.AuditEvent) {
+	c.Set(_auditedKey, true)
+
+	// The event is logged even if the client has gone away
+	if err := w.RecordAuditEvent(context.WithoutCancel(c.Request.Context()), event); err != nil {
+		l.Error("http - v1 - recordAuditEvent", sl.Err(err), slog.String("actor", event.Actor),
+			slog.String("action", event.Action), slog.String("outcome", event.Outcome),
+			slog.String("requestId", event.RequestID))
+	}
+}
+
+// Checking that the id of the request from the client is short and consists of the safe characters.
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > _maxRequestID {
+		return false
+	}
+
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+type auditRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newAuditRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &auditRoutes{w, l}
+
+	h := handler.Group("/admin/audit")
+	{
+		h.GET("", requirePermission(w, l, entity.PermissionAuditRead), r.getAuditEvents)
+	}
+}
+
+// @Summary     Журнал аудита
+// @Description Возвращает записи журнала аудита постранично, от новых к старым.
+// @Description Следующая страница запрашивается с курсором nextCursor из предыдущей.
+// @Description Журнал содержит все изменяющие запросы, отказы в доступе и действия воркера от имени system.
+// @Tags  	    Admin
+// @Param actor query string false "Субъект, выполнивший действие"
+// @Param action query string false "Действие, например POST /api/v1/wallet/:walletId/send"
+// @Param resource query string false "Начало пути ресурса, например /api/v1/wallet/5b53700ed469fa6a09ea72bb78f36fd9"
+// @Param outcome query string false "Результат действия" Enums(success, failure, denied)
+// @Param requestId query string false "ID запроса"
+// @Param since query string false "Начало периода включительно, RFC3339"
+// @Param until query string false "Конец периода не включительно, RFC3339"
+// @Param cursor query int false "Курсор страницы"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 100"
+// @Success     200 {object} entity.AuditPage "Страница журнала аудита"
+// @Failure     400 "Неверный фильтр или курсор"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/audit [get].
+func (r *auditRoutes) getAuditEvents(c *gin.Context) {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	page, err := r.w.GetAuditEvents(c.Request.Context(), filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrWrongAuditFilter):
+			c.AbortWithStatus(http.StatusBadRequest)
+		case errors.Is(err, entity.ErrTimeout):
+			c.AbortWithStatus(http.StatusGatewayTimeout)
+		default:
+			r.l.Error("http - v1 - getAuditEvents", sl.Err(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Parsing the filter of the audit log from the query parameters.
+func parseAuditFilter(c *gin.Context) (entity.AuditFilter, error) {
+	var err error
+
+	filter := entity.AuditFilter{
+		Actor:     c.Query("actor"),
+		Action:    c.Query("action"),
+		Resource:  c.Query("resource"),
+		Outcome:   c.Query("outcome"),
+		RequestID: c.Query("requestId"),
+	}
+
+	if value := c.Query("since"); value != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, value); err != nil {
+			return filter, entity.ErrWrongAuditFilter
+		}
+	}
+
+	if value := c.Query("until"); value != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, value); err != nil {
+			return filter, entity.ErrWrongAuditFilter
+		}
+	}
+
+	if value := c.Query("cursor"); value != "" {
+		if filter.Cursor, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return filter, entity.ErrWrongAuditFilter
+		}
+	}
+
+	if value := c.Query("limit"); value != "" {
+		if filter.Limit, err = strconv.Atoi(value); err != nil {
+			return filter, entity.ErrWrongAuditFilter
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_audit(t *testing.T) {
+	for _, test := range testsAudit {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			var recorded []*entity.AuditEvent
+
+			repo := mock_usecase.NewMockWallet(c)
+			repo.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, event *entity.AuditEvent) error {
+					recorded = append(recorded, event)
+					return nil
+				}).AnyTimes()
+
+			l := logger.SetupLogger("debug")
+			// Init Endpoint
+			r := gin.New()
+			r.Use(requestID(), withPrincipal(test.principal), audit(repo, l))
+			r.POST("/wallet/:walletId/send", requirePermission(repo, l, entity.PermissionWalletRead), func(c *gin.Context) {
+				var body map[string]interface{}
+				if err := c.BindJSON(&body); err != nil {
+					return
+				}
+
+				c.Status(test.handlerStatus)
+			})
+			r.GET("/wallet/:walletId", func(c *gin.Context) { c.Status(http.StatusOK) })
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.target, bytes.NewBufferString(test.reqBody))
+			req.Header.Set(_requestIDHeader, test.requestID)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+
+			requestID := w.Header().Get(_requestIDHeader)
+			if test.expectedRequestID != "" {
+				assert.Equal(t, requestID, test.expectedRequestID)
+			}
+			// The unsafe id of the client is replaced by the generated one
+			assert.Equal(t, isValidRequestID(requestID), true)
+
+			for _, event := range test.expectedEvents {
+				event.RequestID = requestID
+			}
+
+			assert.Equal(t, recorded, test.expectedEvents)
+		})
+	}
+}
+
+var testsAudit = []struct {
+	name               string
+	principal          *entity.Principal
+	method             string
+	target             string
+	reqBody            string
+	requestID          string
+	handlerStatus      int
+	expectedStatusCode int
+	expectedRequestID  string
+	expectedEvents     []*entity.AuditEvent
+}{
+	{
+		name:               "Ok, logged with the payload",
+		principal:          &entity.Principal{Subject: "operations", Permissions: []string{entity.PermissionWalletRead}},
+		method:             http.MethodPost,
+		target:             "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+		reqBody:            `{ "to": "eb376add88bf8e70f80787266a0801d5", "amount": "100" }`,
+		requestID:          "req-1",
+		handlerStatus:      http.StatusOK,
+		expectedStatusCode: 200,
+		expectedRequestID:  "req-1",
+		expectedEvents: []*entity.AuditEvent{{
+			Actor:    "operations",
+			Action:   "POST /wallet/:walletId/send",
+			Resource: "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+			Outcome:  entity.AuditOutcomeSuccess,
+			IP:       "192.0.2.1",
+			Payload:  json.RawMessage(`{"to":"eb376add88bf8e70f80787266a0801d5","amount":"100"}`),
+		}},
+	},
+	{
+		name:               "Failure, logged with the status",
+		principal:          &entity.Principal{Subject: "operations", Permissions: []string{entity.PermissionWalletRead}},
+		method:             http.MethodPost,
+		target:             "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+		reqBody:            `{"amount":"100"}`,
+		requestID:          "req 2",
+		handlerStatus:      http.StatusConflict,
+		expectedStatusCode: 409,
+		expectedEvents: []*entity.AuditEvent{{
+			Actor:    "operations",
+			Action:   "POST /wallet/:walletId/send",
+			Resource: "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+			Outcome:  entity.AuditOutcomeFailure,
+			Reason:   "409 Conflict",
+			IP:       "192.0.2.1",
+			Payload:  json.RawMessage(`{"amount":"100"}`),
+		}},
+	},
+	{
+		name:               "Not json payload",
+		principal:          &entity.Principal{Subject: "operations", Permissions: []string{entity.PermissionWalletRead}},
+		method:             http.MethodPost,
+		target:             "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+		reqBody:            `amount=100`,
+		handlerStatus:      http.StatusOK,
+		expectedStatusCode: 400,
+		expectedEvents: []*entity.AuditEvent{{
+			Actor:    "operations",
+			Action:   "POST /wallet/:walletId/send",
+			Resource: "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+			Outcome:  entity.AuditOutcomeFailure,
+			Reason:   "400 Bad Request",
+			IP:       "192.0.2.1",
+		}},
+	},
+	{
+		name:               "Denial is logged once",
+		principal:          &entity.Principal{Subject: "operations", Permissions: []string{}},
+		method:             http.MethodPost,
+		target:             "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+		reqBody:            `{"amount":"100"}`,
+		handlerStatus:      http.StatusOK,
+		expectedStatusCode: 403,
+		expectedEvents: []*entity.AuditEvent{{
+			Actor:    "operations",
+			Action:   "POST /wallet/:walletId/send",
+			Resource: "/wallet/5b53700ed469fa6a09ea72bb78f36fd9/send",
+			Outcome:  entity.AuditOutcomeDenied,
+			Reason:   "forbidden: missing permission wallet:read",
+			IP:       "192.0.2.1",
+		}},
+	},
+	{
+		name:               "Read is not logged",
+		principal:          &entity.Principal{Subject: "operations"},
+		method:             http.MethodGet,
+		target:             "/wallet/5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode: 200,
+		expectedEvents:     nil,
+	},
+}
+
+func Test_getAuditEvents(t *testing.T) {
+	for _, test := range testsGetAuditEvents {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+
+			handler := auditRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.GET("/admin/audit", handler.getAuditEvents)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/admin/audit"+test.query, nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsGetAuditEvents = []struct {
+	name                 string
+	query                string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:  "Ok",
+		query: "?actor=operations&outcome=success&since=2024-05-05T00:00:00Z&cursor=10&limit=1",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetAuditEvents(context.Background(), entity.AuditFilter{
+				Actor:   "operations",
+				Outcome: entity.AuditOutcomeSuccess,
+				Since:   time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC),
+				Cursor:  10,
+				Limit:   1,
+			}).Return(&entity.AuditPage{
+				Events: []entity.AuditEvent{{
+					ID:        9,
+					Time:      time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC),
+					Actor:     "operations",
+					Action:    "POST /api/v1/admin/wallets/:walletId/freeze",
+					Resource:  "/api/v1/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze",
+					Outcome:   entity.AuditOutcomeSuccess,
+					RequestID: "req-1",
+					IP:        "203.0.113.7",
+				}},
+				NextCursor: 9,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"events":[{"id":9,"time":"2024-05-05T12:00:00Z","actor":"operations",` +
+			`"action":"POST /api/v1/admin/wallets/:walletId/freeze",` +
+			`"resource":"/api/v1/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze","outcome":"success",` +
+			`"requestId":"req-1","ip":"203.0.113.7"}],"nextCursor":9}`,
+	},
+	{
+		name:                 "Wrong cursor",
+		query:                "?cursor=abc",
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: ``,
+	},
+	{
+		name:  "Wrong outcome",
+		query: "?outcome=unknown",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetAuditEvents(context.Background(), entity.AuditFilter{Outcome: "unknown"}).
+				Return(nil, entity.ErrWrongAuditFilter)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: ``,
+	},
+}
@@ -0,0 +1,254 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/auth"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	_principalKey  = "principal"
+	_holdWalletKey = "holdWalletId"
+)
+
+var errForbidden = errors.New("forbidden")
+
+// Authenticating the caller of every request. The principal is available by principalFrom.
+func authenticate(a *auth.Authenticator, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, err := a.Authenticate(c.Request)
+		if err != nil {
+			if !errors.Is(err, auth.ErrNoCredentials) {
+				l.Debug("http - v1 - authenticate", sl.Err(err))
+			}
+
+			c.Header("WWW-Authenticate", `Bearer realm="wallet"`)
+			c.AbortWithStatus(http.StatusUnauthorized)
+
+			return
+		}
+
+		c.Set(_principalKey, principal)
+		c.Next()
+	}
+}
+
+// Allowing the request only for the callers with the permission.
+func requirePermission(w usecase.Wallet, l *slog.Logger, permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := checkPermission(c, w, permission); err != nil {
+			abortWithAccessError(c, w, l, "requirePermission", err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Allowing the request only for the callers, who can send from the wallet of the path.
+func requireSender(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := checkSender(c, w, c.Param("walletId")); err != nil {
+			abortWithAccessError(c, w, l, "requireSender", err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Allowing the request only for the callers, who can send from the wallet of the hold.
+func requireHoldSender(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hold, err := w.GetHoldByID(c.Request.Context(), c.Param("holdId"))
+		if err == nil {
+			err = checkSender(c, w, hold.WalletID)
+		}
+
+		if err != nil {
+			abortWithAccessError(c, w, l, "requireHoldSender", err)
+			return
+		}
+
+		c.Set(_holdWalletKey, hold.WalletID)
+		c.Next()
+	}
+}
+
+// Allowing the request only for the callers, who can read the wallet of the path.
+func requireReader(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := checkReader(c, w, c.Param("walletId")); err != nil {
+			abortWithAccessError(c, w, l, "requireReader", err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Allowing the request only for the customer of the path and for the callers, who can read all wallets.
+func requireCustomerReader(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal := principalFrom(c)
+		if principal.Subject != "" && strings.EqualFold(principal.Subject, c.Param("customerId")) {
+			c.Next()
+			return
+		}
+
+		if err := checkPermission(c, w, entity.PermissionWalletRead); err != nil {
+			abortWithAccessError(c, w, l, "requireCustomerReader", err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Allowing the request only for the customer of the path and for the callers, who manage the customers.
+func requireCustomer(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := checkCustomer(c, w, c.Param("customerId")); err != nil {
+			abortWithAccessError(c, w, l, "requireCustomer", err)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Allowing the request only for the callers with the subject, who own the resources of the request.
+func requireSubject(w usecase.Wallet, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if principalFrom(c).Subject == "" {
+			abortWithAccessError(c, w, l, "requireSubject", fmt.Errorf("%w: caller has no subject", errForbidden))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Checking that the caller owns the wallet or the sending from it is delegated to the caller.
+// The wallet is requested only if the sending is not delegated.
+func checkSender(c *gin.Context, w usecase.Wallet, walletID string) error {
+	principal := principalFrom(c)
+	if principal.CanSendFrom(walletID) {
+		return nil
+	}
+
+	wallet, err := w.GetWalletByID(c.Request.Context(), walletID)
+	if err != nil {
+		return err
+	}
+
+	if !principal.Owns(wallet) {
+		return fmt.Errorf("%w: wallet %s is not owned by the caller", errForbidden, walletID)
+	}
+
+	return nil
+}
+
+// Checking that the caller can read one of the wallets: owns it, can send from it or has the permission.
+func checkReader(c *gin.Context, w usecase.Wallet, walletIDs ...string) error {
+	principal := principalFrom(c)
+	if principal.HasPermission(entity.PermissionWalletRead) {
+		return nil
+	}
+
+	for _, walletID := range walletIDs {
+		if walletID == "" {
+			continue
+		}
+
+		if principal.CanSendFrom(walletID) {
+			return nil
+		}
+
+		wallet, err := w.GetWalletByID(c.Request.Context(), walletID)
+		if err != nil {
+			return err
+		}
+
+		if principal.Owns(wallet) {
+			return nil
+		}
+	}
+
+	return checkPermission(c, w, entity.PermissionWalletRead)
+}
+
+// Checking that the caller is the customer or manages the customers.
+func checkCustomer(c *gin.Context, w usecase.Wallet, customerID string) error {
+	principal := principalFrom(c)
+	if principal.Subject != "" && strings.EqualFold(principal.Subject, customerID) {
+		return nil
+	}
+
+	return checkPermission(c, w, entity.PermissionCustomersManage)
+}
+
+// Checking that the permission is granted to the caller by the scopes or by the assigned roles.
+// The roles are requested once per request and only if the scopes do not grant the permission.
+func checkPermission(c *gin.Context, w usecase.Wallet, permission string) error {
+	principal := principalFrom(c)
+
+	if !principal.HasPermission(permission) && principal.Permissions == nil && principal.Subject != "" {
+		roles, err := w.GetSubjectRoles(c.Request.Context(), principal.Subject)
+		if err != nil && !errors.Is(err, entity.ErrWrongSubject) {
+			return err
+		}
+
+		principal.GrantRoles(roles)
+	}
+
+	if !principal.HasPermission(permission) {
+		return fmt.Errorf("%w: missing permission %s", errForbidden, permission)
+	}
+
+	return nil
+}
+
+// Getting the authenticated caller. Without the authentication the caller has no rights.
+func principalFrom(c *gin.Context) *entity.Principal {
+	if principal, ok := c.Get(_principalKey); ok {
+		if p, ok := principal.(*entity.Principal); ok {
+			return p
+		}
+	}
+
+	principal := &entity.Principal{}
+	c.Set(_principalKey, principal)
+
+	return principal
+}
+
+// Aborting the request with the http status of the access check error. Every denial is audit-logged.
+func abortWithAccessError(c *gin.Context, w usecase.Wallet, l *slog.Logger, operation string, err error) {
+	switch {
+	case errors.Is(err, errForbidden):
+		auditDenial(c, w, l, err.Error())
+		c.AbortWithStatus(http.StatusForbidden)
+	case errors.Is(err, entity.ErrWalletNotFound),
+		errors.Is(err, entity.ErrHoldNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// Appending the denial to the audit log. The request is denied even if the audit log is unavailable.
+func auditDenial(c *gin.Context, w usecase.Wallet, l *slog.Logger, reason string) {
+	recordAuditEvent(c, w, l, newAuditEvent(c, entity.AuditOutcomeDenied, reason))
+}
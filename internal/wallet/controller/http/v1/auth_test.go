@@ -0,0 +1,485 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/auth"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/jwt"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+const (
+	testAPIKey    = "test-key"
+	testJWTSecret = "secret"
+)
+
+// Setting the authenticated caller for the handlers under the test.
+func withPrincipal(principal *entity.Principal) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(_principalKey, principal)
+	}
+}
+
+func testToken(claims string) string {
+	signed := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(testJWTSecret))
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func Test_authenticate(t *testing.T) {
+	hash := sha256.Sum256([]byte(testAPIKey))
+
+	verifier, err := jwt.NewHMAC([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := auth.New(
+		auth.APIKey(hex.EncodeToString(hash[:]), "operations", []string{entity.ScopeAdmin}),
+		auth.JWT(verifier),
+	)
+
+	for _, test := range testsAuthenticate {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Endpoint
+			r := gin.New()
+			r.GET("/", authenticate(a, logger.SetupLogger("debug")), func(c *gin.Context) {
+				principal := principalFrom(c)
+				c.String(http.StatusOK, "%s %v", principal.Subject, principal.Scopes)
+			})
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsAuthenticate = []struct {
+	name                 string
+	headers              map[string]string
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:                 "Ok - API key",
+		headers:              map[string]string{auth.APIKeyHeader: testAPIKey},
+		expectedStatusCode:   200,
+		expectedResponseBody: "operations [admin]",
+	},
+	{
+		name: "Ok - bearer token",
+		headers: map[string]string{"Authorization": "Bearer " + testToken(fmt.Sprintf(
+			`{"sub":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21","scope":"wallet:send","exp":%d}`,
+			time.Now().Add(time.Hour).Unix(),
+		))},
+		expectedStatusCode:   200,
+		expectedResponseBody: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21 [wallet:send]",
+	},
+	{
+		name:                 "Without credentials",
+		expectedStatusCode:   401,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong API key",
+		headers:              map[string]string{auth.APIKeyHeader: "other-key"},
+		expectedStatusCode:   401,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Expired token",
+		headers: map[string]string{"Authorization": "Bearer " + testToken(fmt.Sprintf(
+			`{"sub":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21","exp":%d}`,
+			time.Now().Add(-time.Hour).Unix(),
+		))},
+		expectedStatusCode:   401,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Not bearer",
+		headers:              map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+		expectedStatusCode:   401,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_requirePermission(t *testing.T) {
+	for _, test := range testsRequirePermission {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.POST("/admin/wallets/:walletId/freeze",
+				requirePermission(repo, logger.SetupLogger("debug"), entity.PermissionWalletFreeze),
+				func(c *gin.Context) { c.Status(http.StatusOK) },
+			)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze", nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+		})
+	}
+}
+
+var testsRequirePermission = []struct {
+	name               string
+	principal          *entity.Principal
+	mockBehavior       func(r *mock_usecase.MockWallet)
+	expectedStatusCode int
+}{
+	{
+		name:               "Ok - admin scope",
+		principal:          &entity.Principal{Subject: "operations", Scopes: []string{entity.ScopeAdmin}},
+		mockBehavior:       func(_ *mock_usecase.MockWallet) {},
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Ok - permission scope",
+		principal:          &entity.Principal{Subject: "operations", Scopes: []string{entity.PermissionWalletFreeze}},
+		mockBehavior:       func(_ *mock_usecase.MockWallet) {},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Ok - assigned role",
+		principal: &entity.Principal{Subject: "agent-42"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "agent-42").Return([]entity.Role{{
+				Name:        "support",
+				Permissions: []string{entity.PermissionWalletRead, entity.PermissionWalletFreeze},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Denied and audit-logged",
+		principal: &entity.Principal{Subject: "auditor-7"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "auditor-7").Return([]entity.Role{{
+				Name:        "auditor",
+				Permissions: []string{entity.PermissionWalletRead, entity.PermissionAuditRead},
+			}}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), &entity.AuditEvent{
+				Actor:    "auditor-7",
+				Action:   "POST /admin/wallets/:walletId/freeze",
+				Resource: "/admin/wallets/5b53700ed469fa6a09ea72bb78f36fd9/freeze",
+				Outcome:  entity.AuditOutcomeDenied,
+				Reason:   "forbidden: missing permission wallet:freeze",
+				IP:       "192.0.2.1",
+			}).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Denied without roles",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").Return([]entity.Role{}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Denied when audit log is unavailable",
+		principal: &entity.Principal{Subject: "agent-42", Permissions: []string{}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(entity.ErrTimeout)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Roles are unavailable",
+		principal: &entity.Principal{Subject: "agent-42"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "agent-42").Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode: 504,
+	},
+}
+
+func Test_requireSender(t *testing.T) {
+	for _, test := range testsRequireSender {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.POST("/:walletId/send", requireSender(repo, logger.SetupLogger("debug")), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/%s/send", test.id), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+		})
+	}
+}
+
+var testsRequireSender = []struct {
+	name               string
+	id                 string
+	principal          *entity.Principal
+	mockBehavior       func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode int
+}{
+	{
+		name:      "Ok - owner",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{
+				ID:      id,
+				OwnerID: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+			}, nil)
+		},
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Ok - delegated wallet",
+		id:                 "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal:          &entity.Principal{Scopes: []string{entity.WalletSendScope("5b53700ed469fa6a09ea72bb78f36fd9")}},
+		mockBehavior:       func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Ok - delegated all wallets",
+		id:                 "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal:          &entity.Principal{Scopes: []string{entity.ScopeSend}},
+		mockBehavior:       func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Not owner",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{
+				ID:      id,
+				OwnerID: "0c7c1d9f-5e21-4b8e-9a55-9b2f4a368c0e",
+			}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Anonymous wallet",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{ID: id}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Delegated other wallet",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Scopes: []string{entity.WalletSendScope("eb376add88bf8e70f80787266a0801d5")}},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{ID: id}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Wallet not found",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode: 404,
+	},
+}
+
+func Test_requireReader(t *testing.T) {
+	for _, test := range testsRequireReader {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.GET("/:walletId", requireReader(repo, logger.SetupLogger("debug")), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/"+test.id, nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+		})
+	}
+}
+
+var testsRequireReader = []struct {
+	name               string
+	id                 string
+	principal          *entity.Principal
+	mockBehavior       func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode int
+}{
+	{
+		name:      "Ok - owner",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{
+				ID:      id,
+				OwnerID: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+			}, nil)
+		},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Ok - support agent",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "agent-42"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{ID: id}, nil)
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "agent-42").Return([]entity.Role{{
+				Name:        "support",
+				Permissions: []string{entity.PermissionWalletRead},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Not owner",
+		id:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletByID(gomock.Any(), id).Return(&entity.Wallet{ID: id}, nil)
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").Return([]entity.Role{}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+}
+
+func Test_requireCustomer(t *testing.T) {
+	for _, test := range testsRequireCustomer {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.POST("/customers/:customerId/wallets", requireCustomer(repo, logger.SetupLogger("debug")), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/customers/"+test.id+"/wallets", nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+		})
+	}
+}
+
+var testsRequireCustomer = []struct {
+	name               string
+	id                 string
+	principal          *entity.Principal
+	mockBehavior       func(r *mock_usecase.MockWallet)
+	expectedStatusCode int
+}{
+	{
+		name:               "Ok - customer",
+		id:                 "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		principal:          &entity.Principal{Subject: "9B2F4A36-8C0E-4B8E-9A55-0C7C1D9F5E21"},
+		mockBehavior:       func(_ *mock_usecase.MockWallet) {},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Ok - customers manager",
+		id:        "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		principal: &entity.Principal{Subject: "agent-42"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "agent-42").Return([]entity.Role{{
+				Name:        "administrator",
+				Permissions: []string{entity.PermissionCustomersManage},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+	},
+	{
+		name:      "Other customer",
+		id:        "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		principal: &entity.Principal{Subject: "3c0e1f5a-0d4b-4a3e-8f61-2b7d9e8c4a10"},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "3c0e1f5a-0d4b-4a3e-8f61-2b7d9e8c4a10").Return([]entity.Role{}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+	{
+		name:      "Support agent",
+		id:        "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		principal: &entity.Principal{Subject: "agent-42", Scopes: []string{entity.PermissionWalletRead}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetSubjectRoles(gomock.Any(), "agent-42").Return([]entity.Role{}, nil)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode: 403,
+	},
+}
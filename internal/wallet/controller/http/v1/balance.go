@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type balanceRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newBalanceRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &balanceRoutes{w, l}
+
+	h := handler.Group("/wallet")
+	{
+		h.GET("/:walletId/balance", requireReader(w, l), r.getWalletBalanceAt)
+	}
+}
+
+// @Summary     Получение баланса кошелька на момент времени
+// @Description Баланс восстанавливается по журналу операций кошелька, начиная с начального баланса.
+// @Tags  	    Wallet
+// @Param walletId path string true "ID кошелька"
+// @Param at query string true "Момент времени, RFC3339"
+// @Success     200 {object} entity.HistoricalBalance "Баланс кошелька на момент времени"
+// @Failure     400 "Момент времени не указан или указан неверно"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/balance [get].
+func (r *balanceRoutes) getWalletBalanceAt(c *gin.Context) {
+	at, err := time.Parse(time.RFC3339, c.Query("at"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	balance, err := r.w.GetWalletBalanceAt(c.Request.Context(), c.Param("walletId"), at)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrWrongBalanceTime):
+			c.AbortWithStatus(http.StatusBadRequest)
+		case errors.Is(err, entity.ErrWalletNotFound):
+			c.AbortWithStatus(http.StatusNotFound)
+		case errors.Is(err, entity.ErrTimeout):
+			c.AbortWithStatus(http.StatusGatewayTimeout)
+		default:
+			r.l.Error("http - v1 - getWalletBalanceAt", sl.Err(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, balance)
+}
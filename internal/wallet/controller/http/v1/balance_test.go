@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_getWalletBalanceAt(t *testing.T) {
+	for _, test := range testsGetWalletBalanceAt {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := balanceRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.GET("/:walletId/balance", handler.getWalletBalanceAt)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/balance%s", test.id, test.query), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var endOfMarch = time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)
+
+var testsGetWalletBalanceAt = []struct {
+	name                 string
+	id                   string
+	query                string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:  "Ok",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?at=2024-03-31T23:59:59Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletBalanceAt(context.Background(), id, endOfMarch).Return(&entity.HistoricalBalance{
+				WalletID: id,
+				At:       endOfMarch,
+				Balance:  7500,
+				Currency: "USD",
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9","at":"2024-03-31T23:59:59Z",` +
+			`"balance":"7500","currency":"USD"}`,
+	},
+	{
+		name:                 "Without moment",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong moment",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "?at=end-of-march",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Not found",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?at=2024-03-31T23:59:59Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletBalanceAt(context.Background(), id, endOfMarch).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Timeout",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?at=2024-03-31T23:59:59Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletBalanceAt(context.Background(), id, endOfMarch).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Something went wrong",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?at=2024-03-31T23:59:59Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletBalanceAt(context.Background(), id, endOfMarch).Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedResponseBody: "",
+	},
+}
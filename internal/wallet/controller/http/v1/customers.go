@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type customerRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newCustomerRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &customerRoutes{w, l}
+
+	h := handler.Group("/customers")
+	{
+		h.POST("", requirePermission(w, l, entity.PermissionCustomersManage), r.createCustomer)
+		h.GET("/:customerId", requireCustomerReader(w, l), r.getCustomerByID)
+		h.GET("/:customerId/wallets", requireCustomerReader(w, l), r.getCustomerWallets)
+		h.POST("/:customerId/wallets", requireCustomer(w, l), r.createCustomerWallet)
+	}
+}
+
+// @Description Запрос создания клиента.
+type createCustomerRequest struct {
+	Type    string `json:"type"    example:"individual"       description:"Тип клиента: individual или company" validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	Name    string `json:"name"    example:"Ivan Petrov"      description:"Имя человека или название компании"  validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	Email   string `json:"email"   example:"ivan@example.com" description:"Адрес электронной почты"             validate:"optional"` //nolint:lll,tagalign // вот так то лучше
+	Phone   string `json:"phone"   example:"+79991234567"     description:"Номер телефона"                      validate:"optional"` //nolint:lll,tagalign // вот так то лучше
+	Country string `json:"country" example:"RU"               description:"Страна клиента ISO 3166-1 alpha-2"   validate:"optional"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Создание клиента
+// @Description Создает клиента, который может владеть кошельками. Идентификатор генерируется сервером.
+// @Description Требуется разрешение customers:manage.
+// @Tags  	    Customers
+// @Param input body createCustomerRequest true "Запрос создания клиента"
+// @Success     201 {object} entity.Customer "Клиент создан"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось создать клиента"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /customers [post].
+func (r *customerRoutes) createCustomer(c *gin.Context) {
+	var request createCustomerRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	customer, err := r.w.CreateCustomer(c.Request.Context(), &entity.Customer{
+		Type:    request.Type,
+		Name:    request.Name,
+		Email:   request.Email,
+		Phone:   request.Phone,
+		Country: request.Country,
+	})
+	if err != nil {
+		r.abortWithError(c, "createCustomer", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, customer)
+}
+
+// @Summary     Получение клиента
+// @Tags  	    Customers
+// @Param customerId path string true "ID клиента"
+// @Success     200 {object} entity.Customer "Клиент"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный клиент не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /customers/{customerId} [get].
+func (r *customerRoutes) getCustomerByID(c *gin.Context) {
+	customer, err := r.w.GetCustomerByID(c.Request.Context(), c.Param("customerId"))
+	if err != nil {
+		r.abortWithError(c, "getCustomerByID", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, customer)
+}
+
+// @Summary     Получение кошельков клиента
+// @Tags  	    Customers
+// @Param customerId path string true "ID клиента"
+// @Success     200 {array} entity.Wallet "Кошельки клиента"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный клиент не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /customers/{customerId}/wallets [get].
+func (r *customerRoutes) getCustomerWallets(c *gin.Context) {
+	wallets, err := r.w.GetCustomerWallets(c.Request.Context(), c.Param("customerId"))
+	if err != nil {
+		r.abortWithError(c, "getCustomerWallets", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, wallets)
+}
+
+// @Description Запрос открытия кошелька клиента.
+type createCustomerWalletRequest struct {
+	Currency string `json:"currency" example:"USD"      description:"Валюта кошелька ISO 4217, по умолчанию USD"`                           //nolint:lll,tagalign // вот так то лучше
+	Type     string `json:"type"     example:"business" description:"Тип кошелька: personal, business или merchant, по умолчанию personal"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Открытие кошелька клиента
+// @Description Создает новый кошелек, владельцем которого является клиент.
+// @Description Открыть кошелек может сам клиент, либо обладатель разрешения customers:manage.
+// @Tags  	    Customers
+// @Param customerId path string true "ID клиента"
+// @Param input body createCustomerWalletRequest false "Запрос открытия кошелька клиента"
+// @Success     201 {object} entity.Wallet "Кошелек создан"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный клиент не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось создать кошелек"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /customers/{customerId}/wallets [post].
+func (r *customerRoutes) createCustomerWallet(c *gin.Context) {
+	var request createCustomerWalletRequest
+	// Request body is optional
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	wallet, err := r.w.CreateNewWalletWithDefaultBalance(
+		c.Request.Context(),
+		request.Currency,
+		request.Type,
+		c.Param("customerId"),
+	)
+	if err != nil {
+		r.abortWithError(c, "createCustomerWallet", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, wallet)
+}
+
+// Aborting the request with the http status of the customer operation error.
+func (r *customerRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWrongCustomer),
+		errors.Is(err, entity.ErrWrongCurrency),
+		errors.Is(err, entity.ErrWrongWalletType):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrCustomerNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,266 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+const testCustomerID = "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+
+func Test_createCustomer(t *testing.T) {
+	for _, test := range testsCreateCustomer {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := customerRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/customers", handler.createCustomer)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/customers", bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsCreateCustomer = []struct {
+	name                 string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		reqBody: `{"type":"individual","name":"Ivan Petrov","email":"ivan@example.com","country":"RU"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CreateCustomer(context.Background(), &entity.Customer{
+				Type:    entity.CustomerTypeIndividual,
+				Name:    "Ivan Petrov",
+				Email:   "ivan@example.com",
+				Country: "RU",
+			}).Return(&entity.Customer{
+				ID:        testCustomerID,
+				Type:      entity.CustomerTypeIndividual,
+				Name:      "Ivan Petrov",
+				Email:     "ivan@example.com",
+				Country:   "RU",
+				CreatedAt: time.Date(2024, 5, 4, 12, 0, 0, 0, time.UTC),
+			}, nil)
+		},
+		expectedStatusCode: 201,
+		expectedResponseBody: `{"id":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21","type":"individual","name":"Ivan Petrov",` +
+			`"email":"ivan@example.com","country":"RU","createdAt":"2024-05-04T12:00:00Z"}`,
+	},
+	{
+		name:    "Wrong customer",
+		reqBody: `{"type":"individual","name":""}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CreateCustomer(context.Background(), &entity.Customer{
+				Type: entity.CustomerTypeIndividual,
+			}).Return(nil, entity.ErrWrongCustomer)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong input - not json",
+		reqBody:              `helloworld`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Timeout",
+		reqBody: `{"type":"company","name":"Acme"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CreateCustomer(context.Background(), &entity.Customer{
+				Type: entity.CustomerTypeCompany,
+				Name: "Acme",
+			}).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_getCustomerWallets(t *testing.T) {
+	for _, test := range testsGetCustomerWallets {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := customerRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.GET("/customers/:customerId/wallets", handler.getCustomerWallets)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/customers/%s/wallets", test.id), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsGetCustomerWallets = []struct {
+	name                 string
+	id                   string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		id:   testCustomerID,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetCustomerWallets(context.Background(), id).Return([]entity.Wallet{{
+				ID:        "5b53700ed469fa6a09ea72bb78f36fd9",
+				Balance:   100,
+				Available: 100,
+				Currency:  "USD",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypePersonal,
+				OwnerID:   id,
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `[{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100",` +
+			`"currency":"USD","status":"active","type":"personal","ownerId":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"}]`,
+	},
+	{
+		name: "Ok - no wallets",
+		id:   testCustomerID,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetCustomerWallets(context.Background(), id).Return([]entity.Wallet{}, nil)
+		},
+		expectedStatusCode:   200,
+		expectedResponseBody: `[]`,
+	},
+	{
+		name: "Customer not found",
+		id:   testCustomerID,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetCustomerWallets(context.Background(), id).Return(nil, entity.ErrCustomerNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_createCustomerWallet(t *testing.T) {
+	for _, test := range testsCreateCustomerWallet {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := customerRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/customers/:customerId/wallets", handler.createCustomerWallet)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/customers/%s/wallets", test.id),
+				bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsCreateCustomerWallet = []struct {
+	name                 string
+	id                   string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		id:      testCustomerID,
+		reqBody: `{"currency":"EUR","type":"business"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "EUR", entity.WalletTypeBusiness, id).
+				Return(&entity.Wallet{
+					ID:        "5b53700ed469fa6a09ea72bb78f36fd9",
+					Balance:   100,
+					Available: 100,
+					Currency:  "EUR",
+					Status:    entity.WalletStatusActive,
+					Type:      entity.WalletTypeBusiness,
+					OwnerID:   id,
+				}, nil)
+		},
+		expectedStatusCode: 201,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100",` +
+			`"currency":"EUR","status":"active","type":"business","ownerId":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"}`,
+	},
+	{
+		name: "Customer not found",
+		id:   testCustomerID,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", id).
+				Return(nil, entity.ErrCustomerNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wrong currency",
+		id:      testCustomerID,
+		reqBody: `{"currency":"ABC"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "ABC", "", id).
+				Return(nil, entity.ErrWrongCurrency)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+}
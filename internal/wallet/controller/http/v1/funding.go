@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type fundingRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newFundingRoutes(handler *gin.RouterGroup, w usecase.Wallet, rl *ratelimit.Limiter, l *slog.Logger) {
+	r := &fundingRoutes{w, l}
+
+	h := handler.Group("/wallet")
+	{
+		h.POST("/:walletId/deposit", limitWallet(rl, l), requireSender(w, l), r.deposit)
+		h.POST("/:walletId/withdraw", limitWallet(rl, l), requireSender(w, l), r.withdraw)
+	}
+}
+
+// @Description Запрос пополнения или вывода средств.
+type fundingRequest struct {
+	Amount entity.Money `json:"amount" example:"10000" description:"Сумма в минимальных единицах валюты" validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Пополнение кошелька
+// @Description Зачисляет средства от платежного провайдера. Пополнение может остаться в обработке у провайдера.
+// @Description Пополнять кошелек может его владелец, либо тот, кому делегирована отправка с кошелька.
+// @Tags  	    Funding
+// @Param walletId path string true "ID кошелька"
+// @Param input body fundingRequest true "Запрос пополнения"
+// @Success     200 {object} entity.Transaction "Пополнение проведено"
+// @Success     202 {object} entity.Transaction "Пополнение в обработке у провайдера"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 {object} entity.Transaction "Провайдер отклонил пополнение"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Ошибка пополнения"
+// @Failure     502 "Платежный провайдер недоступен"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/deposit [post].
+func (r *fundingRoutes) deposit(c *gin.Context) {
+	var request fundingRequest
+
+	if err := c.BindJSON(&request); err != nil || !request.Amount.IsPositive() {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	transaction, err := r.w.Deposit(c.Request.Context(), c.Param("walletId"), request.Amount)
+	if err != nil {
+		r.abortWithError(c, "deposit", err)
+		return
+	}
+
+	c.JSON(fundingStatus(transaction), transaction)
+}
+
+// @Summary     Вывод средств из кошелька
+// @Description Списывает средства в пользу платежного провайдера. Вывод может остаться в обработке у провайдера.
+// @Description Если провайдер отклонил вывод, то средства возвращаются на кошелек.
+// @Tags  	    Funding
+// @Param walletId path string true "ID кошелька"
+// @Param input body fundingRequest true "Запрос вывода средств"
+// @Success     200 {object} entity.Transaction "Вывод проведен"
+// @Success     202 {object} entity.Transaction "Вывод в обработке у провайдера"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 {object} entity.Transaction "Провайдер отклонил вывод, недостаточно средств или превышен лимит расходов"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Ошибка вывода"
+// @Failure     502 "Платежный провайдер недоступен"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/withdraw [post].
+func (r *fundingRoutes) withdraw(c *gin.Context) {
+	var request fundingRequest
+
+	if err := c.BindJSON(&request); err != nil || !request.Amount.IsPositive() {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	transaction, err := r.w.Withdraw(c.Request.Context(), c.Param("walletId"), request.Amount)
+	if err != nil {
+		r.abortWithError(c, "withdraw", err)
+		return
+	}
+
+	c.JSON(fundingStatus(transaction), transaction)
+}
+
+// Getting the http status of the deposit or the withdrawal by its status.
+func fundingStatus(transaction *entity.Transaction) int {
+	switch transaction.Status {
+	case entity.TransactionStatusPending:
+		return http.StatusAccepted
+	case entity.TransactionStatusFailed:
+		return http.StatusUnprocessableEntity
+	}
+
+	return http.StatusOK
+}
+
+// Aborting the request with the http status of the funding operation error.
+func (r *fundingRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	// Responding with the remaining allowance of the exceeded limit
+	var limitErr *entity.LimitExceededError
+	if errors.As(err, &limitErr) {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, limitErr)
+		return
+	}
+
+	switch {
+	case errors.Is(err, entity.ErrWrongAmount),
+		errors.Is(err, entity.ErrEmptyWallet):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrWalletClosed):
+		c.AbortWithStatus(http.StatusGone)
+	case errors.Is(err, entity.ErrNotEnoughFunds),
+		errors.Is(err, entity.ErrLimitExceeded),
+		errors.Is(err, entity.ErrAmountOverflow):
+		c.AbortWithStatus(http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrWalletFrozen):
+		c.AbortWithStatus(http.StatusLocked)
+	case errors.Is(err, entity.ErrFundingUnavailable):
+		c.AbortWithStatus(http.StatusBadGateway)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
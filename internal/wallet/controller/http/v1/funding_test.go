@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_funding(t *testing.T) {
+	for _, test := range testsFunding {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := fundingRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:walletId/deposit", handler.deposit)
+			r.POST("/:walletId/withdraw", handler.withdraw)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/%s/%s", test.id, test.action),
+				bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+func fundingTransaction(transactionType, status, reference string) *entity.Transaction {
+	transaction := &entity.Transaction{
+		ID:                "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		Time:              time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC),
+		Type:              transactionType,
+		Amount:            500,
+		Currency:          "USD",
+		Status:            status,
+		Provider:          "fake",
+		ProviderReference: reference,
+	}
+
+	if transactionType == entity.TransactionTypeDeposit {
+		transaction.To = "5b53700ed469fa6a09ea72bb78f36fd9"
+	} else {
+		transaction.From = "5b53700ed469fa6a09ea72bb78f36fd9"
+	}
+
+	return transaction
+}
+
+var testsFunding = []struct {
+	name                 string
+	id                   string
+	action               string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok - deposit",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "deposit",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Deposit(context.Background(), id, entity.Money(500)).Return(
+				fundingTransaction(entity.TransactionTypeDeposit, entity.TransactionStatusCompleted, "fake-1"), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"deposit","from":"","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"500","currency":"USD",` +
+			`"status":"completed","provider":"fake","providerReference":"fake-1"}`,
+	},
+	{
+		name:    "Deposit is pending",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "deposit",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Deposit(context.Background(), id, entity.Money(500)).Return(
+				fundingTransaction(entity.TransactionTypeDeposit, entity.TransactionStatusPending, "fake-2"), nil)
+		},
+		expectedStatusCode: 202,
+		expectedResponseBody: `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"deposit","from":"","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"500","currency":"USD",` +
+			`"status":"pending","provider":"fake","providerReference":"fake-2"}`,
+	},
+	{
+		name:    "Withdrawal is failed",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "withdraw",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Withdraw(context.Background(), id, entity.Money(500)).Return(
+				fundingTransaction(entity.TransactionTypeWithdrawal, entity.TransactionStatusFailed, "fake-3"), nil)
+		},
+		expectedStatusCode: 422,
+		expectedResponseBody: `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"withdrawal","from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"","amount":"500","currency":"USD",` +
+			`"status":"failed","provider":"fake","providerReference":"fake-3"}`,
+	},
+	{
+		name:                 "Wrong amount",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:               "deposit",
+		reqBody:              `{"amount":"0"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet, id string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Not enough funds",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "withdraw",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Withdraw(context.Background(), id, entity.Money(500)).Return(nil, entity.ErrNotEnoughFunds)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Limit is exceeded",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "withdraw",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Withdraw(context.Background(), id, entity.Money(500)).Return(nil,
+				fmt.Errorf("WalletUseCase - Withdraw: %w", &entity.LimitExceededError{
+					Limit:     entity.LimitMonthly,
+					Remaining: 300,
+				}))
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: `{"limit":"monthly","remaining":"300"}`,
+	},
+	{
+		name:    "Wallet is frozen",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "deposit",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Deposit(context.Background(), id, entity.Money(500)).Return(nil, entity.ErrWalletFrozen)
+		},
+		expectedStatusCode:   423,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Funding provider is unavailable",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		action:  "deposit",
+		reqBody: `{"amount":"500"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().Deposit(context.Background(), id, entity.Money(500)).Return(nil, entity.ErrFundingUnavailable)
+		},
+		expectedStatusCode:   502,
+		expectedResponseBody: "",
+	},
+}
@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type holdRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newHoldRoutes(handler *gin.RouterGroup, w usecase.Wallet, rl *ratelimit.Limiter, l *slog.Logger) {
+	r := &holdRoutes{w, l}
+
+	handler.POST("/wallet/:walletId/holds", limitWallet(rl, l), requireSender(w, l), r.authorizeHold)
+
+	h := handler.Group("/holds")
+	{
+		h.GET("/:holdId", r.getHoldByID)
+		h.POST("/:holdId/capture", requireHoldSender(w, l), limitHoldWallet(rl, l), r.captureHold)
+		h.POST("/:holdId/void", requireHoldSender(w, l), limitHoldWallet(rl, l), r.voidHold)
+	}
+}
+
+// @Description Запрос резервирования средств.
+type authorizeHoldRequest struct {
+	Amount entity.Money `json:"amount" example:"3000" description:"Сумма резерва в минимальных единицах валюты"        validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	TTL    int64        `json:"ttl"    example:"3600" description:"Время жизни резерва в секундах, по умолчанию сутки" validate:"optional"`                      //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Резервирование средств кошелька
+// @Description Уменьшает доступный баланс кошелька без перевода средств.
+// @Description Резерв можно списать или отменить, по истечении времени жизни он отменяется автоматически.
+// @Tags  	    Hold
+// @Param walletId path string true "ID кошелька"
+// @Param input body authorizeHoldRequest true "Запрос резервирования средств"
+// @Success     200 {object} entity.Hold "Средства зарезервированы"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 "Недостаточно доступных средств"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось зарезервировать средства"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/holds [post].
+func (r *holdRoutes) authorizeHold(c *gin.Context) {
+	var request authorizeHoldRequest
+
+	if err := c.BindJSON(&request); err != nil || !request.Amount.IsPositive() || request.TTL < 0 {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	ttl := time.Duration(request.TTL) * time.Second
+
+	hold, err := r.w.AuthorizeHold(c.Request.Context(), c.Param("walletId"), request.Amount, ttl)
+	if err != nil {
+		r.abortWithError(c, "authorizeHold", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hold)
+}
+
+// @Description Запрос списания резерва.
+type captureHoldRequest struct {
+	To     string       `json:"to"     example:"eb376add88bf8e70f80787266a0801d5" description:"ID кошелька, куда нужно перевести деньги"       validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Amount entity.Money `json:"amount" example:"2000"                             description:"Сумма списания, по умолчанию вся сумма резерва" validate:"optional" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Списание резерва
+// @Description Переводит зарезервированные средства получателю. Остаток частично списанного резерва освобождается.
+// @Tags  	    Hold
+// @Param holdId path string true "ID резерва"
+// @Param input body captureHoldRequest true "Запрос списания резерва"
+// @Success     200 {object} entity.Hold "Резерв списан"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Резерв или кошелек получателя не найден"
+// @Failure     409 "Резерв уже списан, отменен или истек"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 {object} entity.LimitExceededError "Перевод невозможен: превышен лимит расходов или валюты кошельков различаются"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось списать резерв"
+// @Failure     503 "Не настроен кошелек комиссий валюты перевода"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /holds/{holdId}/capture [post].
+func (r *holdRoutes) captureHold(c *gin.Context) {
+	var request captureHoldRequest
+
+	if err := c.BindJSON(&request); err != nil || request.Amount < 0 {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	hold, err := r.w.CaptureHold(c.Request.Context(), c.Param("holdId"), request.To, request.Amount)
+	if err != nil {
+		r.abortWithError(c, "captureHold", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hold)
+}
+
+// @Summary     Отмена резерва
+// @Description Освобождает зарезервированные средства.
+// @Tags  	    Hold
+// @Param holdId path string true "ID резерва"
+// @Success     200 {object} entity.Hold "Резерв отменен"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Резерв не найден"
+// @Failure     409 "Резерв уже списан, отменен или истек"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось отменить резерв"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /holds/{holdId}/void [post].
+func (r *holdRoutes) voidHold(c *gin.Context) {
+	hold, err := r.w.VoidHold(c.Request.Context(), c.Param("holdId"))
+	if err != nil {
+		r.abortWithError(c, "voidHold", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hold)
+}
+
+// @Summary     Получение резерва
+// @Tags  	    Hold
+// @Param holdId path string true "ID резерва"
+// @Success     200 {object} entity.Hold "OK"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Резерв не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /holds/{holdId} [get].
+func (r *holdRoutes) getHoldByID(c *gin.Context) {
+	hold, err := r.w.GetHoldByID(c.Request.Context(), c.Param("holdId"))
+	if err != nil {
+		r.abortWithError(c, "getHoldByID", err)
+		return
+	}
+
+	if err = checkReader(c, r.w, hold.WalletID); err != nil {
+		abortWithAccessError(c, r.w, r.l, "getHoldByID", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, hold)
+}
+
+// Aborting the request with the http status of the hold operation error.
+func (r *holdRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	var limitErr *entity.LimitExceededError
+
+	switch {
+	case errors.As(err, &limitErr):
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, limitErr)
+	case errors.Is(err, entity.ErrSenderIsReceiver),
+		errors.Is(err, entity.ErrWrongAmount),
+		errors.Is(err, entity.ErrEmptyWallet),
+		errors.Is(err, entity.ErrWrongHoldTTL):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrHoldNotFound),
+		errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrHoldNotActive):
+		c.AbortWithStatus(http.StatusConflict)
+	case errors.Is(err, entity.ErrNotEnoughFunds),
+		errors.Is(err, entity.ErrCurrencyMismatch),
+		errors.Is(err, entity.ErrLimitExceeded),
+		errors.Is(err, entity.ErrAmountOverflow):
+		c.AbortWithStatus(http.StatusUnprocessableEntity)
+	case errors.Is(err, entity.ErrWalletClosed):
+		c.AbortWithStatus(http.StatusGone)
+	case errors.Is(err, entity.ErrWalletFrozen):
+		c.AbortWithStatus(http.StatusLocked)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	case errors.Is(err, entity.ErrFeeWalletNotConfigured):
+		r.l.Error("http - v1 - "+operation+" - fee wallet of the sender currency is not configured", sl.Err(err))
+		c.AbortWithStatus(http.StatusServiceUnavailable)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
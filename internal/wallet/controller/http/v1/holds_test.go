@@ -0,0 +1,408 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+const (
+	testHoldID     = "0f8fad5b-d9cb-469f-a165-70867728950e"
+	testHoldWallet = "5b53700ed469fa6a09ea72bb78f36fd9"
+	testHoldTo     = "eb376add88bf8e70f80787266a0801d5"
+)
+
+var testHoldTime = time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC)
+
+func testHold(status string) *entity.Hold {
+	return &entity.Hold{
+		ID:        testHoldID,
+		WalletID:  testHoldWallet,
+		Amount:    3000,
+		Currency:  "USD",
+		Status:    status,
+		ExpiresAt: testHoldTime.Add(time.Hour),
+		CreatedAt: testHoldTime,
+	}
+}
+
+func Test_authorizeHold(t *testing.T) {
+	for _, test := range testsAuthorizeHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := holdRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:walletId/holds", handler.authorizeHold)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/"+testHoldWallet+"/holds", bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsAuthorizeHold = []struct {
+	name                 string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		reqBody: `{"amount":"3000","ttl":3600}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Hour).
+				Return(testHold(entity.HoldStatusAuthorized), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"authorized",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name:    "Ok - default ttl",
+		reqBody: `{"amount":"3000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Duration(0)).
+				Return(testHold(entity.HoldStatusAuthorized), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"authorized",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name:                 "Without amount",
+		reqBody:              `{"ttl":3600}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Negative ttl",
+		reqBody:              `{"amount":"3000","ttl":-1}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wrong ttl",
+		reqBody: `{"amount":"3000","ttl":100000000}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), 100000000*time.Second).
+				Return(nil, entity.ErrWrongHoldTTL)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Not enough funds",
+		reqBody: `{"amount":"3000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Duration(0)).
+				Return(nil, entity.ErrNotEnoughFunds)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wallet not found",
+		reqBody: `{"amount":"3000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Duration(0)).
+				Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Timeout",
+		reqBody: `{"amount":"3000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Duration(0)).
+				Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Something went wrong",
+		reqBody: `{"amount":"3000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AuthorizeHold(context.Background(), testHoldWallet, entity.Money(3000), time.Duration(0)).
+				Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_captureHold(t *testing.T) {
+	for _, test := range testsCaptureHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := holdRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:holdId/capture", handler.captureHold)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/"+testHoldID+"/capture", bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsCaptureHold = []struct {
+	name                 string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok - partial",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"2000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			hold := testHold(entity.HoldStatusCaptured)
+			hold.CapturedTo = testHoldTo
+			hold.CapturedAmount = 2000
+
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(2000)).Return(hold, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"captured",` +
+			`"capturedTo":"eb376add88bf8e70f80787266a0801d5","capturedAmount":"2000",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name:    "Ok - whole hold",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			hold := testHold(entity.HoldStatusCaptured)
+			hold.CapturedTo = testHoldTo
+			hold.CapturedAmount = 3000
+
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(0)).Return(hold, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"captured",` +
+			`"capturedTo":"eb376add88bf8e70f80787266a0801d5","capturedAmount":"3000",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name:                 "Negative amount",
+		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"-1"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "More than hold",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"5000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(5000)).
+				Return(nil, entity.ErrWrongAmount)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Hold not found",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(0)).
+				Return(nil, entity.ErrHoldNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Hold is not active",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(0)).
+				Return(nil, entity.ErrHoldNotActive)
+		},
+		expectedStatusCode:   409,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Currency mismatch",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CaptureHold(context.Background(), testHoldID, testHoldTo, entity.Money(0)).
+				Return(nil, entity.ErrCurrencyMismatch)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_voidHold(t *testing.T) {
+	for _, test := range testsVoidHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := holdRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/:holdId/void", handler.voidHold)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/"+testHoldID+"/void", nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsVoidHold = []struct {
+	name                 string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().VoidHold(context.Background(), testHoldID).Return(testHold(entity.HoldStatusVoided), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"voided",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name: "Hold is not active",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().VoidHold(context.Background(), testHoldID).Return(nil, entity.ErrHoldNotActive)
+		},
+		expectedStatusCode:   409,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Something went wrong",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().VoidHold(context.Background(), testHoldID).Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_getHoldByID(t *testing.T) {
+	for _, test := range testsGetHoldByID {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := holdRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(&entity.Principal{Scopes: []string{entity.PermissionWalletRead}}))
+			r.GET("/:holdId", handler.getHoldByID)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/"+testHoldID, nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsGetHoldByID = []struct {
+	name                 string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetHoldByID(context.Background(), testHoldID).Return(testHold(entity.HoldStatusExpired), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"3000","currency":"USD","status":"expired",` +
+			`"expiresAt":"2024-02-04T18:25:35Z","createdAt":"2024-02-04T17:25:35Z"}`,
+	},
+	{
+		name: "Not Found",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetHoldByID(context.Background(), testHoldID).Return(nil, entity.ErrHoldNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Timeout",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetHoldByID(context.Background(), testHoldID).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+}
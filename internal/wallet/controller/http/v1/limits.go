@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type limitsRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newLimitsRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &limitsRoutes{w, l}
+
+	h := handler.Group("/wallet")
+	{
+		h.GET("/:walletId/limits", requireReader(w, l), r.getWalletLimits)
+		h.PUT("/:walletId/limits", requireSender(w, l), r.setWalletLimits)
+		h.DELETE("/:walletId/limits", requireSender(w, l), r.deleteWalletLimits)
+	}
+}
+
+// @Summary     Получение лимитов расходов кошелька
+// @Description Нулевой лимит не ограничивает расходы.
+// @Tags  	    Limits
+// @Param walletId path string true "ID кошелька"
+// @Success     200 {object} entity.SpendingLimits "Лимиты расходов кошелька"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/limits [get].
+func (r *limitsRoutes) getWalletLimits(c *gin.Context) {
+	limits, err := r.w.GetWalletLimits(c.Request.Context(), c.Param("walletId"))
+	if err != nil {
+		r.abortWithError(c, "getWalletLimits", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, limits)
+}
+
+// @Description Запрос установки лимитов расходов.
+type setLimitsRequest struct {
+	PerTransaction entity.Money `json:"perTransaction" example:"5000"   description:"Максимальная сумма одного перевода"                validate:"optional" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Daily          entity.Money `json:"daily"          example:"20000"  description:"Максимальная сумма переводов за последние сутки"   validate:"optional" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+	Monthly        entity.Money `json:"monthly"        example:"100000" description:"Максимальная сумма переводов за последние 30 дней" validate:"optional" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Установка лимитов расходов кошелька
+// @Description Заменяет все лимиты кошелька. Лимиты ограничивают переводы и выводы по скользящим окнам: сутки и 30 дней.
+// @Description Нулевой лимит не ограничивает расходы. Менять лимиты может только тот, кто может отправлять с кошелька.
+// @Tags  	    Limits
+// @Param walletId path string true "ID кошелька"
+// @Param input body setLimitsRequest true "Запрос установки лимитов расходов"
+// @Success     200 {object} entity.SpendingLimits "Лимиты установлены"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/limits [put].
+func (r *limitsRoutes) setWalletLimits(c *gin.Context) {
+	var request setLimitsRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	limits, err := r.w.SetWalletLimits(c.Request.Context(), &entity.SpendingLimits{
+		WalletID:       c.Param("walletId"),
+		PerTransaction: request.PerTransaction,
+		Daily:          request.Daily,
+		Monthly:        request.Monthly,
+	})
+	if err != nil {
+		r.abortWithError(c, "setWalletLimits", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, limits)
+}
+
+// @Summary     Удаление лимитов расходов кошелька
+// @Tags  	    Limits
+// @Param walletId path string true "ID кошелька"
+// @Success     204 "Лимиты удалены"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/limits [delete].
+func (r *limitsRoutes) deleteWalletLimits(c *gin.Context) {
+	if err := r.w.DeleteWalletLimits(c.Request.Context(), c.Param("walletId")); err != nil {
+		r.abortWithError(c, "deleteWalletLimits", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Aborting the request with the http status of the limits operation error.
+func (r *limitsRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWrongLimit),
+		errors.Is(err, entity.ErrEmptyWallet):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
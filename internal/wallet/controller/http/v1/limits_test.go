@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_setWalletLimits(t *testing.T) {
+	for _, test := range testsSetWalletLimits {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := limitsRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.PUT("/:walletId/limits", handler.setWalletLimits)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/%s/limits", test.id), bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsSetWalletLimits = []struct {
+	name                 string
+	id                   string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"perTransaction":"5000","daily":"20000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			limits := &entity.SpendingLimits{
+				WalletID:       id,
+				PerTransaction: 5000,
+				Daily:          20000,
+			}
+			r.EXPECT().SetWalletLimits(context.Background(), limits).Return(limits, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9","perTransaction":"5000",` +
+			`"daily":"20000","monthly":"0"}`,
+	},
+	{
+		name:                 "Wrong request body",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `{"daily":"twenty"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet, id string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Negative limit",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"monthly":"-1"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().SetWalletLimits(context.Background(), &entity.SpendingLimits{
+				WalletID: id,
+				Monthly:  -1,
+			}).Return(nil, entity.ErrWrongLimit)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Wallet not found",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"daily":"20000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().SetWalletLimits(context.Background(), &entity.SpendingLimits{
+				WalletID: id,
+				Daily:    20000,
+			}).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Timeout",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"daily":"20000"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().SetWalletLimits(context.Background(), &entity.SpendingLimits{
+				WalletID: id,
+				Daily:    20000,
+			}).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_deleteWalletLimits(t *testing.T) {
+	for _, test := range testsDeleteWalletLimits {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := limitsRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.DELETE("/:walletId/limits", handler.deleteWalletLimits)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/%s/limits", test.id), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+		})
+	}
+}
+
+var testsDeleteWalletLimits = []struct {
+	name               string
+	id                 string
+	mockBehavior       func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode int
+}{
+	{
+		name: "Ok",
+		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().DeleteWalletLimits(context.Background(), id).Return(nil)
+		},
+		expectedStatusCode: 204,
+	},
+	{
+		name: "Wallet not found",
+		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().DeleteWalletLimits(context.Background(), id).Return(entity.ErrWalletNotFound)
+		},
+		expectedStatusCode: 404,
+	},
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Limiting the requests from the IP address. It is applied before the authentication,
+// so the requests with the wrong credentials are limited too.
+func limitIP(rl *ratelimit.Limiter, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !allowRate(c, rl, l, ratelimit.KindIP, c.ClientIP()) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Limiting the requests of the authenticated caller. The API keys without the subject are limited by the key.
+func limitPrincipal(rl *ratelimit.Limiter, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !allowRate(c, rl, l, ratelimit.KindPrincipal, principalFrom(c).RateLimitKey()) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Limiting the transfers from the wallet of the path. It is applied before the access checks,
+// so the requests over the limit do not reach the worker.
+func limitWallet(rl *ratelimit.Limiter, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !allowRate(c, rl, l, ratelimit.KindWallet, c.Param("walletId")) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Limiting the transfers from the wallet of the hold, which is found by requireHoldSender.
+// The wallet is known only after the hold is loaded, so the request is limited by the principal before.
+func limitHoldWallet(rl *ratelimit.Limiter, l *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !allowRate(c, rl, l, ratelimit.KindWallet, c.GetString(_holdWalletKey)) {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Taking the token of the key and aborting the request with 429, if the key is over the limit.
+// The request is allowed, if the buckets are unavailable, because the limits must not stop the API.
+func allowRate(c *gin.Context, rl *ratelimit.Limiter, l *slog.Logger, kind ratelimit.Kind, key string) bool {
+	wait, err := rl.Allow(c.Request.Context(), kind, key)
+	if err != nil {
+		l.Error("http - v1 - allowRate", sl.Err(err))
+		return true
+	}
+
+	if wait > 0 {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		c.AbortWithStatus(http.StatusTooManyRequests)
+
+		return false
+	}
+
+	return true
+}
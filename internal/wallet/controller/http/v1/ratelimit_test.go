@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_limitPrincipal(t *testing.T) {
+	rl := ratelimit.New(
+		ratelimit.NewMemoryStore(),
+		ratelimit.Principal(ratelimit.Limit{Rate: 0.5, Burst: 2}),
+	)
+	// Init Endpoint
+	r := gin.New()
+	r.GET("/", func(c *gin.Context) {
+		c.Set(_principalKey, &entity.Principal{Subject: c.GetHeader("X-Subject"), Credential: c.GetHeader("X-Credential")})
+	}, limitPrincipal(rl, logger.SetupLogger("debug")), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// The requests are made one by one, because they share the buckets
+	for _, test := range testsLimitPrincipal {
+		// Create Request
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Subject", test.subject)
+		req.Header.Set("X-Credential", test.credential)
+		// Make Request
+		r.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, w.Code, test.expectedStatusCode, test.name)
+		assert.Equal(t, w.Header().Get("Retry-After"), test.expectedRetryAfter, test.name)
+	}
+}
+
+var testsLimitPrincipal = []struct {
+	name               string
+	subject            string
+	credential         string
+	expectedStatusCode int
+	expectedRetryAfter string
+}{
+	{
+		name:               "First request",
+		subject:            "alice",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Burst",
+		subject:            "alice",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Over the limit",
+		subject:            "alice",
+		expectedStatusCode: 429,
+		expectedRetryAfter: "2",
+	},
+	{
+		name:               "Other principal",
+		subject:            "bob",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "API key without subject",
+		credential:         "apikey:5b53700ed469fa6a",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "API key without subject - burst",
+		credential:         "apikey:5b53700ed469fa6a",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "API key without subject - over the limit",
+		credential:         "apikey:5b53700ed469fa6a",
+		expectedStatusCode: 429,
+		expectedRetryAfter: "2",
+	},
+	{
+		name:               "Anonymous principal is not limited",
+		subject:            "",
+		expectedStatusCode: 200,
+	},
+}
+
+func Test_limitHoldWallet(t *testing.T) {
+	rl := ratelimit.New(
+		ratelimit.NewMemoryStore(),
+		ratelimit.Wallet(ratelimit.Limit{Rate: 0.5, Burst: 1}),
+	)
+	// Init Endpoint
+	r := gin.New()
+	r.POST("/:holdId/capture", func(c *gin.Context) {
+		c.Set(_holdWalletKey, c.GetHeader("X-Wallet"))
+	}, limitHoldWallet(rl, logger.SetupLogger("debug")), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// The requests are made one by one, because they share the buckets
+	for _, test := range testsLimitHoldWallet {
+		// Create Request
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/"+test.holdID+"/capture", nil)
+		req.Header.Set("X-Wallet", test.walletID)
+		// Make Request
+		r.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, w.Code, test.expectedStatusCode, test.name)
+	}
+}
+
+var testsLimitHoldWallet = []struct {
+	name               string
+	holdID             string
+	walletID           string
+	expectedStatusCode int
+}{
+	{
+		name:               "First capture",
+		holdID:             "0f8fad5b-d9cb-469f-a165-70867728950e",
+		walletID:           "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode: 200,
+	},
+	{
+		name:               "Other hold of the same wallet",
+		holdID:             "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		walletID:           "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode: 429,
+	},
+	{
+		name:               "Hold of other wallet",
+		holdID:             "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19",
+		walletID:           "eb376add88bf8e70f80787266a0801d5",
+		expectedStatusCode: 200,
+	},
+}
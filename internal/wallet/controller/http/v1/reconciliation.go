@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type reconciliationRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newReconciliationRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &reconciliationRoutes{w, l}
+
+	h := handler.Group("/admin/reconciliation")
+	{
+		h.POST("", requirePermission(w, l, entity.PermissionLedgerReconcile), r.startReconciliation)
+		h.GET("", requirePermission(w, l, entity.PermissionAuditRead), r.getReconciliationReport)
+	}
+}
+
+// @Summary     Запуск сверки балансов
+// @Description Пересчитывает балансы всех кошельков по проводкам и по переводам и сравнивает их с текущими.
+// @Description Сверка выполняется в фоне, ее результат доступен в отчете последней сверки.
+// @Tags  	    Admin
+// @Success     202 "Сверка запущена"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     409 "Сверка уже выполняется"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/reconciliation [post].
+func (r *reconciliationRoutes) startReconciliation(c *gin.Context) {
+	if err := r.w.StartReconciliation(c.Request.Context()); err != nil {
+		r.abortWithError(c, "startReconciliation", err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// @Summary     Отчет последней сверки балансов
+// @Description Возвращает кошельки, баланс которых отличается от суммы проводок или от суммы переводов.
+// @Tags  	    Admin
+// @Success     200 {object} entity.ReconciliationReport "Отчет сверки"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Сверка еще не выполнялась"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/reconciliation [get].
+func (r *reconciliationRoutes) getReconciliationReport(c *gin.Context) {
+	report, err := r.w.GetReconciliationReport(c.Request.Context())
+	if err != nil {
+		r.abortWithError(c, "getReconciliationReport", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Aborting the request with the http status of the reconciliation error.
+func (r *reconciliationRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrReconciliationNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrReconciliationRunning):
+		c.AbortWithStatus(http.StatusConflict)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_reconciliation(t *testing.T) {
+	for _, test := range testsReconciliation {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+
+			l := logger.SetupLogger("debug")
+			handler := reconciliationRoutes{
+				w: repo,
+				l: l,
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.POST("/admin/reconciliation", requirePermission(repo, l, entity.PermissionLedgerReconcile), handler.startReconciliation)
+			r.GET("/admin/reconciliation", requirePermission(repo, l, entity.PermissionAuditRead), handler.getReconciliationReport)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, "/admin/reconciliation", nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsReconciliation = []struct {
+	name                 string
+	method               string
+	principal            *entity.Principal
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:      "Ok - start",
+		method:    http.MethodPost,
+		principal: &entity.Principal{Subject: "operations", Permissions: []string{entity.PermissionLedgerReconcile}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().StartReconciliation(context.Background()).Return(nil)
+		},
+		expectedStatusCode:   202,
+		expectedResponseBody: ``,
+	},
+	{
+		name:      "Already running",
+		method:    http.MethodPost,
+		principal: &entity.Principal{Subject: "operations", Permissions: []string{entity.PermissionLedgerReconcile}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().StartReconciliation(context.Background()).Return(entity.ErrReconciliationRunning)
+		},
+		expectedStatusCode:   409,
+		expectedResponseBody: ``,
+	},
+	{
+		name:      "Start without the permission",
+		method:    http.MethodPost,
+		principal: &entity.Principal{Subject: "auditor", Permissions: []string{entity.PermissionAuditRead}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode:   403,
+		expectedResponseBody: ``,
+	},
+	{
+		name:      "Ok - report",
+		method:    http.MethodGet,
+		principal: &entity.Principal{Subject: "auditor", Permissions: []string{entity.PermissionAuditRead}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetReconciliationReport(context.Background()).Return(&entity.ReconciliationReport{
+				ID:             7,
+				StartedAt:      time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC),
+				FinishedAt:     time.Date(2024, 2, 4, 17, 25, 37, 0, time.UTC),
+				WalletsChecked: 1000,
+				DriftedWallets: 1,
+				Drifts: []entity.WalletDrift{{
+					WalletID:            "5b53700ed469fa6a09ea72bb78f36fd9",
+					Currency:            "USD",
+					Balance:             10000,
+					LedgerBalance:       10000,
+					TransactionsBalance: 9500,
+					TransactionsDrift:   500,
+				}},
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":7,"startedAt":"2024-02-04T17:25:35Z","finishedAt":"2024-02-04T17:25:37Z",` +
+			`"walletsChecked":1000,"driftedWallets":1,"drifts":[{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"currency":"USD","balance":"10000","ledgerBalance":"10000","transactionsBalance":"9500",` +
+			`"ledgerDrift":"0","transactionsDrift":"500"}]}`,
+	},
+	{
+		name:      "Report not found",
+		method:    http.MethodGet,
+		principal: &entity.Principal{Subject: "auditor", Permissions: []string{entity.PermissionAuditRead}},
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetReconciliationReport(context.Background()).Return(nil, entity.ErrReconciliationNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: ``,
+	},
+}
@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type roleRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newRoleRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &roleRoutes{w, l}
+
+	h := handler.Group("/admin", requirePermission(w, l, entity.PermissionRolesManage))
+	{
+		h.GET("/permissions", r.getPermissions)
+		h.GET("/roles", r.getRoles)
+		h.PUT("/roles/:role", r.setRole)
+		h.DELETE("/roles/:role", r.deleteRole)
+		h.GET("/principals/:subject/roles", r.getSubjectRoles)
+		h.PUT("/principals/:subject/roles/:role", r.assignRole)
+		h.DELETE("/principals/:subject/roles/:role", r.revokeRole)
+	}
+}
+
+// @Summary     Получение всех разрешений
+// @Tags  	    Roles
+// @Success     200 {array} string "Разрешения"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/permissions [get].
+func (r *roleRoutes) getPermissions(c *gin.Context) {
+	c.JSON(http.StatusOK, entity.Permissions())
+}
+
+// @Summary     Получение всех ролей
+// @Tags  	    Roles
+// @Success     200 {array} entity.Role "Роли"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/roles [get].
+func (r *roleRoutes) getRoles(c *gin.Context) {
+	roles, err := r.w.GetRoles(c.Request.Context())
+	if err != nil {
+		r.abortWithError(c, "getRoles", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// @Description Запрос установки разрешений роли.
+type setRoleRequest struct {
+	Permissions []string `json:"permissions" example:"wallet:read,wallet:freeze" description:"Разрешения роли" validate:"required"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Создание или изменение роли
+// @Description Заменяет все разрешения роли. Изменения действуют для всех субъектов, которым назначена роль.
+// @Tags  	    Roles
+// @Param role path string true "Название роли"
+// @Param input body setRoleRequest true "Запрос установки разрешений роли"
+// @Success     200 {object} entity.Role "Роль сохранена"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/roles/{role} [put].
+func (r *roleRoutes) setRole(c *gin.Context) {
+	var request setRoleRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	role, err := r.w.SetRole(c.Request.Context(), &entity.Role{
+		Name:        c.Param("role"),
+		Permissions: request.Permissions,
+	})
+	if err != nil {
+		r.abortWithError(c, "setRole", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, role)
+}
+
+// @Summary     Удаление роли
+// @Description Роль отзывается у всех субъектов, которым она назначена.
+// @Tags  	    Roles
+// @Param role path string true "Название роли"
+// @Success     204 "Роль удалена"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Роль не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/roles/{role} [delete].
+func (r *roleRoutes) deleteRole(c *gin.Context) {
+	if err := r.w.DeleteRole(c.Request.Context(), c.Param("role")); err != nil {
+		r.abortWithError(c, "deleteRole", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary     Получение ролей субъекта
+// @Tags  	    Roles
+// @Param subject path string true "Субъект API ключа или токена"
+// @Success     200 {array} entity.Role "Роли субъекта"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/principals/{subject}/roles [get].
+func (r *roleRoutes) getSubjectRoles(c *gin.Context) {
+	roles, err := r.w.GetSubjectRoles(c.Request.Context(), c.Param("subject"))
+	if err != nil {
+		r.abortWithError(c, "getSubjectRoles", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// @Summary     Назначение роли субъекту
+// @Description Повторное назначение роли ничего не меняет.
+// @Tags  	    Roles
+// @Param subject path string true "Субъект API ключа или токена"
+// @Param role path string true "Название роли"
+// @Success     200 {array} entity.Role "Роли субъекта"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Роль не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/principals/{subject}/roles/{role} [put].
+func (r *roleRoutes) assignRole(c *gin.Context) {
+	roles, err := r.w.AssignRole(c.Request.Context(), c.Param("subject"), c.Param("role"))
+	if err != nil {
+		r.abortWithError(c, "assignRole", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, roles)
+}
+
+// @Summary     Отзыв роли у субъекта
+// @Tags  	    Roles
+// @Param subject path string true "Субъект API ключа или токена"
+// @Param role path string true "Название роли"
+// @Success     204 "Роль отозвана"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Роль не назначена субъекту"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /admin/principals/{subject}/roles/{role} [delete].
+func (r *roleRoutes) revokeRole(c *gin.Context) {
+	if err := r.w.RevokeRole(c.Request.Context(), c.Param("subject"), c.Param("role")); err != nil {
+		r.abortWithError(c, "revokeRole", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Aborting the request with the http status of the role operation error.
+func (r *roleRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWrongRole),
+		errors.Is(err, entity.ErrWrongSubject):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrRoleNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_setRole(t *testing.T) {
+	for _, test := range testsSetRole {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := roleRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.PUT("/roles/:role", handler.setRole)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/roles/"+test.role, bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsSetRole = []struct {
+	name                 string
+	role                 string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		role:    "support",
+		reqBody: `{"permissions":["wallet:read","wallet:freeze"]}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			role := &entity.Role{
+				Name:        "support",
+				Permissions: []string{entity.PermissionWalletRead, entity.PermissionWalletFreeze},
+			}
+			r.EXPECT().SetRole(context.Background(), role).Return(role, nil)
+		},
+		expectedStatusCode:   200,
+		expectedResponseBody: `{"name":"support","permissions":["wallet:read","wallet:freeze"]}`,
+	},
+	{
+		name:    "Unknown permission",
+		role:    "support",
+		reqBody: `{"permissions":["wallet:delete"]}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().SetRole(context.Background(), &entity.Role{
+				Name:        "support",
+				Permissions: []string{"wallet:delete"},
+			}).Return(nil, entity.ErrWrongRole)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong input - not json",
+		role:                 "support",
+		reqBody:              `helloworld`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+}
+
+func Test_assignRole(t *testing.T) {
+	for _, test := range testsAssignRole {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := roleRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.PUT("/principals/:subject/roles/:role", handler.assignRole)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/principals/%s/roles/%s", test.subject, test.role), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsAssignRole = []struct {
+	name                 string
+	subject              string
+	role                 string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok",
+		subject: "agent-42",
+		role:    "auditor",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AssignRole(context.Background(), "agent-42", "auditor").Return([]entity.Role{{
+				Name:        "auditor",
+				Permissions: []string{entity.PermissionWalletRead, entity.PermissionAuditRead},
+			}}, nil)
+		},
+		expectedStatusCode:   200,
+		expectedResponseBody: `[{"name":"auditor","permissions":["wallet:read","audit:read"]}]`,
+	},
+	{
+		name:    "Role not found",
+		subject: "agent-42",
+		role:    "superuser",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AssignRole(context.Background(), "agent-42", "superuser").Return(nil, entity.ErrRoleNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Timeout",
+		subject: "agent-42",
+		role:    "auditor",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().AssignRole(context.Background(), "agent-42", "auditor").Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+}
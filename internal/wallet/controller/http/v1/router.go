@@ -5,6 +5,9 @@ import (
 	"net/http"
 
 	_ "github.com/egor-denisov/wallet-rielta/docs" //nolint:blank-imports // for correct work swagger documentation
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/auth"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/stream"
 	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -17,10 +20,27 @@ import (
 // @version     1.0
 // @host        localhost:8080
 // @BasePath    /api/v1
+//
+// @securityDefinitions.apikey ApiKeyAuth
+// @in                         header
+// @name                       X-API-Key
+//
+// @securityDefinitions.apikey BearerAuth
+// @in                         header
+// @name                       Authorization
+// @description                JWT в формате "Bearer <token>", subject токена - ID клиента
 // .
-func NewRouter(handler *gin.Engine, l *slog.Logger, w usecase.Wallet) {
+func NewRouter(
+	handler *gin.Engine,
+	l *slog.Logger,
+	w usecase.Wallet,
+	a *auth.Authenticator,
+	rl *ratelimit.Limiter,
+	hub *stream.Hub,
+) {
 	handler.Use(gin.Logger())
 	handler.Use(gin.Recovery())
+	handler.Use(requestID())
 
 	// Swagger
 	swaggerHandler := ginSwagger.DisablingWrapHandler(swaggerFiles.Handler, "DISABLE_SWAGGER_HTTP_HANDLER")
@@ -33,8 +53,22 @@ func NewRouter(handler *gin.Engine, l *slog.Logger, w usecase.Wallet) {
 	handler.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	// Routers
-	h := handler.Group("/api/v1")
+	h := handler.Group("/api/v1", limitIP(rl, l), authenticate(a, l), limitPrincipal(rl, l), audit(w, l))
 	{
-		newWalletRoutes(h, w, l)
+		newWalletRoutes(h, w, rl, l)
+		newCustomerRoutes(h, w, l)
+		newHoldRoutes(h, w, rl, l)
+		newLimitsRoutes(h, w, l)
+		newBalanceRoutes(h, w, l)
+		newStatementRoutes(h, w, l)
+		newTransferRoutes(h, w, rl, l)
+		newTransactionRoutes(h, w, l)
+		newFundingRoutes(h, w, rl, l)
+		newAdminRoutes(h, w, l)
+		newRoleRoutes(h, w, l)
+		newWebhookRoutes(h, w, l)
+		newReconciliationRoutes(h, w, l)
+		newAuditRoutes(h, w, l)
+		newStreamRoutes(h, w, hub, l)
 	}
 }
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/statement"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const _statementFormatJSON = "json"
+
+type statementRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newStatementRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &statementRoutes{w, l}
+
+	h := handler.Group("/wallet")
+	{
+		h.GET("/:walletId/statement", requireReader(w, l), r.getWalletStatement)
+	}
+}
+
+// @Summary     Получение выписки по кошельку за период
+// @Description Выписка содержит баланс на начало периода, операции периода и баланс на конец периода.
+// @Description Выписку можно скачать в форматах CSV, OFX и ISO 20022 CAMT.053.
+// @Tags  	    Wallet
+// @Param walletId path string true "ID кошелька"
+// @Param from query string true "Начало периода включительно, RFC3339"
+// @Param to query string true "Конец периода не включительно, RFC3339"
+// @Param format query string false "Формат выписки, по умолчанию json" Enums(json, csv, ofx, camt053)
+// @Produce     json,text/csv,application/x-ofx,application/xml
+// @Success     200 {object} entity.Statement "Выписка по кошельку"
+// @Failure     400 "Неверный период или формат выписки, либо слишком много операций за период"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/statement [get].
+func (r *statementRoutes) getWalletStatement(c *gin.Context) {
+	name := c.DefaultQuery("format", _statementFormatJSON)
+
+	format, ok := statement.Lookup(name)
+	if !ok && name != _statementFormatJSON {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	from, errFrom := time.Parse(time.RFC3339, c.Query("from"))
+	to, errTo := time.Parse(time.RFC3339, c.Query("to"))
+
+	if errFrom != nil || errTo != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	walletStatement, err := r.w.GetWalletStatement(c.Request.Context(), c.Param("walletId"), from, to)
+	if err != nil {
+		r.abortWithError(c, "getWalletStatement", err)
+		return
+	}
+
+	if name == _statementFormatJSON {
+		c.JSON(http.StatusOK, walletStatement)
+		return
+	}
+
+	var file bytes.Buffer
+
+	if err = format.Write(&file, walletStatement); err != nil {
+		r.abortWithError(c, "getWalletStatement", err)
+		return
+	}
+
+	filename := fmt.Sprintf("statement-%s-%s-%s.%s",
+		walletStatement.WalletID, from.UTC().Format("20060102"), to.UTC().Format("20060102"), format.Extension)
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, format.ContentType, file.Bytes())
+}
+
+// Aborting the request with the http status of the statement error.
+func (r *statementRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWrongStatementPeriod),
+		errors.Is(err, entity.ErrStatementTooLarge):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_getWalletStatement(t *testing.T) {
+	for _, test := range testsGetWalletStatement {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := statementRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.GET("/:walletId/statement", handler.getWalletStatement)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/statement%s", test.id, test.query), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Header().Get("Content-Type"), test.expectedContentType)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var (
+	startOfMarch = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	startOfApril = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testStatement(id string) *entity.Statement {
+	return &entity.Statement{
+		WalletID:       id,
+		Currency:       "USD",
+		From:           startOfMarch,
+		To:             startOfApril,
+		GeneratedAt:    startOfApril,
+		OpeningBalance: 10000,
+		ClosingBalance: 7000,
+		Entries: []entity.StatementEntry{{
+			TransactionID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			Time:          startOfMarch.Add(time.Hour),
+			Type:          entity.TransactionTypeTransfer,
+			Status:        entity.TransactionStatusCompleted,
+			Direction:     entity.EntryDirectionDebit,
+			Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+			Amount:        3000,
+			Balance:       7000,
+		}},
+	}
+}
+
+var testsGetWalletStatement = []struct {
+	name                 string
+	id                   string
+	query                string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedContentType  string
+	expectedResponseBody string
+}{
+	{
+		name:  "Ok",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfMarch, startOfApril).
+				Return(testStatement(id), nil)
+		},
+		expectedStatusCode:  200,
+		expectedContentType: "application/json; charset=utf-8",
+		expectedResponseBody: `{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9","currency":"USD",` +
+			`"from":"2024-03-01T00:00:00Z","to":"2024-04-01T00:00:00Z","generatedAt":"2024-04-01T00:00:00Z",` +
+			`"openingBalance":"10000","closingBalance":"7000","entries":[{"transactionId":` +
+			`"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-03-01T01:00:00Z","type":"transfer",` +
+			`"status":"completed","direction":"debit","counterparty":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"3000","balance":"7000"}]}`,
+	},
+	{
+		name:  "Ok in csv",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z&format=csv",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfMarch, startOfApril).
+				Return(testStatement(id), nil)
+		},
+		expectedStatusCode:  200,
+		expectedContentType: "text/csv; charset=utf-8",
+		expectedResponseBody: "time,transaction_id,type,status,counterparty,description,reference,amount,balance\n" +
+			"2024-03-01T00:00:00Z,,opening,,,,,,100.00\n" +
+			"2024-03-01T01:00:00Z,7c9e6679-7425-40de-944b-e07fc1f90ae7,transfer,completed," +
+			"eb376add88bf8e70f80787266a0801d5,,,-30.00,70.00\n" +
+			"2024-04-01T00:00:00Z,,closing,,,,,,70.00\n",
+	},
+	{
+		name:                 "Unknown format",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z&format=pdf",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong period",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "?from=march&to=2024-04-01T00:00:00Z",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Empty period",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-04-01T00:00:00Z&to=2024-03-01T00:00:00Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfApril, startOfMarch).
+				Return(nil, entity.ErrWrongStatementPeriod)
+		},
+		expectedStatusCode:   400,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Not found",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfMarch, startOfApril).
+				Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Too many entries",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfMarch, startOfApril).
+				Return(nil, entity.ErrStatementTooLarge)
+		},
+		expectedStatusCode:   400,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Something went wrong",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?from=2024-03-01T00:00:00Z&to=2024-04-01T00:00:00Z",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletStatement(context.Background(), id, startOfMarch, startOfApril).
+				Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedContentType:  "",
+		expectedResponseBody: "",
+	},
+}
@@ -0,0 +1,212 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/stream"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// Interval of the comments, which keep the idle stream open through the proxies.
+	_streamKeepAlive = 15 * time.Second
+
+	_transportWebSocket = "websocket"
+)
+
+type streamRoutes struct {
+	w   usecase.Wallet
+	hub *stream.Hub
+	l   *slog.Logger
+}
+
+func newStreamRoutes(handler *gin.RouterGroup, w usecase.Wallet, hub *stream.Hub, l *slog.Logger) {
+	r := &streamRoutes{w, hub, l}
+
+	h := handler.Group("/wallet")
+	{
+		h.GET("/:walletId/stream", requireReader(w, l), r.streamWallet)
+	}
+}
+
+// @Summary     Поток изменений кошелька
+// @Description Первым событием wallet отправляется текущее состояние кошелька, затем события credited и debited
+// @Description при изменении баланса (entity.BalanceChange) и transaction при создании или изменении перевода (entity.Transaction).
+// @Description По умолчанию используется Server-Sent Events, с параметром transport=websocket - WebSocket.
+// @Description Если клиент не успевает читать события, поток закрывается, и клиенту нужно переподключиться.
+// @Tags  	    Wallet
+// @Produce     text/event-stream
+// @Param walletId path string true "ID кошелька"
+// @Param transport query string false "Транспорт потока" Enums(sse, websocket)
+// @Success     200 {object} entity.WalletEvent "Поток событий"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/{walletId}/stream [get].
+func (r *streamRoutes) streamWallet(c *gin.Context) {
+	walletID := c.Param("walletId")
+	// Subscribing before getting the wallet, so the changes between them are not lost
+	subscription := r.hub.Subscribe(walletID)
+	defer r.hub.Unsubscribe(subscription)
+
+	wallet, err := r.w.GetWalletByID(c.Request.Context(), walletID)
+	if err != nil {
+		r.abortWithError(c, "streamWallet", err)
+		return
+	}
+
+	data, err := json.Marshal(wallet)
+	if err != nil {
+		r.l.Error("http - v1 - streamWallet - json.Marshal", sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	snapshot := entity.WalletEvent{
+		Type:     entity.WalletEventSnapshot,
+		WalletID: walletID,
+		Data:     data,
+	}
+	// The stream lives longer than the write timeout of the server
+	if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+		r.l.Debug("http - v1 - streamWallet - SetWriteDeadline", sl.Err(err))
+	}
+
+	if c.Query("transport") == _transportWebSocket || strings.EqualFold(c.GetHeader("Upgrade"), _transportWebSocket) {
+		r.serveWebSocket(c, subscription, snapshot)
+		return
+	}
+
+	r.serveSSE(c, subscription, snapshot)
+}
+
+// Sending the events as Server-Sent Events until the client is disconnected.
+func (r *streamRoutes) serveSSE(c *gin.Context, subscription *stream.Subscription, snapshot entity.WalletEvent) {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	if err := writeSSE(c.Writer, snapshot); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(_streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			if err := writeSSE(c.Writer, event); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := io.WriteString(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+			c.Writer.Flush()
+		}
+	}
+}
+
+// Writing the event in the format of Server-Sent Events.
+func writeSSE(w gin.ResponseWriter, event entity.WalletEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if event.ID != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", event.ID); err != nil {
+			return fmt.Errorf("fmt.Fprintf: %w", err)
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+		return fmt.Errorf("fmt.Fprintf: %w", err)
+	}
+
+	w.Flush()
+
+	return nil
+}
+
+// Sending the events as the json messages of WebSocket until the client is disconnected.
+func (r *streamRoutes) serveWebSocket(c *gin.Context, subscription *stream.Subscription, snapshot entity.WalletEvent) {
+	server := websocket.Server{
+		// The origin is not checked, because the client is authenticated by the headers, not by the cookies
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(c.Request.Context())
+			defer cancel()
+
+			if err := ws.SetDeadline(time.Time{}); err != nil {
+				return
+			}
+			// The messages of the client are not expected, the reading detects the disconnection
+			go func() {
+				defer cancel()
+
+				_, _ = io.Copy(io.Discard, ws)
+			}()
+
+			if err := websocket.JSON.Send(ws, snapshot); err != nil {
+				return
+			}
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event, ok := <-subscription.Events():
+					if !ok {
+						return
+					}
+
+					if err := websocket.JSON.Send(ws, event); err != nil {
+						return
+					}
+				}
+			}
+		},
+	}
+
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+func (r *streamRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+	"golang.org/x/net/websocket"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/stream"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+const _streamWalletID = "5b53700ed469fa6a09ea72bb78f36fd9"
+
+// Starting the server with the stream of the wallet for the reader of all wallets.
+func newStreamServer(t *testing.T, hub *stream.Hub) *httptest.Server {
+	t.Helper()
+
+	c := gomock.NewController(t)
+
+	repo := mock_usecase.NewMockWallet(c)
+	repo.EXPECT().GetWalletByID(gomock.Any(), _streamWalletID).Return(&entity.Wallet{
+		ID:       _streamWalletID,
+		Balance:  10000,
+		Currency: "USD",
+	}, nil)
+
+	r := gin.New()
+	r.Use(withPrincipal(&entity.Principal{Subject: "support-1", Scopes: []string{entity.PermissionWalletRead}}))
+	newStreamRoutes(r.Group(""), repo, hub, logger.SetupLogger("debug"))
+
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// Waiting until the stream is subscribed to the events.
+func waitSubscribers(t *testing.T, hub *stream.Hub) {
+	t.Helper()
+
+	for i := 0; i < 100 && hub.Subscribers(_streamWalletID) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func Test_streamWallet_SSE(t *testing.T) {
+	hub := stream.New()
+	server := newStreamServer(t, hub)
+
+	resp, err := http.Get(server.URL + "/wallet/" + _streamWalletID + "/stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	assert.Equal(t, resp.StatusCode, http.StatusOK)
+	assert.Equal(t, resp.Header.Get("Content-Type"), "text/event-stream")
+
+	waitSubscribers(t, hub)
+	hub.HandleMessage("wallet."+_streamWalletID+".credited", "42", []byte(`{"amount":"100"}`))
+
+	reader := bufio.NewReader(resp.Body)
+
+	var lines []string
+
+	for len(lines) < 5 {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if line = strings.TrimSuffix(line, "\n"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	assert.Equal(t, lines[0], "event: wallet")
+	assert.Equal(t, strings.HasPrefix(lines[1], `data: {"type":"wallet","walletId":"`+_streamWalletID+`","data":{`), true)
+	assert.Equal(t, lines[2], "id: 42")
+	assert.Equal(t, lines[3], "event: credited")
+	assert.Equal(t, lines[4], fmt.Sprintf(`data: {"id":"42","type":"credited","walletId":"%s","data":{"amount":"100"}}`, _streamWalletID))
+}
+
+func Test_streamWallet_WebSocket(t *testing.T) {
+	hub := stream.New()
+	server := newStreamServer(t, hub)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/wallet/" + _streamWalletID + "/stream?transport=websocket"
+
+	ws, err := websocket.Dial(url, "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ws.Close()
+
+	var snapshot entity.WalletEvent
+	if err := websocket.JSON.Receive(ws, &snapshot); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, snapshot.Type, entity.WalletEventSnapshot)
+	assert.Equal(t, snapshot.WalletID, _streamWalletID)
+
+	waitSubscribers(t, hub)
+	hub.HandleMessage("wallet."+_streamWalletID+".transaction", "43", []byte(`{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7"}`))
+
+	var event entity.WalletEvent
+	if err := websocket.JSON.Receive(ws, &event); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, event.ID, "43")
+	assert.Equal(t, event.Type, entity.OutboxEventTransaction)
+	assert.Equal(t, string(event.Data), `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7"}`)
+}
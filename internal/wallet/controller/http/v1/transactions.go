@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type transactionRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newTransactionRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &transactionRoutes{w, l}
+
+	h := handler.Group("/transactions")
+	{
+		h.GET("/:id", r.getTransactionByID)
+	}
+}
+
+// @Summary     Получение перевода
+// @Description Возвращает перевод по его уникальному ID.
+// @Tags  	    Transactions
+// @Param id path string true "ID перевода"
+// @Success     200 {object} entity.Transaction "Перевод"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Перевод не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /transactions/{id} [get].
+func (r *transactionRoutes) getTransactionByID(c *gin.Context) {
+	transaction, err := r.w.GetTransactionByID(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrTransactionNotFound):
+			c.AbortWithStatus(http.StatusNotFound)
+		case errors.Is(err, entity.ErrTimeout):
+			c.AbortWithStatus(http.StatusGatewayTimeout)
+		default:
+			r.l.Error("http - v1 - getTransactionByID", sl.Err(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+
+		return
+	}
+	// The transaction is visible to the parties and to the callers, who can read all wallets
+	if err = checkReader(c, r.w, transaction.From, transaction.To); err != nil {
+		abortWithAccessError(c, r.w, r.l, "getTransactionByID", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, transaction)
+}
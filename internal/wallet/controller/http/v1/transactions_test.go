@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_getTransactionByID(t *testing.T) {
+	for _, test := range testsGetTransactionByID {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := transactionRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(&entity.Principal{Scopes: []string{entity.PermissionWalletRead}}))
+			r.GET("/transactions/:id", handler.getTransactionByID)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/transactions/%s", test.id), nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsGetTransactionByID = []struct {
+	name                 string
+	id                   string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		id:   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetTransactionByID(context.Background(), id).Return(&entity.Transaction{
+				ID:       id,
+				Time:     time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC),
+				Type:     entity.TransactionTypeTransfer,
+				From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+				To:       "eb376add88bf8e70f80787266a0801d5",
+				Amount:   3000,
+				Currency: "USD",
+				Status:   entity.TransactionStatusCompleted,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"transfer","from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"3000","currency":"USD","status":"completed"}`,
+	},
+	{
+		name: "Not found",
+		id:   "not-a-transaction",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetTransactionByID(context.Background(), id).Return(nil, entity.ErrTransactionNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Timeout",
+		id:   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetTransactionByID(context.Background(), id).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type transferRoutes struct {
+	w  usecase.Wallet
+	rl *ratelimit.Limiter
+	l  *slog.Logger
+}
+
+func newTransferRoutes(handler *gin.RouterGroup, w usecase.Wallet, rl *ratelimit.Limiter, l *slog.Logger) {
+	r := &transferRoutes{w, rl, l}
+
+	h := handler.Group("/transfers")
+	{
+		h.POST("/batch", r.sendFundsBatch)
+	}
+}
+
+// @Description Запрос пакетного перевода средств.
+type batchRequest struct {
+	Legs []entity.TransferLeg `json:"legs" description:"Переводы пакета, не более 1000" validate:"required"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Пакетный перевод средств
+// @Description Проводит все переводы пакета в одной транзакции: либо проводятся все, либо ни один.
+// @Description Если пакет отклонен, то в ответе перечислены ошибки всех отклоненных переводов.
+// @Tags  	    Transfers
+// @Param input body batchRequest true "Запрос пакетного перевода средств"
+// @Success     200 {array} entity.Transaction "Переводы успешно проведены"
+// @Failure     400 "Ошибка в пользовательском запросе или размер пакета"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     422 {object} entity.BatchError "Пакет отклонен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Ошибка перевода"
+// @Failure     503 "Не настроен кошелек комиссий валюты перевода"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /transfers/batch [post].
+func (r *transferRoutes) sendFundsBatch(c *gin.Context) {
+	var request batchRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	// Every wallet of the batch is checked, because the batch is rejected as a whole
+	for _, leg := range request.Legs {
+		if err := checkSender(c, r.w, leg.From); err != nil {
+			abortWithAccessError(c, r.w, r.l, "sendFundsBatch", err)
+			return
+		}
+	}
+
+	// The batch takes one token of every source wallet
+	seen := make(map[string]struct{}, len(request.Legs))
+	for _, leg := range request.Legs {
+		if _, ok := seen[leg.From]; ok {
+			continue
+		}
+
+		seen[leg.From] = struct{}{}
+
+		if !allowRate(c, r.rl, r.l, ratelimit.KindWallet, leg.From) {
+			return
+		}
+	}
+
+	transactions, err := r.w.SendFundsBatch(c.Request.Context(), request.Legs)
+	if err != nil {
+		var batchErr *entity.BatchError
+
+		switch {
+		case errors.As(err, &batchErr):
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, batchErr)
+		case errors.Is(err, entity.ErrBatchRejected):
+			c.AbortWithStatus(http.StatusUnprocessableEntity)
+		case errors.Is(err, entity.ErrWrongBatchSize):
+			c.AbortWithStatus(http.StatusBadRequest)
+		case errors.Is(err, entity.ErrTimeout):
+			c.AbortWithStatus(http.StatusGatewayTimeout)
+		case errors.Is(err, entity.ErrFeeWalletNotConfigured):
+			r.l.Error("http - v1 - sendFundsBatch - fee wallet of the sender currency is not configured", sl.Err(err))
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+		default:
+			r.l.Error("http - v1 - sendFundsBatch", sl.Err(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	c.JSON(http.StatusOK, transactions)
+}
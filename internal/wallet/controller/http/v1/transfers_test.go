@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_sendFundsBatch(t *testing.T) {
+	for _, test := range testsSendFundsBatch {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.legs)
+			handler := transferRoutes{
+				w:  repo,
+				rl: ratelimit.New(ratelimit.NewMemoryStore()),
+				l:  logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(&entity.Principal{Scopes: []string{entity.ScopeSend}}))
+			r.POST("/batch", handler.sendFundsBatch)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/batch", bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsSendFundsBatch = []struct {
+	name                 string
+	reqBody              string
+	legs                 []entity.TransferLeg
+	mockBehavior         func(r *mock_usecase.MockWallet, legs []entity.TransferLeg)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		reqBody: `{"legs":[{"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100"}]}`,
+		legs: []entity.TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {
+			r.EXPECT().SendFundsBatch(context.Background(), legs).Return([]entity.Transaction{
+				{
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC),
+					Type:     entity.TransactionTypeTransfer,
+					From:     legs[0].From,
+					To:       legs[0].To,
+					Amount:   legs[0].Amount,
+					Currency: "USD",
+				},
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"transfer","from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100","currency":"USD"}]`,
+	},
+	{
+		name:                 "Wrong request body",
+		reqBody:              `{"legs":"none"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Empty batch",
+		reqBody: `{"legs":[]}`,
+		legs:    []entity.TransferLeg{},
+		mockBehavior: func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {
+			r.EXPECT().SendFundsBatch(context.Background(), legs).Return(nil, entity.ErrWrongBatchSize)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Batch is rejected",
+		reqBody: `{"legs":[{"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100"},{"from":"eb376add88bf8e70f80787266a0801d5","to":"5b53700ed469fa6a09ea72bb78f36fd9",` +
+			`"amount":"999999"}]}`,
+		legs: []entity.TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+			{From: "eb376add88bf8e70f80787266a0801d5", To: "5b53700ed469fa6a09ea72bb78f36fd9", Amount: 999999},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {
+			r.EXPECT().SendFundsBatch(context.Background(), legs).Return(nil,
+				fmt.Errorf("WalletUseCase - SendFundsBatch: %w", &entity.BatchError{
+					Legs: []entity.LegError{{Index: 1, Error: entity.ErrNotEnoughFunds.Error()}},
+				}))
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: `{"legs":[{"index":1,"error":"not enough funds"}]}`,
+	},
+	{
+		name: "Timeout",
+		reqBody: `{"legs":[{"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100"}]}`,
+		legs: []entity.TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {
+			r.EXPECT().SendFundsBatch(context.Background(), legs).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+	{
+		name: "Fee wallet is not configured",
+		reqBody: `{"legs":[{"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100"}]}`,
+		legs: []entity.TransferLeg{
+			{From: "5b53700ed469fa6a09ea72bb78f36fd9", To: "eb376add88bf8e70f80787266a0801d5", Amount: 100},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, legs []entity.TransferLeg) {
+			r.EXPECT().SendFundsBatch(context.Background(), legs).Return(nil, entity.ErrFeeWalletNotConfigured)
+		},
+		expectedStatusCode:   503,
+		expectedResponseBody: "",
+	},
+}
@@ -2,10 +2,14 @@ package v1
 
 import (
 	"errors"
+	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/ratelimit"
 	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
 	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
 	"github.com/gin-gonic/gin"
@@ -16,30 +20,78 @@ type walletRoutes struct {
 	l *slog.Logger
 }
 
-func newWalletRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+func newWalletRoutes(handler *gin.RouterGroup, w usecase.Wallet, rl *ratelimit.Limiter, l *slog.Logger) {
 	r := &walletRoutes{w, l}
 
 	h := handler.Group("/wallet")
 	{
 		h.POST("", r.createNewWallet)
-		h.POST("/:walletId/send", r.sendFunds)
-		h.GET("/:walletId/history", r.GetWalletHistoryByID)
-		h.GET("/:walletId", r.GetWalletByID)
+		h.POST("/:walletId/send", limitWallet(rl, l), requireSender(w, l), r.sendFunds)
+		h.POST("/transactions/:id/refund", requirePermission(w, l, entity.PermissionLedgerAdjust), r.refundTransaction)
+		h.GET("/:walletId/history", requireReader(w, l), r.GetWalletHistoryByID)
+		h.GET("/:walletId", requireReader(w, l), r.GetWalletByID)
 	}
 }
 
+// @Description Запрос создания кошелька.
+type createWalletRequest struct {
+	Currency string `json:"currency" example:"USD"                                  description:"Валюта кошелька ISO 4217, по умолчанию USD"`                           //nolint:lll,tagalign // вот так то лучше
+	Type     string `json:"type"     example:"business"                             description:"Тип кошелька: personal, business или merchant, по умолчанию personal"` //nolint:lll,tagalign // вот так то лучше
+	OwnerID  string `json:"ownerId"  example:"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21" description:"ID клиента - владельца кошелька"`                                      //nolint:lll,tagalign // вот так то лучше
+}
+
 // @Summary     Создание кошелька
 // @Description Создает новый кошелек с уникальным ID. Идентификатор генерируется сервером.
+// @Description Владелец по умолчанию - клиент из subject вызывающего, другого владельца может указать только обладатель разрешения customers:manage.
 // @Description
-// @Description Созданный кошелек должен иметь сумму 100.0 у.е. на балансе
+// @Description Созданный кошелек должен иметь сумму 100.00 в валюте кошелька на балансе (10000 в минимальных единицах)
 // @Tags  	    Wallet
+// @Param input body createWalletRequest false "Запрос создания кошелька"
 // @Success     200 {object} entity.Wallet "Кошелек создан"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный владелец не найден"
+// @Failure     429 "Слишком много запросов"
 // @Failure     500 "Не удалось создать кошелек"
 // @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
 // @Router      /wallet [post].
 func (r *walletRoutes) createNewWallet(c *gin.Context) {
-	wallet, err := r.w.CreateNewWalletWithDefaultBalance(c.Request.Context())
+	var createWalletRequest createWalletRequest
+	// Request body is optional
+	if err := c.ShouldBindJSON(&createWalletRequest); err != nil && !errors.Is(err, io.EOF) {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	// The wallet is opened for the caller, unless the caller manages the customers
+	if createWalletRequest.OwnerID == "" {
+		createWalletRequest.OwnerID = principalFrom(c).Subject
+	}
+
+	if err := checkCustomer(c, r.w, createWalletRequest.OwnerID); err != nil {
+		abortWithAccessError(c, r.w, r.l, "createNewWallet", err)
+		return
+	}
+
+	wallet, err := r.w.CreateNewWalletWithDefaultBalance(
+		c.Request.Context(),
+		createWalletRequest.Currency,
+		createWalletRequest.Type,
+		createWalletRequest.OwnerID,
+	)
 	if err != nil {
+		if errors.Is(err, entity.ErrWrongCurrency) || errors.Is(err, entity.ErrWrongWalletType) {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		if errors.Is(err, entity.ErrCustomerNotFound) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
 		if errors.Is(err, entity.ErrTimeout) {
 			c.AbortWithStatus(http.StatusGatewayTimeout)
 			return
@@ -56,70 +108,231 @@ func (r *walletRoutes) createNewWallet(c *gin.Context) {
 
 // @Description Запрос перевода средств.
 type transactionRequest struct {
-	To     string `json:"to"     example:"eb376add88bf8e70f80787266a0801d5" description:"ID кошелька, куда нужно перевести деньги" validate:"required"` //nolint:lll,tagalign // вот так то лучше
-	Amount uint   `json:"amount" example:"100"                              description:"Сумма перевода"                           validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	To     string       `json:"to"     example:"eb376add88bf8e70f80787266a0801d5" description:"ID кошелька, куда нужно перевести деньги"     validate:"required"`                      //nolint:lll,tagalign // вот так то лучше
+	Amount entity.Money `json:"amount" example:"10000"                            description:"Сумма перевода в минимальных единицах валюты" validate:"required" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+
+	entity.TransferDetails
 }
 
 // @Summary     Перевод средств с одного кошелька на другой
+// @Description Повторный запрос с тем же ключом идемпотентности возвращает результат исходного перевода.
 // @Tags  	    Wallet
 // @Param walletId path string true "ID кошелька"
+// @Param Idempotency-Key header string false "Ключ идемпотентности перевода"
 // @Param input body transactionRequest true "Запрос перевода средств"
-// @Success     200 "Перевод успешно проведен"
+// @Success     200 {object} entity.Transaction "Перевод успешно проведен"
 // @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
 // @Failure     404 "Исходящий кошелек не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 {object} entity.LimitExceededError "Перевод невозможен: превышен лимит расходов, недостаточно средств, валюты кошельков различаются или ключ идемпотентности использован с другим запросом"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
 // @Failure     500 "Ошибка перевода"
+// @Failure     503 "Не настроен кошелек комиссий валюты перевода"
 // @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
 // @Router      /wallet/{walletId}/send [post].
 func (r *walletRoutes) sendFunds(c *gin.Context) {
 	var transactionRequest transactionRequest
 
-	if err := c.BindJSON(&transactionRequest); err != nil {
+	if err := c.BindJSON(&transactionRequest); err != nil || !transactionRequest.Amount.IsPositive() {
 		c.AbortWithStatus(http.StatusBadRequest)
 		return
 	}
 
 	walletID := c.Param("walletId")
+	idempotencyKey := c.GetHeader("Idempotency-Key")
 
-	err := r.w.SendFunds(c.Request.Context(), walletID, transactionRequest.To, transactionRequest.Amount)
+	transaction, err := r.w.SendFunds(
+		c.Request.Context(),
+		walletID,
+		transactionRequest.To,
+		transactionRequest.Amount,
+		transactionRequest.TransferDetails,
+		idempotencyKey,
+	)
 	if err != nil {
 		if errors.Is(err, entity.ErrSenderIsReceiver) ||
 			errors.Is(err, entity.ErrWrongAmount) ||
-			errors.Is(err, entity.ErrEmptyWallet) {
+			errors.Is(err, entity.ErrEmptyWallet) ||
+			errors.Is(err, entity.ErrWrongTransferDetails) ||
+			errors.Is(err, entity.ErrWrongIdempotencyKey) {
 			c.AbortWithStatus(http.StatusBadRequest)
 			return
 		}
+		// Responding with the remaining allowance of the exceeded limit
+		var limitErr *entity.LimitExceededError
+		if errors.As(err, &limitErr) {
+			c.AbortWithStatusJSON(http.StatusUnprocessableEntity, limitErr)
+			return
+		}
+
+		if errors.Is(err, entity.ErrIdempotencyKeyReused) ||
+			errors.Is(err, entity.ErrCurrencyMismatch) ||
+			errors.Is(err, entity.ErrNotEnoughFunds) ||
+			errors.Is(err, entity.ErrLimitExceeded) ||
+			errors.Is(err, entity.ErrAmountOverflow) {
+			c.AbortWithStatus(http.StatusUnprocessableEntity)
+			return
+		}
 
 		if errors.Is(err, entity.ErrWalletNotFound) {
 			c.AbortWithStatus(http.StatusNotFound)
 			return
 		}
 
+		if errors.Is(err, entity.ErrWalletFrozen) {
+			c.AbortWithStatus(http.StatusLocked)
+			return
+		}
+
+		if errors.Is(err, entity.ErrWalletClosed) {
+			c.AbortWithStatus(http.StatusGone)
+			return
+		}
+
 		if errors.Is(err, entity.ErrTimeout) {
 			c.AbortWithStatus(http.StatusGatewayTimeout)
 			return
 		}
 
+		if errors.Is(err, entity.ErrFeeWalletNotConfigured) {
+			r.l.Error("http - v1 - sendFunds - fee wallet of the sender currency is not configured", sl.Err(err))
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+
+			return
+		}
+
 		r.l.Error("http - v1 - sendFunds", sl.Err(err))
 		c.AbortWithStatus(http.StatusInternalServerError)
 
 		return
 	}
 
-	c.Status(http.StatusOK)
+	c.JSON(http.StatusOK, transaction)
+}
+
+// @Description Запрос возврата перевода.
+type refundRequest struct {
+	Amount entity.Money `json:"amount" example:"1000" description:"Сумма возврата, по умолчанию весь остаток перевода" swaggertype:"string"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Возврат перевода
+// @Description Создает связанный с переводом обратный перевод на всю сумму или ее часть.
+// @Description Сумма всех возвратов не может превышать сумму исходного перевода.
+// @Tags  	    Wallet
+// @Param id path string true "ID перевода"
+// @Param input body refundRequest false "Запрос возврата перевода"
+// @Success     200 {object} entity.Transaction "Возврат проведен"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Перевод не найден"
+// @Failure     410 "Кошелек закрыт"
+// @Failure     422 "Возврат невозможен: превышена сумма перевода, перевод сам является возвратом или недостаточно средств"
+// @Failure     423 "Кошелек заморожен"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Ошибка возврата"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /wallet/transactions/{id}/refund [post].
+func (r *walletRoutes) refundTransaction(c *gin.Context) {
+	var refundRequest refundRequest
+	// Request body is optional
+	if err := c.ShouldBindJSON(&refundRequest); (err != nil && !errors.Is(err, io.EOF)) || refundRequest.Amount < 0 {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	refund, err := r.w.RefundTransaction(c.Request.Context(), c.Param("id"), refundRequest.Amount)
+	if err != nil {
+		if errors.Is(err, entity.ErrWrongAmount) {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		if errors.Is(err, entity.ErrTransactionNotFound) ||
+			errors.Is(err, entity.ErrWalletNotFound) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		if errors.Is(err, entity.ErrRefundExceedsAmount) ||
+			errors.Is(err, entity.ErrTransactionNotRefundable) ||
+			errors.Is(err, entity.ErrNotEnoughFunds) ||
+			errors.Is(err, entity.ErrAmountOverflow) {
+			c.AbortWithStatus(http.StatusUnprocessableEntity)
+			return
+		}
+
+		if errors.Is(err, entity.ErrWalletFrozen) {
+			c.AbortWithStatus(http.StatusLocked)
+			return
+		}
+
+		if errors.Is(err, entity.ErrWalletClosed) {
+			c.AbortWithStatus(http.StatusGone)
+			return
+		}
+
+		if errors.Is(err, entity.ErrTimeout) {
+			c.AbortWithStatus(http.StatusGatewayTimeout)
+			return
+		}
+
+		r.l.Error("http - v1 - refundTransaction", sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, refund)
 }
 
 // @Summary     Получение историй входящих и исходящих транзакций
-// @Description Возвращает историю транзакций по указанному кошельку.
+// @Description Возвращает историю транзакций по указанному кошельку постранично, от новых к старым.
+// @Description Следующая страница запрашивается с курсором nextCursor из предыдущей.
+// @Description Возвраты содержат ID исходного перевода в поле parentId.
 // @Tags  	    Wallet
 // @Param walletId path string true "ID кошелька"
-// @Success     200 {object} []entity.Transaction "История транзакций получена"
+// @Param direction query string false "Направление транзакций" Enums(incoming, outgoing)
+// @Param counterparty query string false "ID кошелька контрагента"
+// @Param reference query string false "Внешняя ссылка перевода"
+// @Param since query string false "Начало периода включительно, RFC3339"
+// @Param until query string false "Конец периода не включительно, RFC3339"
+// @Param minAmount query string false "Минимальная сумма в минимальных единицах валюты"
+// @Param maxAmount query string false "Максимальная сумма в минимальных единицах валюты"
+// @Param cursor query string false "Курсор страницы"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 100"
+// @Success     200 {object} entity.HistoryPage "Страница истории транзакций получена"
+// @Failure     400 "Неверный фильтр или курсор"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
 // @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
 // @Failure     500 "Не удалось выполнить запрос"
 // @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
 // @Router      /wallet/{walletId}/history [get].
 func (r *walletRoutes) GetWalletHistoryByID(c *gin.Context) {
-	transactions, err := r.w.GetWalletHistoryByID(c.Request.Context(), c.Param("walletId"))
+	filter, err := parseHistoryFilter(c)
 	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	page, err := r.w.GetWalletHistoryByID(c.Request.Context(), c.Param("walletId"), filter)
+	if err != nil {
+		if errors.Is(err, entity.ErrWrongHistoryFilter) {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
 		if errors.Is(err, entity.ErrTimeout) {
 			c.AbortWithStatus(http.StatusGatewayTimeout)
 			return
@@ -136,16 +349,66 @@ func (r *walletRoutes) GetWalletHistoryByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, transactions)
+	c.JSON(http.StatusOK, page)
+}
+
+// Parsing the filter of the wallet history from the query parameters.
+func parseHistoryFilter(c *gin.Context) (entity.HistoryFilter, error) {
+	var err error
+
+	filter := entity.HistoryFilter{
+		Reference:    c.Query("reference"),
+		Direction:    c.Query("direction"),
+		Counterparty: c.Query("counterparty"),
+		Cursor:       c.Query("cursor"),
+	}
+
+	if value := c.Query("since"); value != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, value); err != nil {
+			return filter, entity.ErrWrongHistoryFilter
+		}
+	}
+
+	if value := c.Query("until"); value != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, value); err != nil {
+			return filter, entity.ErrWrongHistoryFilter
+		}
+	}
+
+	if value := c.Query("minAmount"); value != "" {
+		if filter.MinAmount, err = entity.ParseMoney(value); err != nil {
+			return filter, entity.ErrWrongHistoryFilter
+		}
+	}
+
+	if value := c.Query("maxAmount"); value != "" {
+		if filter.MaxAmount, err = entity.ParseMoney(value); err != nil {
+			return filter, entity.ErrWrongHistoryFilter
+		}
+	}
+
+	if value := c.Query("limit"); value != "" {
+		if filter.Limit, err = strconv.Atoi(value); err != nil {
+			return filter, entity.ErrWrongHistoryFilter
+		}
+	}
+
+	return filter, nil
 }
 
 // @Summary     Получение текущего состояния кошелька
+// @Description Возвращает баланс кошелька по журналу и доступный баланс за вычетом активных резервов.
 // @Tags  	    Wallet
 // @Param walletId path string true "ID кошелька"
 // @Success     200 {object} entity.Wallet "OK"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
 // @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
 // @Failure     500 "Не удалось выполнить запрос"
 // @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
 // @Router      /wallet/{walletId} [get].
 func (r *walletRoutes) GetWalletByID(c *gin.Context) {
 	wallet, err := r.w.GetWalletByID(c.Request.Context(), c.Param("walletId"))
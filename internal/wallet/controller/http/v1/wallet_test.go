@@ -5,8 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,6 +23,9 @@ import (
 
 var errSomethingWrong = errors.New("something went wrong")
 
+// Caller, who opens the wallets for any owner and anonymous ones.
+var _customersManager = &entity.Principal{Scopes: []string{entity.PermissionCustomersManage}}
+
 func Test_createNewWallet(t *testing.T) {
 	for _, test := range testsCreateNewWallet {
 		t.Run(test.name, func(t *testing.T) {
@@ -36,10 +41,11 @@ func Test_createNewWallet(t *testing.T) {
 			}
 			// Init Endpoint
 			r := gin.New()
+			r.Use(withPrincipal(test.principal))
 			r.POST("/", handler.createNewWallet)
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(test.reqBody))
 			// Make Request
 			r.ServeHTTP(w, req)
 
@@ -52,35 +58,157 @@ func Test_createNewWallet(t *testing.T) {
 
 var testsCreateNewWallet = []struct {
 	name                 string
+	principal            *entity.Principal
+	reqBody              string
 	mockBehavior         func(r *mock_usecase.MockWallet, id string)
 	id                   string
 	expectedStatusCode   int
 	expectedResponseBody string
 }{
 	{
-		name: "Ok",
+		name:      "Ok",
+		principal: _customersManager,
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
-			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background()).Return(&entity.Wallet{
-				ID:      id,
-				Balance: 100,
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", "").Return(&entity.Wallet{
+				ID:        id,
+				Balance:   100,
+				Available: 100,
+				Currency:  "USD",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypePersonal,
 			}, nil)
 		},
 		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
 		expectedStatusCode:   200,
-		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":100}`,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100","currency":"USD","status":"active","type":"personal"}`,
 	},
 	{
-		name: "Something went wrong",
+		name:      "Ok - owned by the caller",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").
+				Return(&entity.Wallet{
+					ID:        id,
+					Balance:   100,
+					Available: 100,
+					Currency:  "USD",
+					Status:    entity.WalletStatusActive,
+					Type:      entity.WalletTypePersonal,
+					OwnerID:   "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+				}, nil)
+		},
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode:   200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100","currency":"USD","status":"active","type":"personal","ownerId":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"}`,
+	},
+	{
+		name:      "Other owner",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21", Permissions: []string{}},
+		reqBody:   `{"ownerId":"3c0e1f5a-0d4b-4a3e-8f61-2b7d9e8c4a10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode:   403,
+		expectedResponseBody: "",
+	},
+	{
+		name:      "Without subject",
+		principal: &entity.Principal{Permissions: []string{}},
 		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
-			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background()).Return(nil, errSomethingWrong)
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode:   403,
+		expectedResponseBody: "",
+	},
+	{
+		name:      "Ok - with currency",
+		principal: _customersManager,
+		reqBody:   `{"currency":"EUR"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "EUR", "", "").Return(&entity.Wallet{
+				ID:        id,
+				Balance:   100,
+				Available: 100,
+				Currency:  "EUR",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypePersonal,
+			}, nil)
+		},
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode:   200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100","currency":"EUR","status":"active","type":"personal"}`,
+	},
+	{
+		name:      "Ok - with type",
+		principal: _customersManager,
+		reqBody:   `{"type":"business"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", entity.WalletTypeBusiness, "").Return(&entity.Wallet{
+				ID:        id,
+				Balance:   100,
+				Available: 100,
+				Currency:  "USD",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypeBusiness,
+			}, nil)
+		},
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedStatusCode:   200,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"0","available":"100","currency":"USD","status":"active","type":"business"}`,
+	},
+	{
+		name:      "Wrong currency",
+		principal: _customersManager,
+		reqBody:   `{"currency":"ABC"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "ABC", "", "").Return(nil, entity.ErrWrongCurrency)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:      "Wrong type",
+		principal: _customersManager,
+		reqBody:   `{"type":"corporate"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "corporate", "").Return(nil, entity.ErrWrongWalletType)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:      "Owner not found",
+		principal: _customersManager,
+		reqBody:   `{"ownerId":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").
+				Return(nil, entity.ErrCustomerNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong input - not json",
+		principal:            _customersManager,
+		reqBody:              `helloworld`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:      "Something went wrong",
+		principal: _customersManager,
+		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", "").Return(nil, errSomethingWrong)
 		},
 		expectedStatusCode:   500,
 		expectedResponseBody: "",
 	},
 	{
-		name: "Timeout",
+		name:      "Timeout",
+		principal: _customersManager,
 		mockBehavior: func(r *mock_usecase.MockWallet, _ string) {
-			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background()).Return(nil, entity.ErrTimeout)
+			r.EXPECT().CreateNewWalletWithDefaultBalance(context.Background(), "", "", "").Return(nil, entity.ErrTimeout)
 		},
 		expectedStatusCode:   504,
 		expectedResponseBody: "",
@@ -95,7 +223,7 @@ func Test_sendFunds(t *testing.T) {
 			defer c.Finish()
 
 			repo := mock_usecase.NewMockWallet(c)
-			test.mockBehavior(repo, test.id, test.req)
+			test.mockBehavior(repo, test.id, test.idempotencyKey, test.req)
 			handler := walletRoutes{
 				w: repo,
 				l: logger.SetupLogger("debug"),
@@ -106,6 +234,9 @@ func Test_sendFunds(t *testing.T) {
 			// Create Request
 			w := httptest.NewRecorder()
 			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/%s/send", test.id), bytes.NewBufferString(test.reqBody))
+			if test.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", test.idempotencyKey)
+			}
 
 			// Make Request
 			r.ServeHTTP(w, req)
@@ -117,12 +248,34 @@ func Test_sendFunds(t *testing.T) {
 	}
 }
 
+func sentTransaction(id string, req transactionRequest) *entity.Transaction {
+	return &entity.Transaction{
+		ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		Time:     time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC),
+		Type:     entity.TransactionTypeTransfer,
+		From:     id,
+		To:       req.To,
+		Amount:   req.Amount,
+		Currency: "USD",
+		Status:   entity.TransactionStatusCompleted,
+
+		TransferDetails: req.TransferDetails,
+	}
+}
+
+func sentTransactionJSON(from, to string, amount entity.Money) string {
+	return fmt.Sprintf(`{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",`+
+		`"type":"transfer","from":"%s","to":"%s","amount":"%d","currency":"USD","status":"completed"}`,
+		from, to, amount)
+}
+
 var testSendFunds = []struct {
 	name                 string
 	id                   string
+	idempotencyKey       string
 	reqBody              string
 	req                  transactionRequest
-	mockBehavior         func(r *mock_usecase.MockWallet, id string, req transactionRequest)
+	mockBehavior         func(r *mock_usecase.MockWallet, id, key string, req transactionRequest)
 	expectedStatusCode   int
 	expectedResponseBody string
 }{
@@ -134,10 +287,52 @@ var testSendFunds = []struct {
 			To:     "eb376add88bf8e70f80787266a0801d5",
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(nil)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(sentTransaction(id, req), nil)
 		},
-		expectedStatusCode:   200,
+		expectedStatusCode: 200,
+		expectedResponseBody: sentTransactionJSON(
+			"5b53700ed469fa6a09ea72bb78f36fd9", "eb376add88bf8e70f80787266a0801d5", 100),
+	},
+	{
+		name: "Ok - with transfer details",
+		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100,"description":"Salary for April",` +
+			`"reference":"INV-2024-0042","metadata":{"department":"sales"}}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+			TransferDetails: entity.TransferDetails{
+				Description: "Salary for April",
+				Reference:   "INV-2024-0042",
+				Metadata:    map[string]string{"department": "sales"},
+			},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(sentTransaction(id, req), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35Z",` +
+			`"type":"transfer","from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"100","currency":"USD","status":"completed","description":"Salary for April",` +
+			`"reference":"INV-2024-0042","metadata":{"department":"sales"}}`,
+	},
+	{
+		name:    "Wrong input - too long reference",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100,"reference":"` + strings.Repeat("x", 65) + `"}`,
+		req: transactionRequest{
+			To:              "eb376add88bf8e70f80787266a0801d5",
+			Amount:          100,
+			TransferDetails: entity.TransferDetails{Reference: strings.Repeat("x", 65)},
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(nil, entity.ErrWrongTransferDetails)
+		},
+		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
 	{
@@ -148,12 +343,73 @@ var testSendFunds = []struct {
 			To:     "eb376add88bf8e70f80787266a0801d5",
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrWalletNotFound)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrWalletNotFound)
 		},
 		expectedStatusCode:   404,
 		expectedResponseBody: "",
 	},
+	{
+		name:           "Ok - with idempotency key",
+		id:             "5b53700ed469fa6a09ea72bb78f36fd9",
+		idempotencyKey: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		reqBody:        `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(sentTransaction(id, req), nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: sentTransactionJSON(
+			"5b53700ed469fa6a09ea72bb78f36fd9", "eb376add88bf8e70f80787266a0801d5", 100),
+	},
+	{
+		name:           "Idempotency key reused with another request",
+		id:             "5b53700ed469fa6a09ea72bb78f36fd9",
+		idempotencyKey: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		reqBody:        `{"to":"eb376add88bf8e70f80787266a0801d5","amount":50}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 50,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrIdempotencyKeyReused)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:           "Wrong idempotency key",
+		id:             "5b53700ed469fa6a09ea72bb78f36fd9",
+		idempotencyKey: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		reqBody:        `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrWrongIdempotencyKey)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Currencies of wallets are different",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrCurrencyMismatch)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
 	{
 		name:    "Wrong input - without receiver id",
 		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
@@ -161,30 +417,131 @@ var testSendFunds = []struct {
 		req: transactionRequest{
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrEmptyWallet)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrEmptyWallet)
 		},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
 	{
-		name:    "Wrong input - without amount",
+		name:                 "Wrong input - without amount",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Ok - amount as string",
 		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
-		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5"}`,
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"9223372036854775807"}`,
 		req: transactionRequest{
-			To: "eb376add88bf8e70f80787266a0801d5",
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: math.MaxInt64,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrWrongAmount)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(sentTransaction(id, req), nil)
 		},
+		expectedStatusCode: 200,
+		expectedResponseBody: sentTransactionJSON(
+			"5b53700ed469fa6a09ea72bb78f36fd9", "eb376add88bf8e70f80787266a0801d5", math.MaxInt64),
+	},
+	{
+		name:                 "Wrong input - amount overflow",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"9223372036854775808"}`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong input - amount with fraction",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5","amount":10.5}`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
+	{
+		name:    "Not enough funds",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrNotEnoughFunds)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Daily limit is exceeded",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil,
+				fmt.Errorf("WalletUseCase - SendFunds: %w", &entity.LimitExceededError{
+					Limit:     entity.LimitDaily,
+					Remaining: 40,
+				}))
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: `{"limit":"daily","remaining":"40"}`,
+	},
+	{
+		name:    "Limit is exceeded without details",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrLimitExceeded)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Sender wallet is frozen",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrWalletFrozen)
+		},
+		expectedStatusCode:   423,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Receiver wallet is closed",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrWalletClosed)
+		},
+		expectedStatusCode:   410,
+		expectedResponseBody: "",
+	},
 	{
 		name:                 "Wrong input - amount less 0",
 		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
 		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5","amount":-100}`,
-		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string, _ transactionRequest) {},
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
@@ -192,7 +549,7 @@ var testSendFunds = []struct {
 		name:                 "Wrong input - amount not number",
 		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
 		reqBody:              `{"to":"eb376add88bf8e70f80787266a0801d5","amount":"abc"}`,
-		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string, _ transactionRequest) {},
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
@@ -200,18 +557,15 @@ var testSendFunds = []struct {
 		name:                 "Wrong input - not json",
 		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
 		reqBody:              `helloworld`,
-		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string, _ transactionRequest) {},
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
 	{
-		name:    "Wrong input - empty request body",
-		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
-		reqBody: `{}`,
-		req:     transactionRequest{},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrWrongAmount)
-		},
+		name:                 "Wrong input - empty request body",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody:              `{}`,
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _, _ string, _ transactionRequest) {},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
 	},
@@ -223,8 +577,8 @@ var testSendFunds = []struct {
 			To:     "5b53700ed469fa6a09ea72bb78f36fd9",
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrSenderIsReceiver)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrSenderIsReceiver)
 		},
 		expectedStatusCode:   400,
 		expectedResponseBody: "",
@@ -237,12 +591,27 @@ var testSendFunds = []struct {
 			To:     "eb376add88bf8e70f80787266a0801d5",
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(entity.ErrTimeout)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, entity.ErrTimeout)
 		},
 		expectedStatusCode:   504,
 		expectedResponseBody: "",
 	},
+	{
+		name:    "Fee wallet is not configured",
+		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		reqBody: `{"to":"eb376add88bf8e70f80787266a0801d5","amount":100}`,
+		req: transactionRequest{
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).
+				Return(nil, entity.ErrFeeWalletNotConfigured)
+		},
+		expectedStatusCode:   503,
+		expectedResponseBody: "",
+	},
 	{
 		name:    "Something went wrong",
 		id:      "5b53700ed469fa6a09ea72bb78f36fd9",
@@ -251,8 +620,8 @@ var testSendFunds = []struct {
 			To:     "eb376add88bf8e70f80787266a0801d5",
 			Amount: 100,
 		},
-		mockBehavior: func(r *mock_usecase.MockWallet, id string, req transactionRequest) {
-			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount).Return(errSomethingWrong)
+		mockBehavior: func(r *mock_usecase.MockWallet, id, key string, req transactionRequest) {
+			r.EXPECT().SendFunds(context.Background(), id, req.To, req.Amount, req.TransferDetails, key).Return(nil, errSomethingWrong)
 		},
 		expectedStatusCode:   500,
 		expectedResponseBody: "",
@@ -277,7 +646,7 @@ func Test_getWalletHistoryByID(t *testing.T) {
 			r.GET("/:walletId/history", handler.GetWalletHistoryByID)
 			// Create Request
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/history", test.id), nil)
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%s/history%s", test.id, test.query), nil)
 			// Make Request
 			r.ServeHTTP(w, req)
 
@@ -291,6 +660,7 @@ func Test_getWalletHistoryByID(t *testing.T) {
 var testGetWalletHistoryByID = []struct {
 	name                 string
 	id                   string
+	query                string
 	mockBehavior         func(r *mock_usecase.MockWallet, id string)
 	expectedStatusCode   int
 	expectedResponseBody string
@@ -301,26 +671,32 @@ var testGetWalletHistoryByID = []struct {
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
 			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
 
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return([]entity.Transaction{
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(&entity.HistoryPage{Transactions: []entity.Transaction{
 				{
-					Time:   t,
-					From:   "5b53700ed469fa6a09ea72bb78f36fd9",
-					To:     "eb376add88bf8e70f80787266a0801d5",
-					Amount: 30,
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+					To:       "eb376add88bf8e70f80787266a0801d5",
+					Amount:   30,
+					Currency: "USD",
 				},
 				{
-					Time:   t,
-					From:   "eb376add88bf8e70f80787266a0801d5",
-					To:     "5b53700ed469fa6a09ea72bb78f36fd9",
-					Amount: 30,
+					ID:       "0f8fad5b-d9cb-469f-a165-70867728950e",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "eb376add88bf8e70f80787266a0801d5",
+					To:       "5b53700ed469fa6a09ea72bb78f36fd9",
+					Amount:   30,
+					Currency: "USD",
 				},
-			}, nil)
+			}}, nil)
 		},
 		expectedStatusCode: 200,
-		expectedResponseBody: `[{"time":"2024-02-04T17:25:35.448Z","from":"5b53700ed469fa6a09ea72bb78f36fd9",` +
-			`"to":"eb376add88bf8e70f80787266a0801d5","amount":30},` +
-			`{"time":"2024-02-04T17:25:35.448Z","from":"eb376add88bf8e70f80787266a0801d5",` +
-			`"to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":30}]`,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5","amount":"30","currency":"USD"},` +
+			`{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"eb376add88bf8e70f80787266a0801d5","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"30","currency":"USD"}]}`,
 	},
 	{
 		name: "Ok - history exists (only sending)",
@@ -328,18 +704,21 @@ var testGetWalletHistoryByID = []struct {
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
 			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
 
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return([]entity.Transaction{
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(&entity.HistoryPage{Transactions: []entity.Transaction{
 				{
-					Time:   t,
-					From:   "5b53700ed469fa6a09ea72bb78f36fd9",
-					To:     "eb376add88bf8e70f80787266a0801d5",
-					Amount: 30.0,
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+					To:       "eb376add88bf8e70f80787266a0801d5",
+					Amount:   30.0,
+					Currency: "USD",
 				},
-			}, nil)
+			}}, nil)
 		},
 		expectedStatusCode: 200,
-		expectedResponseBody: `[{"time":"2024-02-04T17:25:35.448Z","from":"5b53700ed469fa6a09ea72bb78f36fd9",` +
-			`"to":"eb376add88bf8e70f80787266a0801d5","amount":30}]`,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5","amount":"30","currency":"USD"}]}`,
 	},
 	{
 		name: "Ok - history exists (only receiving)",
@@ -347,33 +726,164 @@ var testGetWalletHistoryByID = []struct {
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
 			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
 
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return([]entity.Transaction{
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(&entity.HistoryPage{Transactions: []entity.Transaction{
 				{
-					Time:   t,
-					From:   "eb376add88bf8e70f80787266a0801d5",
-					To:     "5b53700ed469fa6a09ea72bb78f36fd9",
-					Amount: 30.0,
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "eb376add88bf8e70f80787266a0801d5",
+					To:       "5b53700ed469fa6a09ea72bb78f36fd9",
+					Amount:   30.0,
+					Currency: "USD",
 				},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"eb376add88bf8e70f80787266a0801d5","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"30","currency":"USD"}]}`,
+	},
+	{
+		name: "Ok - history with refund",
+		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
+
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(&entity.HistoryPage{Transactions: []entity.Transaction{
+				{
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+					To:       "eb376add88bf8e70f80787266a0801d5",
+					Amount:   30,
+					Currency: "USD",
+				},
+				{
+					ID:       "0f8fad5b-d9cb-469f-a165-70867728950e",
+					Time:     t,
+					Type:     entity.TransactionTypeRefund,
+					From:     "eb376add88bf8e70f80787266a0801d5",
+					To:       "5b53700ed469fa6a09ea72bb78f36fd9",
+					Amount:   10,
+					Currency: "USD",
+					ParentID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5","amount":"30","currency":"USD"},` +
+			`{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","time":"2024-02-04T17:25:35.448Z","type":"refund",` +
+			`"from":"eb376add88bf8e70f80787266a0801d5","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"10","currency":"USD",` +
+			`"parentId":"7c9e6679-7425-40de-944b-e07fc1f90ae7"}]}`,
+	},
+	{
+		name:  "Ok - filtered by reference",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?reference=INV-2024-0042",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
+
+			filter := entity.HistoryFilter{Reference: "INV-2024-0042"}
+
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, filter).Return(&entity.HistoryPage{Transactions: []entity.Transaction{
+				{
+					ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+					Time:     t,
+					Type:     entity.TransactionTypeTransfer,
+					From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+					To:       "eb376add88bf8e70f80787266a0801d5",
+					Amount:   30,
+					Currency: "USD",
+
+					TransferDetails: entity.TransferDetails{Reference: "INV-2024-0042"},
+				},
+			}}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z","type":"transfer",` +
+			`"from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5","amount":"30","currency":"USD",` +
+			`"reference":"INV-2024-0042"}]}`,
+	},
+	{
+		name: "Ok - filtered page with next cursor",
+		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?direction=outgoing&counterparty=eb376add88bf8e70f80787266a0801d5" +
+			"&since=2024-02-01T00:00:00Z&until=2024-03-01T00:00:00Z&minAmount=10&maxAmount=100&limit=1",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
+
+			filter := entity.HistoryFilter{
+				Direction:    entity.DirectionOutgoing,
+				Counterparty: "eb376add88bf8e70f80787266a0801d5",
+				Since:        time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				Until:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				MinAmount:    10,
+				MaxAmount:    100,
+				Limit:        1,
+			}
+
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, filter).Return(&entity.HistoryPage{
+				Transactions: []entity.Transaction{
+					{
+						ID:       "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+						Time:     t,
+						Type:     entity.TransactionTypeTransfer,
+						From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+						To:       "eb376add88bf8e70f80787266a0801d5",
+						Amount:   30,
+						Currency: "USD",
+					},
+				},
+				NextCursor: "MjAyNC0wMi0wNFQxNzoyNTozNS40NDhafDdjOWU2Njc5",
 			}, nil)
 		},
 		expectedStatusCode: 200,
-		expectedResponseBody: `[{"time":"2024-02-04T17:25:35.448Z","from":"eb376add88bf8e70f80787266a0801d5",` +
-			`"to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":30}]`,
+		expectedResponseBody: `{"transactions":[{"id":"7c9e6679-7425-40de-944b-e07fc1f90ae7","time":"2024-02-04T17:25:35.448Z",` +
+			`"type":"transfer","from":"5b53700ed469fa6a09ea72bb78f36fd9","to":"eb376add88bf8e70f80787266a0801d5",` +
+			`"amount":"30","currency":"USD"}],"nextCursor":"MjAyNC0wMi0wNFQxNzoyNTozNS40NDhafDdjOWU2Njc5"}`,
+	},
+	{
+		name:                 "Wrong input - bad date",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "?since=yesterday",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong input - bad limit",
+		id:                   "5b53700ed469fa6a09ea72bb78f36fd9",
+		query:                "?limit=many",
+		mockBehavior:         func(_ *mock_usecase.MockWallet, _ string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:  "Wrong filter",
+		id:    "5b53700ed469fa6a09ea72bb78f36fd9",
+		query: "?cursor=broken",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{Cursor: "broken"}).
+				Return(nil, entity.ErrWrongHistoryFilter)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
 	},
 	{
 		name: "Ok - history is empty",
 		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return([]entity.Transaction{}, nil)
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(&entity.HistoryPage{Transactions: []entity.Transaction{}}, nil)
 		},
 		expectedStatusCode:   200,
-		expectedResponseBody: `[]`,
+		expectedResponseBody: `{"transactions":[]}`,
 	},
 	{
 		name: "Not Found",
 		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return(nil, entity.ErrWalletNotFound)
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(nil, entity.ErrWalletNotFound)
 		},
 		expectedStatusCode:   404,
 		expectedResponseBody: "",
@@ -382,7 +892,7 @@ var testGetWalletHistoryByID = []struct {
 		name: "Timeout",
 		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return(nil, entity.ErrTimeout)
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(nil, entity.ErrTimeout)
 		},
 		expectedStatusCode:   504,
 		expectedResponseBody: "",
@@ -391,7 +901,7 @@ var testGetWalletHistoryByID = []struct {
 		name: "Something went wrong",
 		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
-			r.EXPECT().GetWalletHistoryByID(context.Background(), id).Return(nil, errSomethingWrong)
+			r.EXPECT().GetWalletHistoryByID(context.Background(), id, entity.HistoryFilter{}).Return(nil, errSomethingWrong)
 		},
 		expectedStatusCode:   500,
 		expectedResponseBody: "",
@@ -439,12 +949,17 @@ var testGetWalletByID = []struct {
 		id:   "5b53700ed469fa6a09ea72bb78f36fd9",
 		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
 			r.EXPECT().GetWalletByID(context.Background(), id).Return(&entity.Wallet{
-				ID:      id,
-				Balance: 100,
+				ID:        id,
+				Balance:   100,
+				Held:      30,
+				Available: 70,
+				Currency:  "USD",
+				Status:    entity.WalletStatusActive,
+				Type:      entity.WalletTypePersonal,
 			}, nil)
 		},
 		expectedStatusCode:   200,
-		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":100}`,
+		expectedResponseBody: `{"id":"5b53700ed469fa6a09ea72bb78f36fd9","balance":"100","held":"30","available":"70","currency":"USD","status":"active","type":"personal"}`,
 	},
 	{
 		name: "Not Found",
@@ -474,3 +989,161 @@ var testGetWalletByID = []struct {
 		expectedResponseBody: "",
 	},
 }
+
+func Test_refundTransaction(t *testing.T) {
+	for _, test := range testsRefundTransaction {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo, test.id)
+			handler := walletRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.POST("/transactions/:id/refund", handler.refundTransaction)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/transactions/%s/refund", test.id),
+				bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsRefundTransaction = []struct {
+	name                 string
+	id                   string
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet, id string)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:    "Ok - partial",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			t, _ := time.Parse(time.RFC3339, "2024-02-04T17:25:35.448Z")
+
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).Return(&entity.Transaction{
+				ID:       "0f8fad5b-d9cb-469f-a165-70867728950e",
+				Time:     t,
+				Type:     entity.TransactionTypeRefund,
+				From:     "eb376add88bf8e70f80787266a0801d5",
+				To:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Amount:   10,
+				Currency: "USD",
+				ParentID: id,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","time":"2024-02-04T17:25:35.448Z","type":"refund",` +
+			`"from":"eb376add88bf8e70f80787266a0801d5","to":"5b53700ed469fa6a09ea72bb78f36fd9","amount":"10","currency":"USD",` +
+			`"parentId":"7c9e6679-7425-40de-944b-e07fc1f90ae7"}`,
+	},
+	{
+		name: "Ok - whole remaining amount",
+		id:   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(0)).Return(&entity.Transaction{
+				ID:       "0f8fad5b-d9cb-469f-a165-70867728950e",
+				Type:     entity.TransactionTypeRefund,
+				Amount:   30,
+				ParentID: id,
+			}, nil)
+		},
+		expectedStatusCode: 200,
+		expectedResponseBody: `{"id":"0f8fad5b-d9cb-469f-a165-70867728950e","time":"0001-01-01T00:00:00Z","type":"refund",` +
+			`"from":"","to":"","amount":"30","currency":"","parentId":"7c9e6679-7425-40de-944b-e07fc1f90ae7"}`,
+	},
+	{
+		name:                 "Negative amount",
+		id:                   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody:              `{"amount":"-10"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet, id string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:                 "Wrong body",
+		id:                   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody:              `{"amount":"1.5"}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet, id string) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Transaction not found",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).
+				Return(nil, entity.ErrTransactionNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Refund exceeds amount",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"100"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(100)).
+				Return(nil, entity.ErrRefundExceedsAmount)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Refund of refund",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).
+				Return(nil, entity.ErrTransactionNotRefundable)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Not enough funds",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).
+				Return(nil, entity.ErrNotEnoughFunds)
+		},
+		expectedStatusCode:   422,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Timeout",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: "",
+	},
+	{
+		name:    "Something went wrong",
+		id:      "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		reqBody: `{"amount":"10"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet, id string) {
+			r.EXPECT().RefundTransaction(context.Background(), id, entity.Money(10)).Return(nil, errSomethingWrong)
+		},
+		expectedStatusCode:   500,
+		expectedResponseBody: "",
+	},
+}
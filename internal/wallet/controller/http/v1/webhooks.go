@@ -0,0 +1,249 @@
+package v1
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/wallet/usecase"
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type webhookRoutes struct {
+	w usecase.Wallet
+	l *slog.Logger
+}
+
+func newWebhookRoutes(handler *gin.RouterGroup, w usecase.Wallet, l *slog.Logger) {
+	r := &webhookRoutes{w, l}
+
+	// The subscriptions are owned by the subject of the caller
+	h := handler.Group("/webhooks", requireSubject(w, l))
+	{
+		h.POST("", r.createWebhook)
+		h.GET("", r.getWebhooks)
+		h.GET("/:webhookId", r.getWebhookByID)
+		h.DELETE("/:webhookId", r.deleteWebhook)
+		h.GET("/:webhookId/deliveries", r.getWebhookDeliveries)
+		h.POST("/:webhookId/deliveries/:deliveryId/replay", r.replayWebhookDelivery)
+	}
+}
+
+// @Description Запрос создания подписки на события.
+type createWebhookRequest struct {
+	URL        string   `json:"url"                example:"https://example.com/hooks/wallet" description:"Адрес, на который отправляются события"                                                        validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	EventTypes []string `json:"eventTypes"         example:"transfer.completed,wallet.frozen" description:"Типы событий: transfer.completed, wallet.frozen, wallet.unfrozen, wallet.closed, hold.expired" validate:"required"` //nolint:lll,tagalign // вот так то лучше
+	WalletID   string   `json:"walletId,omitempty" example:"5b53700ed469fa6a09ea72bb78f36fd9" description:"ID кошелька, без него отправляются события всех кошельков"                                     validate:"optional"` //nolint:lll,tagalign // вот так то лучше
+}
+
+// @Summary     Создание подписки на события
+// @Description События отправляются POST запросом с телом entity.WebhookEvent.
+// @Description Заголовок X-Webhook-Signature содержит подпись "t=<unix время>,v1=<HMAC-SHA256>" строки "<unix время>.<тело запроса>" секретом подписки.
+// @Description Неуспешные доставки повторяются с экспоненциальной задержкой. Секрет возвращается только при создании подписки.
+// @Description Подписка на все кошельки требует разрешения wallet:read, на один кошелек - права на его чтение.
+// @Tags  	    Webhooks
+// @Param input body createWebhookRequest true "Запрос создания подписки"
+// @Success     201 {object} entity.WebhookSubscription "Подписка создана"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Указанный кошелек не найден"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось создать подписку"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks [post].
+func (r *webhookRoutes) createWebhook(c *gin.Context) {
+	var request createWebhookRequest
+
+	if err := c.BindJSON(&request); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	var err error
+	if request.WalletID != "" {
+		err = checkReader(c, r.w, request.WalletID)
+	} else {
+		err = checkPermission(c, r.w, entity.PermissionWalletRead)
+	}
+
+	if err != nil {
+		abortWithAccessError(c, r.w, r.l, "createWebhook", err)
+		return
+	}
+
+	subscription, err := r.w.CreateWebhook(c.Request.Context(), &entity.WebhookSubscription{
+		Owner:      principalFrom(c).Subject,
+		URL:        request.URL,
+		EventTypes: request.EventTypes,
+		WalletID:   request.WalletID,
+	})
+	if err != nil {
+		r.abortWithError(c, "createWebhook", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, subscription)
+}
+
+// @Summary     Получение подписок вызывающего
+// @Tags  	    Webhooks
+// @Success     200 {array} entity.WebhookSubscription "Подписки"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks [get].
+func (r *webhookRoutes) getWebhooks(c *gin.Context) {
+	subscriptions, err := r.w.GetWebhooks(c.Request.Context(), principalFrom(c).Subject)
+	if err != nil {
+		r.abortWithError(c, "getWebhooks", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+// @Summary     Получение подписки
+// @Tags  	    Webhooks
+// @Param webhookId path string true "ID подписки"
+// @Success     200 {object} entity.WebhookSubscription "Подписка"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Подписка не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks/{webhookId} [get].
+func (r *webhookRoutes) getWebhookByID(c *gin.Context) {
+	subscription, err := r.w.GetWebhookByID(c.Request.Context(), principalFrom(c).Subject, c.Param("webhookId"))
+	if err != nil {
+		r.abortWithError(c, "getWebhookByID", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+// @Summary     Удаление подписки
+// @Description Вместе с подпиской удаляется журнал ее доставок.
+// @Tags  	    Webhooks
+// @Param webhookId path string true "ID подписки"
+// @Success     204 "Подписка удалена"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Подписка не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks/{webhookId} [delete].
+func (r *webhookRoutes) deleteWebhook(c *gin.Context) {
+	if err := r.w.DeleteWebhook(c.Request.Context(), principalFrom(c).Subject, c.Param("webhookId")); err != nil {
+		r.abortWithError(c, "deleteWebhook", err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary     Получение журнала доставок подписки
+// @Description Возвращает доставки от новых к старым.
+// @Tags  	    Webhooks
+// @Param webhookId path string true "ID подписки"
+// @Param status query string false "Статус доставки" Enums(pending, delivered, failed)
+// @Param limit query int false "Количество доставок, по умолчанию 50, не больше 100"
+// @Success     200 {array} entity.WebhookDelivery "Доставки"
+// @Failure     400 "Неверный фильтр"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Подписка не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks/{webhookId}/deliveries [get].
+func (r *webhookRoutes) getWebhookDeliveries(c *gin.Context) {
+	filter := entity.WebhookDeliveryFilter{
+		Status: c.Query("status"),
+	}
+
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		filter.Limit = limit
+	}
+
+	deliveries, err := r.w.GetWebhookDeliveries(c.Request.Context(), principalFrom(c).Subject, c.Param("webhookId"), filter)
+	if err != nil {
+		r.abortWithError(c, "getWebhookDeliveries", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// @Summary     Повторная отправка события
+// @Description Доставка отправляется снова с полным числом попыток, даже если она уже доставлена.
+// @Tags  	    Webhooks
+// @Param webhookId path string true "ID подписки"
+// @Param deliveryId path int true "ID доставки"
+// @Success     202 {object} entity.WebhookDelivery "Доставка запланирована"
+// @Failure     400 "Ошибка в пользовательском запросе"
+// @Failure     401 "Требуется аутентификация"
+// @Failure     403 "Недостаточно прав"
+// @Failure     404 "Подписка или доставка не найдена"
+// @Failure     429 "Слишком много запросов"
+// @Failure     500 "Не удалось выполнить запрос"
+// @Failure     504 "Время ожидания вышло"
+// @Security    ApiKeyAuth
+// @Security    BearerAuth
+// @Router      /webhooks/{webhookId}/deliveries/{deliveryId}/replay [post].
+func (r *webhookRoutes) replayWebhookDelivery(c *gin.Context) {
+	deliveryID, err := strconv.ParseInt(c.Param("deliveryId"), 10, 64)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	delivery, err := r.w.ReplayWebhookDelivery(c.Request.Context(), principalFrom(c).Subject, c.Param("webhookId"), deliveryID)
+	if err != nil {
+		r.abortWithError(c, "replayWebhookDelivery", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+func (r *webhookRoutes) abortWithError(c *gin.Context, operation string, err error) {
+	switch {
+	case errors.Is(err, entity.ErrWrongWebhook),
+		errors.Is(err, entity.ErrWrongWebhookDeliveryFilter):
+		c.AbortWithStatus(http.StatusBadRequest)
+	case errors.Is(err, entity.ErrWebhookNotFound),
+		errors.Is(err, entity.ErrWebhookDeliveryNotFound),
+		errors.Is(err, entity.ErrWalletNotFound):
+		c.AbortWithStatus(http.StatusNotFound)
+	case errors.Is(err, entity.ErrTimeout):
+		c.AbortWithStatus(http.StatusGatewayTimeout)
+	default:
+		r.l.Error("http - v1 - "+operation, sl.Err(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
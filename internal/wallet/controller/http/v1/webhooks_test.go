@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+func Test_createWebhook(t *testing.T) {
+	for _, test := range testsCreateWebhook {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			l := logger.SetupLogger("debug")
+			handler := webhookRoutes{
+				w: repo,
+				l: l,
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(test.principal))
+			r.POST("/webhooks", requireSubject(repo, l), handler.createWebhook)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(test.reqBody))
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsCreateWebhook = []struct {
+	name                 string
+	principal            *entity.Principal
+	reqBody              string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name:      "Ok, wallet of the caller",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"},
+		reqBody:   `{"url":"https://example.com/hooks","eventTypes":["wallet.frozen"],"walletId":"5b53700ed469fa6a09ea72bb78f36fd9"}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().GetWalletByID(gomock.Any(), "5b53700ed469fa6a09ea72bb78f36fd9").Return(&entity.Wallet{
+				ID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+				OwnerID: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+			}, nil)
+			r.EXPECT().CreateWebhook(context.Background(), &entity.WebhookSubscription{
+				Owner:      "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{entity.WebhookEventWalletFrozen},
+				WalletID:   "5b53700ed469fa6a09ea72bb78f36fd9",
+			}).Return(&entity.WebhookSubscription{
+				ID:         "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19",
+				Owner:      "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{entity.WebhookEventWalletFrozen},
+				WalletID:   "5b53700ed469fa6a09ea72bb78f36fd9",
+				Secret:     "whsec_1",
+			}, nil)
+		},
+		expectedStatusCode:   201,
+		expectedResponseBody: `{"id":"3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19","owner":"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21","url":"https://example.com/hooks","eventTypes":["wallet.frozen"],"walletId":"5b53700ed469fa6a09ea72bb78f36fd9","secret":"whsec_1","createdAt":"0001-01-01T00:00:00Z"}`,
+	},
+	{
+		name:      "All wallets without the permission",
+		principal: &entity.Principal{Subject: "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21", Permissions: []string{}},
+		reqBody:   `{"url":"https://example.com/hooks","eventTypes":["wallet.frozen"]}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil)
+		},
+		expectedStatusCode:   403,
+		expectedResponseBody: ``,
+	},
+	{
+		name:                 "Caller without the subject",
+		principal:            &entity.Principal{Scopes: []string{entity.ScopeAdmin}},
+		reqBody:              `{"url":"https://example.com/hooks","eventTypes":["wallet.frozen"]}`,
+		mockBehavior:         func(r *mock_usecase.MockWallet) { r.EXPECT().RecordAuditEvent(gomock.Any(), gomock.Any()).Return(nil) },
+		expectedStatusCode:   403,
+		expectedResponseBody: ``,
+	},
+	{
+		name:      "Wrong url",
+		principal: &entity.Principal{Subject: "operations", Scopes: []string{entity.ScopeAdmin}},
+		reqBody:   `{"url":"ftp://example.com/hooks","eventTypes":["wallet.frozen"]}`,
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().CreateWebhook(context.Background(), &entity.WebhookSubscription{
+				Owner:      "operations",
+				URL:        "ftp://example.com/hooks",
+				EventTypes: []string{entity.WebhookEventWalletFrozen},
+			}).Return(nil, entity.ErrWrongWebhook)
+		},
+		expectedStatusCode:   400,
+		expectedResponseBody: ``,
+	},
+	{
+		name:                 "Wrong body",
+		principal:            &entity.Principal{Subject: "operations", Scopes: []string{entity.ScopeAdmin}},
+		reqBody:              `{"url":`,
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: ``,
+	},
+}
+
+func Test_replayWebhookDelivery(t *testing.T) {
+	for _, test := range testsReplayWebhookDelivery {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWallet(c)
+			test.mockBehavior(repo)
+			handler := webhookRoutes{
+				w: repo,
+				l: logger.SetupLogger("debug"),
+			}
+			// Init Endpoint
+			r := gin.New()
+			r.Use(withPrincipal(&entity.Principal{Subject: "operations"}))
+			r.POST("/webhooks/:webhookId/deliveries/:deliveryId/replay", handler.replayWebhookDelivery)
+			// Create Request
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, test.path, nil)
+			// Make Request
+			r.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, w.Code, test.expectedStatusCode)
+			assert.Equal(t, w.Body.String(), test.expectedResponseBody)
+		})
+	}
+}
+
+var testsReplayWebhookDelivery = []struct {
+	name                 string
+	path                 string
+	mockBehavior         func(r *mock_usecase.MockWallet)
+	expectedStatusCode   int
+	expectedResponseBody string
+}{
+	{
+		name: "Ok",
+		path: "/webhooks/3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19/deliveries/42/replay",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().ReplayWebhookDelivery(context.Background(), "operations", "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19", int64(42)).
+				Return(&entity.WebhookDelivery{
+					ID:             42,
+					SubscriptionID: "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19",
+					EventID:        "6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e",
+					EventType:      entity.WebhookEventTransferCompleted,
+					Payload:        []byte(`{}`),
+					Status:         entity.WebhookDeliveryPending,
+				}, nil)
+		},
+		expectedStatusCode:   202,
+		expectedResponseBody: `{"id":42,"webhookId":"3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19","eventId":"6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e","eventType":"transfer.completed","payload":{},"status":"pending","attempts":0,"nextAttemptAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z","deliveredAt":"0001-01-01T00:00:00Z"}`,
+	},
+	{
+		name: "Delivery not found",
+		path: "/webhooks/3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19/deliveries/7/replay",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().ReplayWebhookDelivery(context.Background(), "operations", "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19", int64(7)).
+				Return(nil, entity.ErrWebhookDeliveryNotFound)
+		},
+		expectedStatusCode:   404,
+		expectedResponseBody: ``,
+	},
+	{
+		name:                 "Wrong delivery id",
+		path:                 "/webhooks/3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19/deliveries/abc/replay",
+		mockBehavior:         func(r *mock_usecase.MockWallet) {},
+		expectedStatusCode:   400,
+		expectedResponseBody: ``,
+	},
+	{
+		name: "Timeout",
+		path: "/webhooks/3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19/deliveries/42/replay",
+		mockBehavior: func(r *mock_usecase.MockWallet) {
+			r.EXPECT().ReplayWebhookDelivery(context.Background(), "operations", "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19", int64(42)).
+				Return(nil, entity.ErrTimeout)
+		},
+		expectedStatusCode:   504,
+		expectedResponseBody: ``,
+	},
+}
@@ -0,0 +1,42 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting the balance of the wallet at the moment, through remote call to rmq server.
+func (gw *WalletGateway) GetWalletBalanceAt(
+	ctx context.Context,
+	walletID string,
+	at time.Time,
+) (*entity.HistoricalBalance, error) {
+	var balance entity.HistoricalBalance
+
+	request := entity.WalletBalanceAtRequest{
+		WalletID: walletID,
+		At:       at,
+	}
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, "getWalletBalanceAt", request, &balance)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("WalletGateway - GetWalletBalanceAt - gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &balance, nil
+}
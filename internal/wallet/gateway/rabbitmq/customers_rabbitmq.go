@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating new customer, through remote call to rmq server.
+func (gw *WalletGateway) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	customer, err := gw.customerCall(ctx, "createCustomer", customer)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - CreateCustomer - gw.customerCall: %w", err)
+	}
+
+	return customer, nil
+}
+
+// Getting customer info by ID, through remote call to rmq server.
+func (gw *WalletGateway) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	request := entity.GetCustomerByIDRequest{
+		CustomerID: customerID,
+	}
+
+	customer, err := gw.customerCall(ctx, "getCustomerByID", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetCustomerByID - gw.customerCall: %w", err)
+	}
+
+	return customer, nil
+}
+
+// Getting all wallets of the customer, through remote call to rmq server.
+func (gw *WalletGateway) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	var wallets []entity.Wallet
+
+	request := entity.GetCustomerByIDRequest{
+		CustomerID: customerID,
+	}
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, "getCustomerWallets", request, &wallets)
+	})
+
+	if err != nil {
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("WalletGateway - GetCustomerWallets - gw.rmq.RemoteCall: %w", err)
+	}
+
+	return wallets, nil
+}
+
+// Calling the handler of rmq server, which responds with the customer.
+func (gw *WalletGateway) customerCall(
+	ctx context.Context,
+	handler string,
+	request interface{},
+) (*entity.Customer, error) {
+	var customer entity.Customer
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, &customer)
+	})
+
+	if err != nil {
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &customer, nil
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Depositing funds to the wallet from the funding provider, through remote call to rmq server.
+func (gw *WalletGateway) Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	request := entity.FundingRequest{
+		WalletID: walletID,
+		Amount:   amount,
+	}
+
+	transaction, err := gw.transactionCall(ctx, "deposit", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - Deposit - gw.transactionCall: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Withdrawing funds from the wallet to the funding provider, through remote call to rmq server.
+func (gw *WalletGateway) Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	request := entity.FundingRequest{
+		WalletID: walletID,
+		Amount:   amount,
+	}
+
+	transaction, err := gw.transactionCall(ctx, "withdraw", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - Withdraw - gw.transactionCall: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Calling the handler of rmq server, which responds with the transaction.
+func (gw *WalletGateway) transactionCall(
+	ctx context.Context,
+	handler string,
+	request interface{},
+) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, &transaction)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &transaction, nil
+}
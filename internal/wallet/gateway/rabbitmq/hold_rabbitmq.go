@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Reserving funds of the wallet, through remote call to rmq server.
+func (gw *WalletGateway) AuthorizeHold(
+	ctx context.Context,
+	walletID string,
+	amount entity.Money,
+	ttl time.Duration,
+) (*entity.Hold, error) {
+	request := entity.AuthorizeHoldRequest{
+		WalletID: walletID,
+		Amount:   amount,
+		TTL:      ttl,
+	}
+
+	hold, err := gw.holdCall(ctx, "authorizeHold", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - AuthorizeHold - gw.holdCall: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Capturing the hold, through remote call to rmq server.
+func (gw *WalletGateway) CaptureHold(
+	ctx context.Context,
+	holdID string,
+	to string,
+	amount entity.Money,
+) (*entity.Hold, error) {
+	request := entity.CaptureHoldRequest{
+		HoldID: holdID,
+		To:     to,
+		Amount: amount,
+	}
+
+	hold, err := gw.holdCall(ctx, "captureHold", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - CaptureHold - gw.holdCall: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Voiding the hold, through remote call to rmq server.
+func (gw *WalletGateway) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	request := entity.VoidHoldRequest{
+		HoldID: holdID,
+	}
+
+	hold, err := gw.holdCall(ctx, "voidHold", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - VoidHold - gw.holdCall: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Getting hold info by ID, through remote call to rmq server.
+func (gw *WalletGateway) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	request := entity.GetHoldByIDRequest{
+		HoldID: holdID,
+	}
+
+	hold, err := gw.holdCall(ctx, "getHoldByID", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetHoldByID - gw.holdCall: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Calling the hold handler of rmq server, which responds with the hold.
+func (gw *WalletGateway) holdCall(ctx context.Context, handler string, request interface{}) (*entity.Hold, error) {
+	var hold entity.Hold
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, &hold)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &hold, nil
+}
@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting the spending limits of the wallet, through remote call to rmq server.
+func (gw *WalletGateway) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	request := entity.WalletLimitsRequest{
+		WalletID: walletID,
+	}
+
+	limits, err := gw.limitsCall(ctx, "getWalletLimits", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetWalletLimits - gw.limitsCall: %w", err)
+	}
+
+	return limits, nil
+}
+
+// Setting the spending limits of the wallet, through remote call to rmq server.
+func (gw *WalletGateway) SetWalletLimits(
+	ctx context.Context,
+	limits *entity.SpendingLimits,
+) (*entity.SpendingLimits, error) {
+	limits, err := gw.limitsCall(ctx, "setWalletLimits", limits)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - SetWalletLimits - gw.limitsCall: %w", err)
+	}
+
+	return limits, nil
+}
+
+// Removing the spending limits of the wallet, through remote call to rmq server.
+func (gw *WalletGateway) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	request := entity.WalletLimitsRequest{
+		WalletID: walletID,
+	}
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, "deleteWalletLimits", request, nil)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return domainErr
+		}
+
+		return fmt.Errorf("WalletGateway - DeleteWalletLimits - gw.rmq.RemoteCall: %w", err)
+	}
+
+	return nil
+}
+
+// Calling the handler of rmq server, which responds with the spending limits.
+func (gw *WalletGateway) limitsCall(
+	ctx context.Context,
+	handler string,
+	request interface{},
+) (*entity.SpendingLimits, error) {
+	var limits entity.SpendingLimits
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, &limits)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &limits, nil
+}
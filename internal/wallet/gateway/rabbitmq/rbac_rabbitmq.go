@@ -0,0 +1,134 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting all roles, through remote call to rmq server.
+func (gw *WalletGateway) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	roles, err := gw.rolesCall(ctx, "getRoles", struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetRoles - gw.rolesCall: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Creating the role or replacing its permissions, through remote call to rmq server.
+func (gw *WalletGateway) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	var result entity.Role
+
+	err := gw.call(ctx, "setRole", role, &result)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - SetRole - gw.call: %w", err)
+	}
+
+	return &result, nil
+}
+
+// Deleting the role, through remote call to rmq server.
+func (gw *WalletGateway) DeleteRole(ctx context.Context, name string) error {
+	request := entity.RoleRequest{
+		Name: name,
+	}
+
+	if err := gw.call(ctx, "deleteRole", request, nil); err != nil {
+		return fmt.Errorf("WalletGateway - DeleteRole - gw.call: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the roles of the subject, through remote call to rmq server.
+func (gw *WalletGateway) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	request := entity.SubjectRequest{
+		Subject: subject,
+	}
+
+	roles, err := gw.rolesCall(ctx, "getSubjectRoles", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetSubjectRoles - gw.rolesCall: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Assigning the role to the subject, through remote call to rmq server.
+func (gw *WalletGateway) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	request := entity.RoleAssignmentRequest{
+		Subject: subject,
+		Role:    role,
+	}
+
+	roles, err := gw.rolesCall(ctx, "assignRole", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - AssignRole - gw.rolesCall: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Revoking the role from the subject, through remote call to rmq server.
+func (gw *WalletGateway) RevokeRole(ctx context.Context, subject, role string) error {
+	request := entity.RoleAssignmentRequest{
+		Subject: subject,
+		Role:    role,
+	}
+
+	if err := gw.call(ctx, "revokeRole", request, nil); err != nil {
+		return fmt.Errorf("WalletGateway - RevokeRole - gw.call: %w", err)
+	}
+
+	return nil
+}
+
+// Appending the event to the audit log, through remote call to rmq server.
+func (gw *WalletGateway) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	if err := gw.call(ctx, "recordAuditEvent", event, nil); err != nil {
+		return fmt.Errorf("WalletGateway - RecordAuditEvent - gw.call: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the page of the audit log, through remote call to rmq server.
+func (gw *WalletGateway) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	var page entity.AuditPage
+
+	if err := gw.call(ctx, "getAuditEvents", filter, &page); err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetAuditEvents - gw.call: %w", err)
+	}
+
+	return &page, nil
+}
+
+// Calling the handler of rmq server, which responds with the roles.
+func (gw *WalletGateway) rolesCall(ctx context.Context, handler string, request interface{}) ([]entity.Role, error) {
+	var roles []entity.Role
+
+	if err := gw.call(ctx, handler, request, &roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// Calling the handler of rmq server and restoring the domain error of the response.
+func (gw *WalletGateway) call(ctx context.Context, handler string, request, response interface{}) error {
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, response)
+	})
+
+	if err != nil {
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return domainErr
+		}
+
+		return fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Starting the reconciliation of the balances, through remote call to rmq server.
+func (gw *WalletGateway) StartReconciliation(ctx context.Context) error {
+	if err := gw.call(ctx, "startReconciliation", struct{}{}, nil); err != nil {
+		return fmt.Errorf("WalletGateway - StartReconciliation - gw.call: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the report of the last reconciliation, through remote call to rmq server.
+func (gw *WalletGateway) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	var report entity.ReconciliationReport
+
+	if err := gw.call(ctx, "getReconciliationReport", struct{}{}, &report); err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetReconciliationReport - gw.call: %w", err)
+	}
+
+	return &report, nil
+}
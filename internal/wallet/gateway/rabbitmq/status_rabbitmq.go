@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Freezing the wallet, through remote call to rmq server.
+func (gw *WalletGateway) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	request := entity.ChangeWalletStatusRequest{
+		WalletID: walletID,
+	}
+
+	wallet, err := gw.walletCall(ctx, "freezeWallet", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - FreezeWallet - gw.walletCall: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Unfreezing the wallet, through remote call to rmq server.
+func (gw *WalletGateway) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	request := entity.ChangeWalletStatusRequest{
+		WalletID: walletID,
+	}
+
+	wallet, err := gw.walletCall(ctx, "unfreezeWallet", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - UnfreezeWallet - gw.walletCall: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Closing the wallet with the sweep of its balance, through remote call to rmq server.
+func (gw *WalletGateway) CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error) {
+	request := entity.CloseWalletRequest{
+		WalletID: walletID,
+		To:       to,
+	}
+
+	wallet, err := gw.walletCall(ctx, "closeWallet", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - CloseWallet - gw.walletCall: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Calling the handler of rmq server, which responds with the wallet.
+func (gw *WalletGateway) walletCall(ctx context.Context, handler string, request interface{}) (*entity.Wallet, error) {
+	var wallet entity.Wallet
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, handler, request, &wallet)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &wallet, nil
+}
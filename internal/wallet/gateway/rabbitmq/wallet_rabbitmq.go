@@ -22,11 +22,20 @@ func New(rmq WalletGatewayRMQ) *WalletGateway {
 }
 
 // Creating new wallet with balance, through remote call to rmq server.
-func (gw *WalletGateway) CreateNewWalletWithBalance(ctx context.Context, balance uint) (*entity.Wallet, error) {
+func (gw *WalletGateway) CreateNewWalletWithBalance(
+	ctx context.Context,
+	balance entity.Money,
+	currency string,
+	walletType string,
+	ownerID string,
+) (*entity.Wallet, error) {
 	var wallet entity.Wallet
 
 	request := entity.CreateNewWalletWithBalanceRequest{
-		Balance: balance,
+		Balance:  balance,
+		Currency: currency,
+		Type:     walletType,
+		OwnerID:  ownerID,
 	}
 
 	err := wrapper(ctx, func() error {
@@ -34,6 +43,10 @@ func (gw *WalletGateway) CreateNewWalletWithBalance(ctx context.Context, balance
 	})
 
 	if err != nil {
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
 		return nil, fmt.Errorf("WalletGateway - CreateNewWalletWithBalance - gw.rmq.RemoteCall: %w", err)
 	}
 
@@ -41,38 +54,130 @@ func (gw *WalletGateway) CreateNewWalletWithBalance(ctx context.Context, balance
 }
 
 // Sending funds, through remote call to rmq server.
-func (gw *WalletGateway) SendFunds(ctx context.Context, from string, to string, amount uint) error {
+func (gw *WalletGateway) SendFunds(
+	ctx context.Context,
+	from string,
+	to string,
+	amount entity.Money,
+	details entity.TransferDetails,
+	idempotencyKey string,
+) (*entity.Transaction, error) {
+	var transaction entity.Transaction
+
 	request := entity.SendFundsRequest{
-		From:   from,
-		To:     to,
-		Amount: amount,
+		From:            from,
+		To:              to,
+		Amount:          amount,
+		IdempotencyKey:  idempotencyKey,
+		TransferDetails: details,
 	}
 
 	err := wrapper(ctx, func() error {
-		return gw.rmq.RemoteCall(ctx, "sendFunds", request, nil)
+		return gw.rmq.RemoteCall(ctx, "sendFunds", request, &transaction)
 	})
 
 	if err != nil {
 		if errors.Is(err, entity.ErrNotFound) {
-			return entity.ErrWalletNotFound
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
 		}
 
-		return fmt.Errorf("WalletGateway - SendFunds - gw.rmq.RemoteCall: %w", err)
+		return nil, fmt.Errorf("WalletGateway - SendFunds - gw.rmq.RemoteCall: %w", err)
 	}
 
-	return nil
+	return &transaction, nil
 }
 
-// Getting transactions history by wallet ID, through remote call to rmq server.
-func (gw *WalletGateway) GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error) {
+// Getting the transaction by its id, through remote call to rmq server.
+func (gw *WalletGateway) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	request := entity.GetTransactionByIDRequest{
+		TransactionID: transactionID,
+	}
+
+	transaction, err := gw.transactionCall(ctx, "getTransactionByID", request)
+	if err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetTransactionByID - gw.transactionCall: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Sending funds of all transfers of the batch as one unit, through remote call to rmq server.
+func (gw *WalletGateway) SendFundsBatch(
+	ctx context.Context,
+	legs []entity.TransferLeg,
+) ([]entity.Transaction, error) {
 	var transactions []entity.Transaction
 
+	request := entity.SendFundsBatchRequest{
+		Legs: legs,
+	}
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, "sendFundsBatch", request, &transactions)
+	})
+
+	if err != nil {
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("WalletGateway - SendFundsBatch - gw.rmq.RemoteCall: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// Refunding the transfer, through remote call to rmq server.
+func (gw *WalletGateway) RefundTransaction(
+	ctx context.Context,
+	transactionID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	var refund entity.Transaction
+
+	request := entity.RefundTransactionRequest{
+		TransactionID: transactionID,
+		Amount:        amount,
+	}
+
+	err := wrapper(ctx, func() error {
+		return gw.rmq.RemoteCall(ctx, "refundTransaction", request, &refund)
+	})
+
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, entity.ErrWalletNotFound
+		}
+
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
+		return nil, fmt.Errorf("WalletGateway - RefundTransaction - gw.rmq.RemoteCall: %w", err)
+	}
+
+	return &refund, nil
+}
+
+// Getting transactions history by wallet ID, through remote call to rmq server.
+func (gw *WalletGateway) GetWalletHistoryByID(
+	ctx context.Context,
+	walletID string,
+	filter entity.HistoryFilter,
+) (*entity.HistoryPage, error) {
+	var page entity.HistoryPage
+
 	request := entity.GetWalletHistoryByIDRequest{
 		WalletID: walletID,
+		Filter:   filter,
 	}
 
 	err := wrapper(ctx, func() error {
-		return gw.rmq.RemoteCall(ctx, "getWalletHistoryByID", request, &transactions)
+		return gw.rmq.RemoteCall(ctx, "getWalletHistoryByID", request, &page)
 	})
 
 	if err != nil {
@@ -80,10 +185,14 @@ func (gw *WalletGateway) GetWalletHistoryByID(ctx context.Context, walletID stri
 			return nil, entity.ErrWalletNotFound
 		}
 
+		if domainErr := entity.FromRemoteError(err); domainErr != nil {
+			return nil, domainErr
+		}
+
 		return nil, fmt.Errorf("WalletGateway - GetWalletHistoryByID - gw.rmq.RemoteCall: %w", err)
 	}
 
-	return transactions, nil
+	return &page, nil
 }
 
 // Getting wallet info by ID, through remote call to rmq server.
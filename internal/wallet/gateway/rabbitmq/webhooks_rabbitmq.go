@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating the webhook subscription, through remote call to rmq server.
+func (gw *WalletGateway) CreateWebhook(
+	ctx context.Context,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, error) {
+	var result entity.WebhookSubscription
+
+	if err := gw.call(ctx, "createWebhook", subscription, &result); err != nil {
+		return nil, fmt.Errorf("WalletGateway - CreateWebhook - gw.call: %w", err)
+	}
+
+	return &result, nil
+}
+
+// Getting the webhook subscriptions of the owner, through remote call to rmq server.
+func (gw *WalletGateway) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	request := entity.WebhookRequest{
+		Owner: owner,
+	}
+
+	var subscriptions []entity.WebhookSubscription
+
+	if err := gw.call(ctx, "getWebhooks", request, &subscriptions); err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetWebhooks - gw.call: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// Getting the webhook subscription of the owner, through remote call to rmq server.
+func (gw *WalletGateway) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	request := entity.WebhookRequest{
+		Owner:     owner,
+		WebhookID: webhookID,
+	}
+
+	var subscription entity.WebhookSubscription
+
+	if err := gw.call(ctx, "getWebhookByID", request, &subscription); err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetWebhookByID - gw.call: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// Deleting the webhook subscription of the owner, through remote call to rmq server.
+func (gw *WalletGateway) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	request := entity.WebhookRequest{
+		Owner:     owner,
+		WebhookID: webhookID,
+	}
+
+	if err := gw.call(ctx, "deleteWebhook", request, nil); err != nil {
+		return fmt.Errorf("WalletGateway - DeleteWebhook - gw.call: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the delivery log of the webhook subscription, through remote call to rmq server.
+func (gw *WalletGateway) GetWebhookDeliveries(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	filter entity.WebhookDeliveryFilter,
+) ([]entity.WebhookDelivery, error) {
+	request := entity.WebhookDeliveriesRequest{
+		Owner:     owner,
+		WebhookID: webhookID,
+		Filter:    filter,
+	}
+
+	var deliveries []entity.WebhookDelivery
+
+	if err := gw.call(ctx, "getWebhookDeliveries", request, &deliveries); err != nil {
+		return nil, fmt.Errorf("WalletGateway - GetWebhookDeliveries - gw.call: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// Sending the delivery of the webhook subscription once again, through remote call to rmq server.
+func (gw *WalletGateway) ReplayWebhookDelivery(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	deliveryID int64,
+) (*entity.WebhookDelivery, error) {
+	request := entity.ReplayWebhookDeliveryRequest{
+		Owner:      owner,
+		WebhookID:  webhookID,
+		DeliveryID: deliveryID,
+	}
+
+	var delivery entity.WebhookDelivery
+
+	if err := gw.call(ctx, "replayWebhookDelivery", request, &delivery); err != nil {
+		return nil, fmt.Errorf("WalletGateway - ReplayWebhookDelivery - gw.call: %w", err)
+	}
+
+	return &delivery, nil
+}
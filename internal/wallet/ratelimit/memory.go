@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const _sweepPeriod = time.Minute
+
+type memoryBucket struct {
+	bucket
+	fullAt time.Time
+}
+
+// MemoryStore - the buckets of the single replica.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	sweptAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*memoryBucket),
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{bucket: newBucket(limit, now)}
+		s.buckets[key] = b
+	}
+
+	wait := b.take(limit, now)
+	b.fullAt = b.bucket.fullAt(limit)
+
+	return wait, nil
+}
+
+// Forgetting the full buckets, because they are the same as the new ones.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < _sweepPeriod {
+		return
+	}
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+
+	s.sweptAt = now
+}
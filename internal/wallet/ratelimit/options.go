@@ -0,0 +1,24 @@
+package ratelimit
+
+type Option func(*Limiter)
+
+// Principal - the limit of the requests of the authenticated caller.
+func Principal(limit Limit) Option {
+	return func(l *Limiter) {
+		l.limits[KindPrincipal] = limit
+	}
+}
+
+// IP - the limit of the requests from the IP address.
+func IP(limit Limit) Option {
+	return func(l *Limiter) {
+		l.limits[KindIP] = limit
+	}
+}
+
+// Wallet - the limit of the transfers from the source wallet.
+func Wallet(limit Limit) Option {
+	return func(l *Limiter) {
+		l.limits[KindWallet] = limit
+	}
+}
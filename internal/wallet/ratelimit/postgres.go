@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+type postgresBucket struct {
+	tableName struct{} `pg:"rate_limit_buckets"` //nolint:unused // table name for go-pg
+
+	Key       string    `pg:"key,pk"`
+	Tokens    float64   `pg:"tokens,use_zero"`
+	UpdatedAt time.Time `pg:"updated_at"`
+}
+
+// PostgresStore - the buckets, which are shared by all replicas.
+type PostgresStore struct {
+	*postgres.Postgres
+}
+
+func NewPostgresStore(pg *postgres.Postgres) *PostgresStore {
+	return &PostgresStore{pg}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (time.Duration, error) {
+	var wait time.Duration
+
+	err := s.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		initial := newBucket(limit, now)
+		row := &postgresBucket{Key: key, Tokens: initial.tokens, UpdatedAt: initial.updated}
+
+		if _, err := tx.ModelContext(ctx, row).OnConflict("DO NOTHING").Insert(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+		// The bucket is locked until the token is taken, so the replicas take the tokens one by one
+		if err := tx.ModelContext(ctx, row).WherePK().For("UPDATE").Select(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		b := bucket{tokens: row.Tokens, updated: row.UpdatedAt}
+		wait = b.take(limit, now)
+		row.Tokens, row.UpdatedAt = b.tokens, b.updated
+
+		if _, err := tx.ModelContext(ctx, row).WherePK().Update(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("ratelimit - PostgresStore - Take - s.DB.RunInTransaction: %w", err)
+	}
+
+	return wait, nil
+}
+
+// Prune - removing the buckets, which are not used for the given time.
+// It must be longer than the time to refill any bucket, otherwise the callers get extra tokens.
+func (s *PostgresStore) Prune(ctx context.Context, idle time.Duration) error {
+	_, err := s.DB.ModelContext(ctx, new(postgresBucket)).
+		Where("updated_at < ?", time.Now().Add(-idle)).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("ratelimit - PostgresStore - Prune - s.DB: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package ratelimit implements limiting of the API requests by the token buckets,
+// which are kept in the memory or shared by the replicas through postgres.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Kind - the kind of the key, which the requests are limited by.
+type Kind string
+
+const (
+	KindPrincipal Kind = "principal"
+	KindIP        Kind = "ip"
+	KindWallet    Kind = "wallet"
+)
+
+// Limit - the rate of the bucket refill in the tokens per second and the capacity of the bucket.
+// The zero rate means, that the requests are not limited.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l Limit) IsValid() bool {
+	return l.Rate == 0 || (l.Rate > 0 && l.Burst > 0)
+}
+
+// Store - the storage of the buckets.
+type Store interface {
+	// Take - taking the token from the bucket of the key.
+	// Returns the time to wait for the next token, if the bucket is empty.
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (time.Duration, error)
+}
+
+type Limiter struct {
+	store  Store
+	limits map[Kind]Limit
+	now    func() time.Time
+}
+
+func New(store Store, opts ...Option) *Limiter {
+	l := &Limiter{
+		store:  store,
+		limits: make(map[Kind]Limit),
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// Allow - taking the token of the key. Returns the time to wait before the retry, if the request is over the limit.
+func (l *Limiter) Allow(ctx context.Context, kind Kind, key string) (time.Duration, error) {
+	limit := l.limits[kind]
+	if limit.Rate == 0 || key == "" {
+		return 0, nil
+	}
+
+	wait, err := l.store.Take(ctx, string(kind)+":"+key, limit, l.now())
+	if err != nil {
+		return 0, fmt.Errorf("ratelimit - Allow - l.store.Take: %w", err)
+	}
+
+	return wait, nil
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func newBucket(limit Limit, now time.Time) bucket {
+	return bucket{
+		tokens:  float64(limit.Burst),
+		updated: now,
+	}
+}
+
+// Refilling the bucket by the elapsed time and taking the token.
+// The time of the replicas can differ slightly, so the bucket is not refilled back in time.
+func (b *bucket) take(limit Limit, now time.Time) time.Duration {
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = min(float64(limit.Burst), b.tokens+elapsed.Seconds()*limit.Rate)
+		b.updated = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+}
+
+// Getting the time, when the bucket is full again and can be forgotten.
+func (b *bucket) fullAt(limit Limit) time.Time {
+	return b.updated.Add(time.Duration((float64(limit.Burst) - b.tokens) / limit.Rate * float64(time.Second)))
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_LimiterAllow(t *testing.T) {
+	start := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+	now := start
+
+	l := New(NewMemoryStore(), Wallet(Limit{Rate: 2, Burst: 2}))
+	l.now = func() time.Time { return now }
+
+	// The requests are made one by one, because they share the bucket
+	for _, test := range testsLimiterAllow {
+		now = start.Add(test.elapsed)
+
+		wait, err := l.Allow(context.Background(), test.kind, "eb376add88bf8e70f80787266a0801d5")
+
+		assert.Equal(t, err, nil, test.name)
+		assert.Equal(t, wait, test.expectedWait, test.name)
+	}
+}
+
+var testsLimiterAllow = []struct {
+	name         string
+	kind         Kind
+	elapsed      time.Duration
+	expectedWait time.Duration
+}{
+	{
+		name:         "Full bucket",
+		kind:         KindWallet,
+		expectedWait: 0,
+	},
+	{
+		name:         "Burst",
+		kind:         KindWallet,
+		expectedWait: 0,
+	},
+	{
+		name:         "Empty bucket",
+		kind:         KindWallet,
+		expectedWait: 500 * time.Millisecond,
+	},
+	{
+		name:         "Partly refilled bucket",
+		kind:         KindWallet,
+		elapsed:      250 * time.Millisecond,
+		expectedWait: 250 * time.Millisecond,
+	},
+	{
+		name:         "Refilled token",
+		kind:         KindWallet,
+		elapsed:      500 * time.Millisecond,
+		expectedWait: 0,
+	},
+	{
+		name:         "Not limited kind",
+		kind:         KindIP,
+		elapsed:      500 * time.Millisecond,
+		expectedWait: 0,
+	},
+	{
+		name:         "Bucket is not over the burst",
+		kind:         KindWallet,
+		elapsed:      time.Hour,
+		expectedWait: 0,
+	},
+}
@@ -0,0 +1,207 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+const (
+	_camtNamespace  = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+	_camtTimeLayout = "2006-01-02T15:04:05Z"
+	// Maximum length of the text fields in ISO 20022.
+	_camtIDLength   = 35
+	_camtTextLength = 140
+)
+
+type camtDocument struct {
+	XMLName   xml.Name      `xml:"Document"`
+	Namespace string        `xml:"xmlns,attr"`
+	Header    camtGroupHdr  `xml:"BkToCstmrStmt>GrpHdr"`
+	Statement camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtGroupHdr struct {
+	MessageID string `xml:"MsgId"`
+	Created   string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID       string        `xml:"Id"`
+	Created  string        `xml:"CreDtTm"`
+	From     string        `xml:"FrToDt>FrDtTm"`
+	To       string        `xml:"FrToDt>ToDtTm"`
+	Account  string        `xml:"Acct>Id>Othr>Id"`
+	Currency string        `xml:"Acct>Ccy"`
+	Balances []camtBalance `xml:"Bal"`
+	Summary  camtSummary   `xml:"TxsSummry"`
+	Entries  []camtEntry   `xml:"Ntry"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtBalance struct {
+	Type      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	Indicator string     `xml:"CdtDbtInd"`
+	Date      string     `xml:"Dt>DtTm"`
+}
+
+type camtTotals struct {
+	Count int    `xml:"NbOfNtries"`
+	Sum   string `xml:"Sum"`
+}
+
+type camtSummary struct {
+	Credit camtTotals `xml:"TtlCdtNtries"`
+	Debit  camtTotals `xml:"TtlDbtNtries"`
+}
+
+type camtEntry struct {
+	Reference string          `xml:"NtryRef"`
+	Amount    camtAmount      `xml:"Amt"`
+	Indicator string          `xml:"CdtDbtInd"`
+	Status    string          `xml:"Sts"`
+	Booked    string          `xml:"BookgDt>DtTm"`
+	Value     string          `xml:"ValDt>DtTm"`
+	Servicer  string          `xml:"AcctSvcrRef"`
+	Code      string          `xml:"BkTxCd>Prtry>Cd"`
+	Details   camtTransaction `xml:"NtryDtls>TxDtls"`
+}
+
+type camtTransaction struct {
+	EndToEndID     string       `xml:"Refs>EndToEndId"`
+	Debtor         *camtAccount `xml:"RltdPties>DbtrAcct,omitempty"`
+	Creditor       *camtAccount `xml:"RltdPties>CdtrAcct,omitempty"`
+	Remittance     string       `xml:"RmtInf>Ustrd,omitempty"`
+	AdditionalInfo string       `xml:"AddtlTxInf,omitempty"`
+}
+
+type camtAccount struct {
+	ID string `xml:"Id>Othr>Id"`
+}
+
+// WriteCAMT053 - writing the statement as ISO 20022 bank to customer statement camt.053.001.02.
+func WriteCAMT053(w io.Writer, s *entity.Statement) error {
+	// The time of the generation goes first to keep the identifier unique after the truncation
+	id := camtID(s.GeneratedAt.UTC().Format("20060102150405") + s.WalletID)
+	credit, debit, credits, debits := s.Totals()
+
+	document := camtDocument{
+		Namespace: _camtNamespace,
+		Header: camtGroupHdr{
+			MessageID: id,
+			Created:   camtTime(s.GeneratedAt),
+		},
+		Statement: camtStatement{
+			ID:       id,
+			Created:  camtTime(s.GeneratedAt),
+			From:     camtTime(s.From),
+			To:       camtTime(s.To),
+			Account:  s.WalletID,
+			Currency: s.Currency,
+			Balances: []camtBalance{
+				newCamtBalance("OPBD", s.OpeningBalance, s.Currency, s.From),
+				newCamtBalance("CLBD", s.ClosingBalance, s.Currency, s.To),
+			},
+			Summary: camtSummary{
+				Credit: camtTotals{Count: credits, Sum: credit.Format(s.Currency)},
+				Debit:  camtTotals{Count: debits, Sum: debit.Format(s.Currency)},
+			},
+			Entries: make([]camtEntry, 0, len(s.Entries)),
+		},
+	}
+
+	for i := range s.Entries {
+		document.Statement.Entries = append(document.Statement.Entries, newCamtEntry(&s.Entries[i], s.Currency))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("statement - WriteCAMT053 - io.WriteString: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("statement - WriteCAMT053 - encoder.Encode: %w", err)
+	}
+
+	return nil
+}
+
+// Creating the balance of the statement. Amounts in camt are unsigned, the sign is the indicator.
+func newCamtBalance(balanceType string, balance entity.Money, currency string, at time.Time) camtBalance {
+	indicator := "CRDT"
+	if balance < 0 {
+		indicator, balance = "DBIT", balance.Neg()
+	}
+
+	return camtBalance{
+		Type:      balanceType,
+		Amount:    camtAmount{Currency: currency, Value: balance.Format(currency)},
+		Indicator: indicator,
+		Date:      camtTime(at),
+	}
+}
+
+// Creating the entry of the statement with the counterparty on the other side.
+func newCamtEntry(entry *entity.StatementEntry, currency string) camtEntry {
+	reference := camtID(entry.TransactionID)
+
+	status := "BOOK"
+	if entry.Status == entity.TransactionStatusPending {
+		status = "PDNG"
+	}
+
+	details := camtTransaction{
+		EndToEndID:     "NOTPROVIDED",
+		Remittance:     truncate(entry.Description, _camtTextLength),
+		AdditionalInfo: entry.Reference,
+	}
+
+	indicator := "CRDT"
+	counterparty := &camtAccount{ID: truncate(entry.Counterparty, _camtIDLength)}
+
+	if entry.Direction == entity.EntryDirectionDebit {
+		indicator = "DBIT"
+		if entry.Counterparty != "" {
+			details.Creditor = counterparty
+		}
+	} else if entry.Counterparty != "" {
+		details.Debtor = counterparty
+	}
+
+	if len(entry.Reference) <= _camtIDLength && entry.Reference != "" {
+		details.EndToEndID = entry.Reference
+	}
+
+	return camtEntry{
+		Reference: reference,
+		Amount:    camtAmount{Currency: currency, Value: entry.Amount.Format(currency)},
+		Indicator: indicator,
+		Status:    status,
+		Booked:    camtTime(entry.Time),
+		Value:     camtTime(entry.Time),
+		Servicer:  reference,
+		Code:      entry.Type,
+		Details:   details,
+	}
+}
+
+// Formatting the time in UTC without the fractional seconds.
+func camtTime(t time.Time) string {
+	return t.UTC().Format(_camtTimeLayout)
+}
+
+// Making the identifier fit the ISO 20022 text of 35 characters. Hyphens of the uuids are dropped.
+func camtID(id string) string {
+	return truncate(strings.ReplaceAll(id, "-", ""), _camtIDLength)
+}
@@ -0,0 +1,51 @@
+package statement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+var _csvHeader = []string{
+	"time", "transaction_id", "type", "status", "counterparty", "description", "reference", "amount", "balance",
+}
+
+// WriteCSV - writing the statement as CSV. The opening and the closing balances are the first and the last rows,
+// amounts are signed decimal numbers in the major units of the currency.
+func WriteCSV(w io.Writer, s *entity.Statement) error {
+	writer := csv.NewWriter(w)
+
+	rows := make([][]string, 0, len(s.Entries)+3)
+	rows = append(rows, _csvHeader, balanceRow(s.From, "opening", s.OpeningBalance, s.Currency))
+
+	for i := range s.Entries {
+		entry := &s.Entries[i]
+		rows = append(rows, []string{
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.TransactionID,
+			entry.Type,
+			entry.Status,
+			entry.Counterparty,
+			entry.Description,
+			entry.Reference,
+			signedAmount(entry).Format(s.Currency),
+			entry.Balance.Format(s.Currency),
+		})
+	}
+
+	rows = append(rows, balanceRow(s.To, "closing", s.ClosingBalance, s.Currency))
+
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("statement - WriteCSV - writer.WriteAll: %w", err)
+	}
+
+	return nil
+}
+
+// Creating the row of the balance at the moment.
+func balanceRow(at time.Time, kind string, balance entity.Money, currency string) []string {
+	return []string{at.UTC().Format(time.RFC3339), "", kind, "", "", "", "", "", balance.Format(currency)}
+}
@@ -0,0 +1,151 @@
+package statement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+const (
+	_ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" +
+		`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+	_ofxTimeLayout = "20060102150405"
+	_ofxBankID     = "wallet-rielta"
+	// Maximum length of the text fields of the transaction in OFX.
+	_ofxNameLength = 32
+	_ofxMemoLength = 255
+)
+
+type ofxDocument struct {
+	XMLName xml.Name             `xml:"OFX"`
+	SignOn  ofxSignOn            `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxStatementResponse `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	Server   string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxStatementResponse struct {
+	TrnUID    string       `xml:"TRNUID"`
+	Status    ofxStatus    `xml:"STATUS"`
+	Statement ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	Currency     string           `xml:"CURDEF"`
+	BankID       string           `xml:"BANKACCTFROM>BANKID"`
+	AccountID    string           `xml:"BANKACCTFROM>ACCTID"`
+	AccountType  string           `xml:"BANKACCTFROM>ACCTTYPE"`
+	Start        string           `xml:"BANKTRANLIST>DTSTART"`
+	End          string           `xml:"BANKTRANLIST>DTEND"`
+	Transactions []ofxTransaction `xml:"BANKTRANLIST>STMTTRN"`
+	Balance      string           `xml:"LEDGERBAL>BALAMT"`
+	BalanceAsOf  string           `xml:"LEDGERBAL>DTASOF"`
+}
+
+type ofxTransaction struct {
+	Type   string `xml:"TRNTYPE"`
+	Posted string `xml:"DTPOSTED"`
+	Amount string `xml:"TRNAMT"`
+	FitID  string `xml:"FITID"`
+	Name   string `xml:"NAME,omitempty"`
+	Memo   string `xml:"MEMO,omitempty"`
+}
+
+// WriteOFX - writing the statement as OFX 2.2 bank statement.
+// OFX has no opening balance, so only the closing one is written as the ledger balance.
+func WriteOFX(w io.Writer, s *entity.Statement) error {
+	ok := ofxStatus{Code: 0, Severity: "INFO"}
+
+	document := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ok,
+			Server:   ofxTime(s.GeneratedAt),
+			Language: "ENG",
+		},
+		Bank: ofxStatementResponse{
+			TrnUID: s.WalletID,
+			Status: ok,
+			Statement: ofxStatement{
+				Currency:     s.Currency,
+				BankID:       _ofxBankID,
+				AccountID:    s.WalletID,
+				AccountType:  "CHECKING",
+				Start:        ofxTime(s.From),
+				End:          ofxTime(s.To),
+				Transactions: make([]ofxTransaction, 0, len(s.Entries)),
+				Balance:      s.ClosingBalance.Format(s.Currency),
+				BalanceAsOf:  ofxTime(s.To),
+			},
+		},
+	}
+
+	for i := range s.Entries {
+		entry := &s.Entries[i]
+
+		trnType := "CREDIT"
+		if entry.Direction == entity.EntryDirectionDebit {
+			trnType = "DEBIT"
+		}
+
+		document.Bank.Statement.Transactions = append(document.Bank.Statement.Transactions, ofxTransaction{
+			Type:   trnType,
+			Posted: ofxTime(entry.Time),
+			Amount: signedAmount(entry).Format(s.Currency),
+			FitID:  entry.TransactionID,
+			Name:   truncate(entry.Counterparty, _ofxNameLength),
+			Memo:   truncate(memo(entry), _ofxMemoLength),
+		})
+	}
+
+	if _, err := io.WriteString(w, _ofxHeader); err != nil {
+		return fmt.Errorf("statement - WriteOFX - io.WriteString: %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("statement - WriteOFX - encoder.Encode: %w", err)
+	}
+
+	return nil
+}
+
+// Formatting the time in the OFX format with the time zone.
+func ofxTime(t time.Time) string {
+	return t.UTC().Format(_ofxTimeLayout) + "[0:GMT]"
+}
+
+// Joining the description and the reference of the entry.
+func memo(entry *entity.StatementEntry) string {
+	switch {
+	case entry.Reference == "":
+		return entry.Description
+	case entry.Description == "":
+		return entry.Reference
+	default:
+		return entry.Description + " (" + entry.Reference + ")"
+	}
+}
+
+// Cutting the text to the maximum number of the characters.
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+
+	return string(runes[:length])
+}
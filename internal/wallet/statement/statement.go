@@ -0,0 +1,45 @@
+// Package statement - exporting the statements of the wallets to the formats of the accounting tools.
+package statement
+
+import (
+	"io"
+	"strings"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Formats of the statement export.
+const (
+	FormatCSV     = "csv"
+	FormatOFX     = "ofx"
+	FormatCAMT053 = "camt053"
+)
+
+// Format - the way the statement is written to the file.
+type Format struct {
+	ContentType string
+	Extension   string
+	Write       func(w io.Writer, s *entity.Statement) error
+}
+
+var _formats = map[string]Format{
+	FormatCSV:     {ContentType: "text/csv; charset=utf-8", Extension: "csv", Write: WriteCSV},
+	FormatOFX:     {ContentType: "application/x-ofx", Extension: "ofx", Write: WriteOFX},
+	FormatCAMT053: {ContentType: "application/xml", Extension: "xml", Write: WriteCAMT053},
+}
+
+// Getting the export format by its name.
+func Lookup(name string) (Format, bool) {
+	format, ok := _formats[strings.ToLower(name)]
+
+	return format, ok
+}
+
+// Getting the signed amount of the entry: credits are positive, debits are negative.
+func signedAmount(entry *entity.StatementEntry) entity.Money {
+	if entry.Direction == entity.EntryDirectionDebit {
+		return entry.Amount.Neg()
+	}
+
+	return entry.Amount
+}
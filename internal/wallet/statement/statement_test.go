@@ -0,0 +1,126 @@
+package statement
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+var testStatement = &entity.Statement{
+	WalletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+	Currency:       "USD",
+	From:           time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	To:             time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	GeneratedAt:    time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC),
+	OpeningBalance: 10000,
+	ClosingBalance: 7500,
+	Entries: []entity.StatementEntry{
+		{
+			TransactionID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			Time:          time.Date(2024, 3, 4, 17, 25, 35, 0, time.UTC),
+			Type:          entity.TransactionTypeTransfer,
+			Status:        entity.TransactionStatusCompleted,
+			Direction:     entity.EntryDirectionDebit,
+			Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+			Amount:        3000,
+			Balance:       7000,
+			Description:   "Rent, March",
+			Reference:     "INV-2024-0042",
+		},
+		{
+			TransactionID: "16fd2706-8baf-433b-82eb-8c7fada847da",
+			Time:          time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+			Type:          entity.TransactionTypeDeposit,
+			Status:        entity.TransactionStatusCompleted,
+			Direction:     entity.EntryDirectionCredit,
+			Counterparty:  "sandbox",
+			Amount:        500,
+			Balance:       7500,
+		},
+	},
+}
+
+func Test_Lookup(t *testing.T) {
+	for _, name := range []string{FormatCSV, FormatOFX, "CAMT053"} {
+		if _, ok := Lookup(name); !ok {
+			t.Errorf("expected format %s to be found", name)
+		}
+	}
+
+	_, ok := Lookup("pdf")
+	assert.Equal(t, ok, false)
+}
+
+func Test_WriteCSV(t *testing.T) {
+	var file bytes.Buffer
+
+	if err := WriteCSV(&file, testStatement); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	assert.Equal(t, file.String(),
+		"time,transaction_id,type,status,counterparty,description,reference,amount,balance\n"+
+			"2024-03-01T00:00:00Z,,opening,,,,,,100.00\n"+
+			"2024-03-04T17:25:35Z,7c9e6679-7425-40de-944b-e07fc1f90ae7,transfer,completed,"+
+			"eb376add88bf8e70f80787266a0801d5,\"Rent, March\",INV-2024-0042,-30.00,70.00\n"+
+			"2024-03-05T10:00:00Z,16fd2706-8baf-433b-82eb-8c7fada847da,deposit,completed,sandbox,,,5.00,75.00\n"+
+			"2024-04-01T00:00:00Z,,closing,,,,,,75.00\n")
+}
+
+func Test_WriteOFX(t *testing.T) {
+	var file bytes.Buffer
+
+	if err := WriteOFX(&file, testStatement); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	for _, expected := range []string{
+		`<?OFX OFXHEADER="200" VERSION="220"`,
+		"<CURDEF>USD</CURDEF>",
+		"<ACCTID>5b53700ed469fa6a09ea72bb78f36fd9</ACCTID>",
+		"<DTSTART>20240301000000[0:GMT]</DTSTART>",
+		"<TRNTYPE>DEBIT</TRNTYPE>",
+		"<TRNAMT>-30.00</TRNAMT>",
+		"<MEMO>Rent, March (INV-2024-0042)</MEMO>",
+		"<TRNTYPE>CREDIT</TRNTYPE>",
+		"<TRNAMT>5.00</TRNAMT>",
+		"<BALAMT>75.00</BALAMT>",
+	} {
+		if !strings.Contains(file.String(), expected) {
+			t.Errorf("expected %s in\n%s", expected, file.String())
+		}
+	}
+}
+
+func Test_WriteCAMT053(t *testing.T) {
+	var file bytes.Buffer
+
+	if err := WriteCAMT053(&file, testStatement); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	for _, expected := range []string{
+		`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">`,
+		"<MsgId>202404020900005b53700ed469fa6a09ea7</MsgId>",
+		"<Cd>OPBD</Cd>",
+		`<Amt Ccy="USD">100.00</Amt>`,
+		"<Cd>CLBD</Cd>",
+		`<Amt Ccy="USD">75.00</Amt>`,
+		"<NbOfNtries>1</NbOfNtries>",
+		"<Sum>5.00</Sum>",
+		"<Sum>30.00</Sum>",
+		"<NtryRef>7c9e6679742540de944be07fc1f90ae7</NtryRef>",
+		"<CdtDbtInd>DBIT</CdtDbtInd>",
+		"<EndToEndId>INV-2024-0042</EndToEndId>",
+		"<CdtrAcct>",
+		"<DbtrAcct>",
+	} {
+		if !strings.Contains(file.String(), expected) {
+			t.Errorf("expected %s in\n%s", expected, file.String())
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package stream
+
+type Option func(*Hub)
+
+// Buffer - the number of the events, which are kept for the slow client before its subscription is closed.
+func Buffer(size int) Option {
+	return func(h *Hub) {
+		h.buffer = size
+	}
+}
@@ -0,0 +1,161 @@
+// Package stream fans out the events of the wallets to the streams of the clients of the replica.
+package stream
+
+import (
+	"sync"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+const _defaultBuffer = 32
+
+// Hub - the local event bus of the replica. The events are received from the message broker
+// by every replica, so the client gets the events regardless of the replica it is connected to.
+type Hub struct {
+	mu            sync.RWMutex
+	subscriptions map[string]map[*Subscription]struct{}
+	buffer        int
+	closed        bool
+}
+
+// Subscription - the stream of the events of one wallet.
+// The subscription is closed, if the client does not read the events in time.
+type Subscription struct {
+	walletID string
+	events   chan entity.WalletEvent
+	closed   bool
+}
+
+func New(opts ...Option) *Hub {
+	h := &Hub{
+		subscriptions: make(map[string]map[*Subscription]struct{}),
+		buffer:        _defaultBuffer,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Subscribe - subscribing to the events of the wallet. The subscription must be cancelled by Unsubscribe.
+func (h *Hub) Subscribe(walletID string) *Subscription {
+	s := &Subscription{
+		walletID: walletID,
+		events:   make(chan entity.WalletEvent, h.buffer),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// The streams are not opened after the closing of the hub
+	if h.closed {
+		s.closed = true
+		close(s.events)
+
+		return s
+	}
+
+	if h.subscriptions[walletID] == nil {
+		h.subscriptions[walletID] = make(map[*Subscription]struct{})
+	}
+
+	h.subscriptions[walletID][s] = struct{}{}
+
+	return s
+}
+
+func (h *Hub) Unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(s)
+}
+
+// Publish - sending the event to the subscriptions of its wallet.
+// The subscription with the full buffer is closed instead of blocking the others.
+func (h *Hub) Publish(event entity.WalletEvent) {
+	h.mu.RLock()
+
+	var lagging []*Subscription
+
+	for s := range h.subscriptions[event.WalletID] {
+		select {
+		case s.events <- event:
+		default:
+			lagging = append(lagging, s)
+		}
+	}
+
+	h.mu.RUnlock()
+
+	if len(lagging) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, s := range lagging {
+		h.remove(s)
+	}
+}
+
+// HandleMessage - publishing the message of the wallet event from the message broker.
+// The messages with the unknown routing keys are skipped.
+func (h *Hub) HandleMessage(routingKey, messageID string, body []byte) {
+	walletID, event, ok := entity.ParseWalletRoutingKey(routingKey)
+	if !ok {
+		return
+	}
+
+	h.Publish(entity.WalletEvent{
+		ID:       messageID,
+		Type:     event,
+		WalletID: walletID,
+		Data:     body,
+	})
+}
+
+// Close - closing all subscriptions, so the streams of the clients are finished before the shutdown.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+
+	for _, subscriptions := range h.subscriptions {
+		for s := range subscriptions {
+			h.remove(s)
+		}
+	}
+}
+
+// Getting the number of the subscriptions of the wallet.
+func (h *Hub) Subscribers(walletID string) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.subscriptions[walletID])
+}
+
+// Removing the subscription and closing its events, the hub must be locked.
+func (h *Hub) remove(s *Subscription) {
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	close(s.events)
+
+	delete(h.subscriptions[s.walletID], s)
+
+	if len(h.subscriptions[s.walletID]) == 0 {
+		delete(h.subscriptions, s.walletID)
+	}
+}
+
+// Events - the events of the wallet, the channel is closed when the subscription is cancelled.
+func (s *Subscription) Events() <-chan entity.WalletEvent {
+	return s.events
+}
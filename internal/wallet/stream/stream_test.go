@@ -0,0 +1,69 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+const (
+	_walletID      = "5b53700ed469fa6a09ea72bb78f36fd9"
+	_otherWalletID = "eb376add88bf8e70f80787266a0801d5"
+)
+
+func Test_HandleMessage(t *testing.T) {
+	hub := New()
+
+	s := hub.Subscribe(_walletID)
+	other := hub.Subscribe(_otherWalletID)
+
+	defer hub.Unsubscribe(s)
+	defer hub.Unsubscribe(other)
+
+	hub.HandleMessage("wallet."+_walletID+".credited", "42", []byte(`{"amount":"100"}`))
+	hub.HandleMessage("customer."+_walletID+".created", "43", []byte(`{}`))
+
+	event := <-s.Events()
+
+	assert.Equal(t, event.ID, "42")
+	assert.Equal(t, event.Type, "credited")
+	assert.Equal(t, event.WalletID, _walletID)
+	assert.Equal(t, string(event.Data), `{"amount":"100"}`)
+	assert.Equal(t, len(s.Events()), 0)
+	assert.Equal(t, len(other.Events()), 0)
+}
+
+func Test_Publish_ClosesLaggingSubscription(t *testing.T) {
+	hub := New(Buffer(1))
+
+	s := hub.Subscribe(_walletID)
+
+	hub.HandleMessage("wallet."+_walletID+".credited", "1", []byte(`{}`))
+	hub.HandleMessage("wallet."+_walletID+".debited", "2", []byte(`{}`))
+
+	event, ok := <-s.Events()
+	assert.Equal(t, event.ID, "1")
+	assert.Equal(t, ok, true)
+
+	_, ok = <-s.Events()
+	assert.Equal(t, ok, false)
+	assert.Equal(t, hub.Subscribers(_walletID), 0)
+
+	// Cancelling of the closed subscription is safe
+	hub.Unsubscribe(s)
+}
+
+func Test_Close(t *testing.T) {
+	hub := New()
+
+	s := hub.Subscribe(_walletID)
+
+	hub.Close()
+
+	_, ok := <-s.Events()
+	assert.Equal(t, ok, false)
+
+	_, ok = <-hub.Subscribe(_otherWalletID).Events()
+	assert.Equal(t, ok, false)
+	assert.Equal(t, hub.Subscribers(_otherWalletID), 0)
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting the balance of the wallet at the moment in the past.
+func (uc *WalletUseCase) GetWalletBalanceAt(
+	ctx context.Context,
+	walletID string,
+	at time.Time,
+) (*entity.HistoricalBalance, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if at.IsZero() {
+		return nil, entity.ErrWrongBalanceTime
+	}
+
+	balance, err := uc.gateway.GetWalletBalanceAt(ctxTimeout, walletID, at)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWalletBalanceAt - uc.gateway.GetWalletBalanceAt: %w", err)
+	}
+
+	return balance, nil
+}
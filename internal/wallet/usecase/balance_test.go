@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_GetWalletBalanceAt(t *testing.T) {
+	for _, test := range testsGetWalletBalanceAt {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.walletID, test.at)
+
+			// Call function and check the result
+			balance, err := NewWallet(gateway).GetWalletBalanceAt(context.Background(), test.walletID, test.at)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, balance, test.expectedBalance)
+		})
+	}
+}
+
+var testsGetWalletBalanceAt = []struct {
+	name            string
+	mockBehavior    func(r *mock_usecase.MockWalletGateway, walletID string, at time.Time)
+	walletID        string
+	at              time.Time
+	expectedError   error
+	expectedBalance *entity.HistoricalBalance
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string, at time.Time) {
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, at).Return(&entity.HistoricalBalance{
+				WalletID: walletID,
+				At:       at,
+				Balance:  7500,
+				Currency: "USD",
+			}, nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		at:            time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC),
+		expectedError: nil,
+		expectedBalance: &entity.HistoricalBalance{
+			WalletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+			At:       time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC),
+			Balance:  7500,
+			Currency: "USD",
+		},
+	},
+	{
+		name:            "Moment is not set",
+		mockBehavior:    func(_ *mock_usecase.MockWalletGateway, _ string, _ time.Time) {},
+		walletID:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedError:   entity.ErrWrongBalanceTime,
+		expectedBalance: nil,
+	},
+	{
+		name: "Something went wrong",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string, at time.Time) {
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, at).Return(nil, errSomethingWentWrong)
+		},
+		walletID:        "5b53700ed469fa6a09ea72bb78f36fd9",
+		at:              time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC),
+		expectedError:   errSomethingWentWrong,
+		expectedBalance: nil,
+	},
+}
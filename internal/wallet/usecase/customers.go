@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating a new customer, who can own wallets.
+func (uc *WalletUseCase) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !customer.IsValid() {
+		return nil, entity.ErrWrongCustomer
+	}
+
+	customer, err := uc.gateway.CreateCustomer(ctxTimeout, customer)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - CreateCustomer - uc.gateway.CreateCustomer: %w", err)
+	}
+
+	return customer, nil
+}
+
+func (uc *WalletUseCase) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	customer, err := uc.gateway.GetCustomerByID(ctxTimeout, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetCustomerByID - uc.gateway.GetCustomerByID: %w", err)
+	}
+
+	return customer, nil
+}
+
+func (uc *WalletUseCase) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	wallets, err := uc.gateway.GetCustomerWallets(ctxTimeout, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetCustomerWallets - uc.gateway.GetCustomerWallets: %w", err)
+	}
+
+	return wallets, nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_CreateCustomer(t *testing.T) {
+	for _, test := range testsCreateCustomer {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.customer)
+
+			// Call function and check the result
+			customer, err := NewWallet(gateway).CreateCustomer(context.Background(), test.customer)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, customer, test.expectedCustomer)
+		})
+	}
+}
+
+var testsCreateCustomer = []struct {
+	name             string
+	customer         *entity.Customer
+	mockBehavior     func(r *mock_usecase.MockWalletGateway, customer *entity.Customer)
+	expectedError    error
+	expectedCustomer *entity.Customer
+}{
+	{
+		name: "Ok",
+		customer: &entity.Customer{
+			Type:  entity.CustomerTypeCompany,
+			Name:  "Acme",
+			Email: "billing@acme.com",
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, customer *entity.Customer) {
+			r.EXPECT().CreateCustomer(gomock.Any(), customer).Return(&entity.Customer{
+				ID:    "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+				Type:  entity.CustomerTypeCompany,
+				Name:  "Acme",
+				Email: "billing@acme.com",
+			}, nil)
+		},
+		expectedError: nil,
+		expectedCustomer: &entity.Customer{
+			ID:    "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+			Type:  entity.CustomerTypeCompany,
+			Name:  "Acme",
+			Email: "billing@acme.com",
+		},
+	},
+	{
+		name: "Wrong email",
+		customer: &entity.Customer{
+			Type:  entity.CustomerTypeIndividual,
+			Name:  "Ivan Petrov",
+			Email: "ivan",
+		},
+		mockBehavior:     func(_ *mock_usecase.MockWalletGateway, _ *entity.Customer) {},
+		expectedError:    entity.ErrWrongCustomer,
+		expectedCustomer: nil,
+	},
+	{
+		name: "Something went wrong",
+		customer: &entity.Customer{
+			Type: entity.CustomerTypeIndividual,
+			Name: "Ivan Petrov",
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, customer *entity.Customer) {
+			r.EXPECT().CreateCustomer(gomock.Any(), customer).Return(nil, errSomethingWentWrong)
+		},
+		expectedError:    errSomethingWentWrong,
+		expectedCustomer: nil,
+	},
+}
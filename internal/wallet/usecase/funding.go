@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Depositing funds to the wallet from the funding provider.
+// The deposit can stay pending, if the provider has not settled it yet.
+func (uc *WalletUseCase) Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := validateFunding(walletID, amount); err != nil {
+		return nil, err
+	}
+
+	transaction, err := uc.gateway.Deposit(ctxTimeout, walletID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - Deposit - uc.gateway.Deposit: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Withdrawing funds from the wallet to the funding provider.
+// The withdrawal can stay pending, if the provider has not settled it yet.
+func (uc *WalletUseCase) Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := validateFunding(walletID, amount); err != nil {
+		return nil, err
+	}
+
+	transaction, err := uc.gateway.Withdraw(ctxTimeout, walletID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - Withdraw - uc.gateway.Withdraw: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Checking the deposit or the withdrawal before the remote call.
+func validateFunding(walletID string, amount entity.Money) error {
+	if len(walletID) == 0 {
+		return entity.ErrEmptyWallet
+	}
+
+	if !amount.IsPositive() {
+		return entity.ErrWrongAmount
+	}
+
+	return nil
+}
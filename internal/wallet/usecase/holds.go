@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Reserving funds of the wallet. If the ttl is zero, then the default one of the worker is used.
+func (uc *WalletUseCase) AuthorizeHold(
+	ctx context.Context,
+	walletID string,
+	amount entity.Money,
+	ttl time.Duration,
+) (*entity.Hold, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if len(walletID) == 0 {
+		return nil, entity.ErrEmptyWallet
+	}
+
+	if !amount.IsPositive() {
+		return nil, entity.ErrWrongAmount
+	}
+
+	if ttl < 0 {
+		return nil, entity.ErrWrongHoldTTL
+	}
+
+	hold, err := uc.gateway.AuthorizeHold(ctxTimeout, walletID, amount, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - AuthorizeHold - uc.gateway.AuthorizeHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Capturing the hold to the receiver. If the amount is zero, then the whole hold is captured.
+func (uc *WalletUseCase) CaptureHold(
+	ctx context.Context,
+	holdID string,
+	to string,
+	amount entity.Money,
+) (*entity.Hold, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if len(to) == 0 {
+		return nil, entity.ErrEmptyWallet
+	}
+
+	if amount < 0 {
+		return nil, entity.ErrWrongAmount
+	}
+
+	hold, err := uc.gateway.CaptureHold(ctxTimeout, holdID, to, amount)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - CaptureHold - uc.gateway.CaptureHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+func (uc *WalletUseCase) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	hold, err := uc.gateway.VoidHold(ctxTimeout, holdID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - VoidHold - uc.gateway.VoidHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+func (uc *WalletUseCase) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	hold, err := uc.gateway.GetHoldByID(ctxTimeout, holdID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetHoldByID - uc.gateway.GetHoldByID: %w", err)
+	}
+
+	return hold, nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_AuthorizeHold(t *testing.T) {
+	for _, test := range testsAuthorizeHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway)
+
+			// Call function and check the result
+			hold, err := NewWallet(gateway).AuthorizeHold(context.Background(), test.walletID, test.amount, test.ttl)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, hold, test.expectedHold)
+		})
+	}
+}
+
+var testsAuthorizeHold = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletGateway)
+	walletID      string
+	amount        entity.Money
+	ttl           time.Duration
+	expectedError error
+	expectedHold  *entity.Hold
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().AuthorizeHold(gomock.Any(), "5b53700ed469fa6a09ea72bb78f36fd9", entity.Money(100), time.Hour).
+				Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Amount: 100}, nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		amount:        100,
+		ttl:           time.Hour,
+		expectedError: nil,
+		expectedHold:  &entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Amount: 100},
+	},
+	{
+		name:          "Empty wallet",
+		mockBehavior:  func(r *mock_usecase.MockWalletGateway) {},
+		walletID:      "",
+		amount:        100,
+		expectedError: entity.ErrEmptyWallet,
+		expectedHold:  nil,
+	},
+	{
+		name:          "Wrong amount",
+		mockBehavior:  func(r *mock_usecase.MockWalletGateway) {},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		amount:        0,
+		expectedError: entity.ErrWrongAmount,
+		expectedHold:  nil,
+	},
+	{
+		name:          "Negative ttl",
+		mockBehavior:  func(r *mock_usecase.MockWalletGateway) {},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		amount:        100,
+		ttl:           -time.Second,
+		expectedError: entity.ErrWrongHoldTTL,
+		expectedHold:  nil,
+	},
+	{
+		name: "Not enough funds",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().AuthorizeHold(gomock.Any(), "5b53700ed469fa6a09ea72bb78f36fd9", entity.Money(100), time.Duration(0)).
+				Return(nil, entity.ErrNotEnoughFunds)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		amount:        100,
+		expectedError: entity.ErrNotEnoughFunds,
+		expectedHold:  nil,
+	},
+}
+
+func Test_CaptureHold(t *testing.T) {
+	for _, test := range testsCaptureHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway)
+
+			// Call function and check the result
+			hold, err := NewWallet(gateway).CaptureHold(context.Background(), test.holdID, test.to, test.amount)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, hold, test.expectedHold)
+		})
+	}
+}
+
+var testsCaptureHold = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletGateway)
+	holdID        string
+	to            string
+	amount        entity.Money
+	expectedError error
+	expectedHold  *entity.Hold
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CaptureHold(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e", "eb376add88bf8e70f80787266a0801d5",
+				entity.Money(0)).Return(&entity.Hold{Status: entity.HoldStatusCaptured}, nil)
+		},
+		holdID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+		to:            "eb376add88bf8e70f80787266a0801d5",
+		expectedError: nil,
+		expectedHold:  &entity.Hold{Status: entity.HoldStatusCaptured},
+	},
+	{
+		name:          "Empty receiver",
+		mockBehavior:  func(r *mock_usecase.MockWalletGateway) {},
+		holdID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+		to:            "",
+		expectedError: entity.ErrEmptyWallet,
+		expectedHold:  nil,
+	},
+	{
+		name:          "Negative amount",
+		mockBehavior:  func(r *mock_usecase.MockWalletGateway) {},
+		holdID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+		to:            "eb376add88bf8e70f80787266a0801d5",
+		amount:        -1,
+		expectedError: entity.ErrWrongAmount,
+		expectedHold:  nil,
+	},
+	{
+		name: "Hold is not active",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CaptureHold(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e", "eb376add88bf8e70f80787266a0801d5",
+				entity.Money(0)).Return(nil, entity.ErrHoldNotActive)
+		},
+		holdID:        "0f8fad5b-d9cb-469f-a165-70867728950e",
+		to:            "eb376add88bf8e70f80787266a0801d5",
+		expectedError: entity.ErrHoldNotActive,
+		expectedHold:  nil,
+	},
+}
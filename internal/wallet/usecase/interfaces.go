@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/egor-denisov/wallet-rielta/internal/entity"
 )
@@ -10,16 +11,117 @@ import (
 
 type (
 	Wallet interface {
-		CreateNewWalletWithDefaultBalance(ctx context.Context) (*entity.Wallet, error)
-		SendFunds(ctx context.Context, from string, to string, amount uint) error
-		GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error)
+		CreateNewWalletWithDefaultBalance(ctx context.Context, currency, walletType, ownerID string) (*entity.Wallet, error)
+		SendFunds(
+			ctx context.Context,
+			from string,
+			to string,
+			amount entity.Money,
+			details entity.TransferDetails,
+			idempotencyKey string,
+		) (*entity.Transaction, error)
+		GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error)
+		SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error)
+		RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error)
+		Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error)
 		GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error)
+		GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error)
+		GetWalletStatement(ctx context.Context, walletID string, from, to time.Time) (*entity.Statement, error)
+		FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error)
+		GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error)
+		SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error)
+		DeleteWalletLimits(ctx context.Context, walletID string) error
+		AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error)
+		CaptureHold(ctx context.Context, holdID string, to string, amount entity.Money) (*entity.Hold, error)
+		VoidHold(ctx context.Context, holdID string) (*entity.Hold, error)
+		GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error)
+		CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
+		GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error)
+		GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error)
+		GetRoles(ctx context.Context) ([]entity.Role, error)
+		SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error)
+		DeleteRole(ctx context.Context, name string) error
+		GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error)
+		AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error)
+		RevokeRole(ctx context.Context, subject, role string) error
+		RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+		GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error)
+		CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error)
+		GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error)
+		DeleteWebhook(ctx context.Context, owner, webhookID string) error
+		GetWebhookDeliveries(
+			ctx context.Context,
+			owner string,
+			webhookID string,
+			filter entity.WebhookDeliveryFilter,
+		) ([]entity.WebhookDelivery, error)
+		ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error)
+		StartReconciliation(ctx context.Context) error
+		GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error)
 	}
 
 	WalletGateway interface {
-		CreateNewWalletWithBalance(ctx context.Context, balance uint) (*entity.Wallet, error)
-		SendFunds(ctx context.Context, from string, to string, amount uint) error
-		GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error)
+		CreateNewWalletWithBalance(
+			ctx context.Context,
+			balance entity.Money,
+			currency string,
+			walletType string,
+			ownerID string,
+		) (*entity.Wallet, error)
+		SendFunds(
+			ctx context.Context,
+			from string,
+			to string,
+			amount entity.Money,
+			details entity.TransferDetails,
+			idempotencyKey string,
+		) (*entity.Transaction, error)
+		GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error)
+		SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error)
+		RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error)
+		Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error)
 		GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error)
+		GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error)
+		FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error)
+		GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error)
+		SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error)
+		DeleteWalletLimits(ctx context.Context, walletID string) error
+		AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error)
+		CaptureHold(ctx context.Context, holdID string, to string, amount entity.Money) (*entity.Hold, error)
+		VoidHold(ctx context.Context, holdID string) (*entity.Hold, error)
+		GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error)
+		CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
+		GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error)
+		GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error)
+		GetRoles(ctx context.Context) ([]entity.Role, error)
+		SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error)
+		DeleteRole(ctx context.Context, name string) error
+		GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error)
+		AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error)
+		RevokeRole(ctx context.Context, subject, role string) error
+		RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+		GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error)
+		CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error)
+		GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error)
+		DeleteWebhook(ctx context.Context, owner, webhookID string) error
+		GetWebhookDeliveries(
+			ctx context.Context,
+			owner string,
+			webhookID string,
+			filter entity.WebhookDeliveryFilter,
+		) ([]entity.WebhookDelivery, error)
+		ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error)
+		StartReconciliation(ctx context.Context) error
+		GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error)
 	}
 )
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+func (uc *WalletUseCase) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	limits, err := uc.gateway.GetWalletLimits(ctxTimeout, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWalletLimits - uc.gateway.GetWalletLimits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// Setting the spending limits of the wallet. Zero limit means that the spending is not limited.
+func (uc *WalletUseCase) SetWalletLimits(
+	ctx context.Context,
+	limits *entity.SpendingLimits,
+) (*entity.SpendingLimits, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if len(limits.WalletID) == 0 {
+		return nil, entity.ErrEmptyWallet
+	}
+
+	if !limits.IsValid() {
+		return nil, entity.ErrWrongLimit
+	}
+
+	limits, err := uc.gateway.SetWalletLimits(ctxTimeout, limits)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - SetWalletLimits - uc.gateway.SetWalletLimits: %w", err)
+	}
+
+	return limits, nil
+}
+
+func (uc *WalletUseCase) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.DeleteWalletLimits(ctxTimeout, walletID); err != nil {
+		return fmt.Errorf("WalletUseCase - DeleteWalletLimits - uc.gateway.DeleteWalletLimits: %w", err)
+	}
+
+	return nil
+}
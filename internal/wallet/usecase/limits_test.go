@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SetWalletLimits(t *testing.T) {
+	for _, test := range testsSetWalletLimits {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.limits)
+
+			// Call function and check the result
+			limits, err := NewWallet(gateway).SetWalletLimits(context.Background(), test.limits)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, limits, test.expectedLimits)
+		})
+	}
+}
+
+var testsSetWalletLimits = []struct {
+	name           string
+	mockBehavior   func(r *mock_usecase.MockWalletGateway, limits *entity.SpendingLimits)
+	limits         *entity.SpendingLimits
+	expectedError  error
+	expectedLimits *entity.SpendingLimits
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, limits *entity.SpendingLimits) {
+			r.EXPECT().SetWalletLimits(gomock.Any(), limits).Return(limits, nil)
+		},
+		limits: &entity.SpendingLimits{
+			WalletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+			Daily:    20000,
+		},
+		expectedError: nil,
+		expectedLimits: &entity.SpendingLimits{
+			WalletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+			Daily:    20000,
+		},
+	},
+	{
+		name:         "Limits must be non-negative",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, limits *entity.SpendingLimits) {},
+		limits: &entity.SpendingLimits{
+			WalletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			PerTransaction: -1,
+		},
+		expectedError:  entity.ErrWrongLimit,
+		expectedLimits: nil,
+	},
+	{
+		name:         "Wallet must be non-empty",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, limits *entity.SpendingLimits) {},
+		limits: &entity.SpendingLimits{
+			Daily: 20000,
+		},
+		expectedError:  entity.ErrEmptyWallet,
+		expectedLimits: nil,
+	},
+	{
+		name: "Wallet not found",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, limits *entity.SpendingLimits) {
+			r.EXPECT().SetWalletLimits(gomock.Any(), limits).Return(nil, entity.ErrWalletNotFound)
+		},
+		limits: &entity.SpendingLimits{
+			WalletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+		},
+		expectedError:  entity.ErrWalletNotFound,
+		expectedLimits: nil,
+	},
+}
@@ -7,6 +7,7 @@ package mock_usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/egor-denisov/wallet-rielta/internal/entity"
 	gomock "github.com/golang/mock/gomock"
@@ -35,101 +36,933 @@ func (m *MockWallet) EXPECT() *MockWalletMockRecorder {
 	return m.recorder
 }
 
+// AssignRole mocks base method.
+func (m *MockWallet) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, subject, role)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockWalletMockRecorder) AssignRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockWallet)(nil).AssignRole), ctx, subject, role)
+}
+
+// AuthorizeHold mocks base method.
+func (m *MockWallet) AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeHold", ctx, walletID, amount, ttl)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeHold indicates an expected call of AuthorizeHold.
+func (mr *MockWalletMockRecorder) AuthorizeHold(ctx, walletID, amount, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeHold", reflect.TypeOf((*MockWallet)(nil).AuthorizeHold), ctx, walletID, amount, ttl)
+}
+
+// CaptureHold mocks base method.
+func (m *MockWallet) CaptureHold(ctx context.Context, holdID, to string, amount entity.Money) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, holdID, to, amount)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockWalletMockRecorder) CaptureHold(ctx, holdID, to, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockWallet)(nil).CaptureHold), ctx, holdID, to, amount)
+}
+
+// CloseWallet mocks base method.
+func (m *MockWallet) CloseWallet(ctx context.Context, walletID, to string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWallet", ctx, walletID, to)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseWallet indicates an expected call of CloseWallet.
+func (mr *MockWalletMockRecorder) CloseWallet(ctx, walletID, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWallet", reflect.TypeOf((*MockWallet)(nil).CloseWallet), ctx, walletID, to)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockWallet) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockWalletMockRecorder) CreateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockWallet)(nil).CreateCustomer), ctx, customer)
+}
+
 // CreateNewWalletWithDefaultBalance mocks base method.
-func (m *MockWallet) CreateNewWalletWithDefaultBalance(ctx context.Context) (*entity.Wallet, error) {
+func (m *MockWallet) CreateNewWalletWithDefaultBalance(ctx context.Context, currency, walletType, ownerID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewWalletWithDefaultBalance", ctx, currency, walletType, ownerID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewWalletWithDefaultBalance indicates an expected call of CreateNewWalletWithDefaultBalance.
+func (mr *MockWalletMockRecorder) CreateNewWalletWithDefaultBalance(ctx, currency, walletType, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWalletWithDefaultBalance", reflect.TypeOf((*MockWallet)(nil).CreateNewWalletWithDefaultBalance), ctx, currency, walletType, ownerID)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWallet) CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, subscription)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWalletMockRecorder) CreateWebhook(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWallet)(nil).CreateWebhook), ctx, subscription)
+}
+
+// DeleteRole mocks base method.
+func (m *MockWallet) DeleteRole(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockWalletMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockWallet)(nil).DeleteRole), ctx, name)
+}
+
+// DeleteWalletLimits mocks base method.
+func (m *MockWallet) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWalletLimits indicates an expected call of DeleteWalletLimits.
+func (mr *MockWalletMockRecorder) DeleteWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWalletLimits", reflect.TypeOf((*MockWallet)(nil).DeleteWalletLimits), ctx, walletID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWallet) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, owner, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWalletMockRecorder) DeleteWebhook(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWallet)(nil).DeleteWebhook), ctx, owner, webhookID)
+}
+
+// Deposit mocks base method.
+func (m *MockWallet) Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit.
+func (mr *MockWalletMockRecorder) Deposit(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockWallet)(nil).Deposit), ctx, walletID, amount)
+}
+
+// FreezeWallet mocks base method.
+func (m *MockWallet) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeWallet", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeWallet indicates an expected call of FreezeWallet.
+func (mr *MockWalletMockRecorder) FreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeWallet", reflect.TypeOf((*MockWallet)(nil).FreezeWallet), ctx, walletID)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockWallet) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, filter)
+	ret0, _ := ret[0].(*entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockWalletMockRecorder) GetAuditEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockWallet)(nil).GetAuditEvents), ctx, filter)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockWallet) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockWalletMockRecorder) GetCustomerByID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockWallet)(nil).GetCustomerByID), ctx, customerID)
+}
+
+// GetCustomerWallets mocks base method.
+func (m *MockWallet) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerWallets", ctx, customerID)
+	ret0, _ := ret[0].([]entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerWallets indicates an expected call of GetCustomerWallets.
+func (mr *MockWalletMockRecorder) GetCustomerWallets(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerWallets", reflect.TypeOf((*MockWallet)(nil).GetCustomerWallets), ctx, customerID)
+}
+
+// GetHoldByID mocks base method.
+func (m *MockWallet) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldByID", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldByID indicates an expected call of GetHoldByID.
+func (mr *MockWalletMockRecorder) GetHoldByID(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldByID", reflect.TypeOf((*MockWallet)(nil).GetHoldByID), ctx, holdID)
+}
+
+// GetReconciliationReport mocks base method.
+func (m *MockWallet) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliationReport", ctx)
+	ret0, _ := ret[0].(*entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliationReport indicates an expected call of GetReconciliationReport.
+func (mr *MockWalletMockRecorder) GetReconciliationReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationReport", reflect.TypeOf((*MockWallet)(nil).GetReconciliationReport), ctx)
+}
+
+// GetRoles mocks base method.
+func (m *MockWallet) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockWalletMockRecorder) GetRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockWallet)(nil).GetRoles), ctx)
+}
+
+// GetSubjectRoles mocks base method.
+func (m *MockWallet) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubjectRoles", ctx, subject)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubjectRoles indicates an expected call of GetSubjectRoles.
+func (mr *MockWalletMockRecorder) GetSubjectRoles(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubjectRoles", reflect.TypeOf((*MockWallet)(nil).GetSubjectRoles), ctx, subject)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockWallet) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockWalletMockRecorder) GetTransactionByID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockWallet)(nil).GetTransactionByID), ctx, transactionID)
+}
+
+// GetWalletBalanceAt mocks base method.
+func (m *MockWallet) GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletBalanceAt", ctx, walletID, at)
+	ret0, _ := ret[0].(*entity.HistoricalBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletBalanceAt indicates an expected call of GetWalletBalanceAt.
+func (mr *MockWalletMockRecorder) GetWalletBalanceAt(ctx, walletID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletBalanceAt", reflect.TypeOf((*MockWallet)(nil).GetWalletBalanceAt), ctx, walletID, at)
+}
+
+// GetWalletByID mocks base method.
+func (m *MockWallet) GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByID", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByID indicates an expected call of GetWalletByID.
+func (mr *MockWalletMockRecorder) GetWalletByID(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByID", reflect.TypeOf((*MockWallet)(nil).GetWalletByID), ctx, walletID)
+}
+
+// GetWalletHistoryByID mocks base method.
+func (m *MockWallet) GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID, filter)
+	ret0, _ := ret[0].(*entity.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletHistoryByID indicates an expected call of GetWalletHistoryByID.
+func (mr *MockWalletMockRecorder) GetWalletHistoryByID(ctx, walletID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWallet)(nil).GetWalletHistoryByID), ctx, walletID, filter)
+}
+
+// GetWalletLimits mocks base method.
+func (m *MockWallet) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletLimits indicates an expected call of GetWalletLimits.
+func (mr *MockWalletMockRecorder) GetWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletLimits", reflect.TypeOf((*MockWallet)(nil).GetWalletLimits), ctx, walletID)
+}
+
+// GetWalletStatement mocks base method.
+func (m *MockWallet) GetWalletStatement(ctx context.Context, walletID string, from, to time.Time) (*entity.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletStatement", ctx, walletID, from, to)
+	ret0, _ := ret[0].(*entity.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletStatement indicates an expected call of GetWalletStatement.
+func (mr *MockWalletMockRecorder) GetWalletStatement(ctx, walletID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletStatement", reflect.TypeOf((*MockWallet)(nil).GetWalletStatement), ctx, walletID, from, to)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWallet) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, owner, webhookID)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWalletMockRecorder) GetWebhookByID(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWallet)(nil).GetWebhookByID), ctx, owner, webhookID)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWallet) GetWebhookDeliveries(ctx context.Context, owner, webhookID string, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, owner, webhookID, filter)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWalletMockRecorder) GetWebhookDeliveries(ctx, owner, webhookID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWallet)(nil).GetWebhookDeliveries), ctx, owner, webhookID, filter)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWallet) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx, owner)
+	ret0, _ := ret[0].([]entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWalletMockRecorder) GetWebhooks(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWallet)(nil).GetWebhooks), ctx, owner)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockWallet) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockWalletMockRecorder) RecordAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockWallet)(nil).RecordAuditEvent), ctx, event)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockWallet) RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", ctx, transactionID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockWalletMockRecorder) RefundTransaction(ctx, transactionID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockWallet)(nil).RefundTransaction), ctx, transactionID, amount)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockWallet) ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, owner, webhookID, deliveryID)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockWalletMockRecorder) ReplayWebhookDelivery(ctx, owner, webhookID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockWallet)(nil).ReplayWebhookDelivery), ctx, owner, webhookID, deliveryID)
+}
+
+// RevokeRole mocks base method.
+func (m *MockWallet) RevokeRole(ctx context.Context, subject, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", ctx, subject, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockWalletMockRecorder) RevokeRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockWallet)(nil).RevokeRole), ctx, subject, role)
+}
+
+// SendFunds mocks base method.
+func (m *MockWallet) SendFunds(ctx context.Context, from, to string, amount entity.Money, details entity.TransferDetails, idempotencyKey string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFunds", ctx, from, to, amount, details, idempotencyKey)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFunds indicates an expected call of SendFunds.
+func (mr *MockWalletMockRecorder) SendFunds(ctx, from, to, amount, details, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWallet)(nil).SendFunds), ctx, from, to, amount, details, idempotencyKey)
+}
+
+// SendFundsBatch mocks base method.
+func (m *MockWallet) SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFundsBatch", ctx, legs)
+	ret0, _ := ret[0].([]entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFundsBatch indicates an expected call of SendFundsBatch.
+func (mr *MockWalletMockRecorder) SendFundsBatch(ctx, legs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFundsBatch", reflect.TypeOf((*MockWallet)(nil).SendFundsBatch), ctx, legs)
+}
+
+// SetRole mocks base method.
+func (m *MockWallet) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, role)
+	ret0, _ := ret[0].(*entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockWalletMockRecorder) SetRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockWallet)(nil).SetRole), ctx, role)
+}
+
+// SetWalletLimits mocks base method.
+func (m *MockWallet) SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletLimits", ctx, limits)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletLimits indicates an expected call of SetWalletLimits.
+func (mr *MockWalletMockRecorder) SetWalletLimits(ctx, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletLimits", reflect.TypeOf((*MockWallet)(nil).SetWalletLimits), ctx, limits)
+}
+
+// StartReconciliation mocks base method.
+func (m *MockWallet) StartReconciliation(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReconciliation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartReconciliation indicates an expected call of StartReconciliation.
+func (mr *MockWalletMockRecorder) StartReconciliation(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReconciliation", reflect.TypeOf((*MockWallet)(nil).StartReconciliation), ctx)
+}
+
+// UnfreezeWallet mocks base method.
+func (m *MockWallet) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeWallet", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeWallet indicates an expected call of UnfreezeWallet.
+func (mr *MockWalletMockRecorder) UnfreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeWallet", reflect.TypeOf((*MockWallet)(nil).UnfreezeWallet), ctx, walletID)
+}
+
+// VoidHold mocks base method.
+func (m *MockWallet) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockWalletMockRecorder) VoidHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockWallet)(nil).VoidHold), ctx, holdID)
+}
+
+// Withdraw mocks base method.
+func (m *MockWallet) Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockWalletMockRecorder) Withdraw(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockWallet)(nil).Withdraw), ctx, walletID, amount)
+}
+
+// MockWalletGateway is a mock of WalletGateway interface.
+type MockWalletGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletGatewayMockRecorder
+}
+
+// MockWalletGatewayMockRecorder is the mock recorder for MockWalletGateway.
+type MockWalletGatewayMockRecorder struct {
+	mock *MockWalletGateway
+}
+
+// NewMockWalletGateway creates a new mock instance.
+func NewMockWalletGateway(ctrl *gomock.Controller) *MockWalletGateway {
+	mock := &MockWalletGateway{ctrl: ctrl}
+	mock.recorder = &MockWalletGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletGateway) EXPECT() *MockWalletGatewayMockRecorder {
+	return m.recorder
+}
+
+// AssignRole mocks base method.
+func (m *MockWalletGateway) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, subject, role)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockWalletGatewayMockRecorder) AssignRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockWalletGateway)(nil).AssignRole), ctx, subject, role)
+}
+
+// AuthorizeHold mocks base method.
+func (m *MockWalletGateway) AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeHold", ctx, walletID, amount, ttl)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeHold indicates an expected call of AuthorizeHold.
+func (mr *MockWalletGatewayMockRecorder) AuthorizeHold(ctx, walletID, amount, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeHold", reflect.TypeOf((*MockWalletGateway)(nil).AuthorizeHold), ctx, walletID, amount, ttl)
+}
+
+// CaptureHold mocks base method.
+func (m *MockWalletGateway) CaptureHold(ctx context.Context, holdID, to string, amount entity.Money) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, holdID, to, amount)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockWalletGatewayMockRecorder) CaptureHold(ctx, holdID, to, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockWalletGateway)(nil).CaptureHold), ctx, holdID, to, amount)
+}
+
+// CloseWallet mocks base method.
+func (m *MockWalletGateway) CloseWallet(ctx context.Context, walletID, to string) (*entity.Wallet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNewWalletWithDefaultBalance", ctx)
+	ret := m.ctrl.Call(m, "CloseWallet", ctx, walletID, to)
 	ret0, _ := ret[0].(*entity.Wallet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateNewWalletWithDefaultBalance indicates an expected call of CreateNewWalletWithDefaultBalance.
-func (mr *MockWalletMockRecorder) CreateNewWalletWithDefaultBalance(ctx interface{}) *gomock.Call {
+// CloseWallet indicates an expected call of CloseWallet.
+func (mr *MockWalletGatewayMockRecorder) CloseWallet(ctx, walletID, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWalletWithDefaultBalance", reflect.TypeOf((*MockWallet)(nil).CreateNewWalletWithDefaultBalance), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWallet", reflect.TypeOf((*MockWalletGateway)(nil).CloseWallet), ctx, walletID, to)
 }
 
-// GetWalletByID mocks base method.
-func (m *MockWallet) GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error) {
+// CreateCustomer mocks base method.
+func (m *MockWalletGateway) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWalletByID", ctx, walletID)
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockWalletGatewayMockRecorder) CreateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockWalletGateway)(nil).CreateCustomer), ctx, customer)
+}
+
+// CreateNewWalletWithBalance mocks base method.
+func (m *MockWalletGateway) CreateNewWalletWithBalance(ctx context.Context, balance entity.Money, currency, walletType, ownerID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewWalletWithBalance", ctx, balance, currency, walletType, ownerID)
 	ret0, _ := ret[0].(*entity.Wallet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWalletByID indicates an expected call of GetWalletByID.
-func (mr *MockWalletMockRecorder) GetWalletByID(ctx, walletID interface{}) *gomock.Call {
+// CreateNewWalletWithBalance indicates an expected call of CreateNewWalletWithBalance.
+func (mr *MockWalletGatewayMockRecorder) CreateNewWalletWithBalance(ctx, balance, currency, walletType, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByID", reflect.TypeOf((*MockWallet)(nil).GetWalletByID), ctx, walletID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWalletWithBalance", reflect.TypeOf((*MockWalletGateway)(nil).CreateNewWalletWithBalance), ctx, balance, currency, walletType, ownerID)
 }
 
-// GetWalletHistoryByID mocks base method.
-func (m *MockWallet) GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error) {
+// CreateWebhook mocks base method.
+func (m *MockWalletGateway) CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID)
-	ret0, _ := ret[0].([]entity.Transaction)
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, subscription)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWalletHistoryByID indicates an expected call of GetWalletHistoryByID.
-func (mr *MockWalletMockRecorder) GetWalletHistoryByID(ctx, walletID interface{}) *gomock.Call {
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWalletGatewayMockRecorder) CreateWebhook(ctx, subscription interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWallet)(nil).GetWalletHistoryByID), ctx, walletID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWalletGateway)(nil).CreateWebhook), ctx, subscription)
 }
 
-// SendFunds mocks base method.
-func (m *MockWallet) SendFunds(ctx context.Context, from, to string, amount uint) error {
+// DeleteRole mocks base method.
+func (m *MockWalletGateway) DeleteRole(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendFunds", ctx, from, to, amount)
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SendFunds indicates an expected call of SendFunds.
-func (mr *MockWalletMockRecorder) SendFunds(ctx, from, to, amount interface{}) *gomock.Call {
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockWalletGatewayMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWallet)(nil).SendFunds), ctx, from, to, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockWalletGateway)(nil).DeleteRole), ctx, name)
 }
 
-// MockWalletGateway is a mock of WalletGateway interface.
-type MockWalletGateway struct {
-	ctrl     *gomock.Controller
-	recorder *MockWalletGatewayMockRecorder
+// DeleteWalletLimits mocks base method.
+func (m *MockWalletGateway) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockWalletGatewayMockRecorder is the mock recorder for MockWalletGateway.
-type MockWalletGatewayMockRecorder struct {
-	mock *MockWalletGateway
+// DeleteWalletLimits indicates an expected call of DeleteWalletLimits.
+func (mr *MockWalletGatewayMockRecorder) DeleteWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWalletLimits", reflect.TypeOf((*MockWalletGateway)(nil).DeleteWalletLimits), ctx, walletID)
 }
 
-// NewMockWalletGateway creates a new mock instance.
-func NewMockWalletGateway(ctrl *gomock.Controller) *MockWalletGateway {
-	mock := &MockWalletGateway{ctrl: ctrl}
-	mock.recorder = &MockWalletGatewayMockRecorder{mock}
-	return mock
+// DeleteWebhook mocks base method.
+func (m *MockWalletGateway) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, owner, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockWalletGateway) EXPECT() *MockWalletGatewayMockRecorder {
-	return m.recorder
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWalletGatewayMockRecorder) DeleteWebhook(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWalletGateway)(nil).DeleteWebhook), ctx, owner, webhookID)
 }
 
-// CreateNewWalletWithBalance mocks base method.
-func (m *MockWalletGateway) CreateNewWalletWithBalance(ctx context.Context, balance uint) (*entity.Wallet, error) {
+// Deposit mocks base method.
+func (m *MockWalletGateway) Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit.
+func (mr *MockWalletGatewayMockRecorder) Deposit(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockWalletGateway)(nil).Deposit), ctx, walletID, amount)
+}
+
+// FreezeWallet mocks base method.
+func (m *MockWalletGateway) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNewWalletWithBalance", ctx, balance)
+	ret := m.ctrl.Call(m, "FreezeWallet", ctx, walletID)
 	ret0, _ := ret[0].(*entity.Wallet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateNewWalletWithBalance indicates an expected call of CreateNewWalletWithBalance.
-func (mr *MockWalletGatewayMockRecorder) CreateNewWalletWithBalance(ctx, balance interface{}) *gomock.Call {
+// FreezeWallet indicates an expected call of FreezeWallet.
+func (mr *MockWalletGatewayMockRecorder) FreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeWallet", reflect.TypeOf((*MockWalletGateway)(nil).FreezeWallet), ctx, walletID)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockWalletGateway) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, filter)
+	ret0, _ := ret[0].(*entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockWalletGatewayMockRecorder) GetAuditEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockWalletGateway)(nil).GetAuditEvents), ctx, filter)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockWalletGateway) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockWalletGatewayMockRecorder) GetCustomerByID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockWalletGateway)(nil).GetCustomerByID), ctx, customerID)
+}
+
+// GetCustomerWallets mocks base method.
+func (m *MockWalletGateway) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerWallets", ctx, customerID)
+	ret0, _ := ret[0].([]entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerWallets indicates an expected call of GetCustomerWallets.
+func (mr *MockWalletGatewayMockRecorder) GetCustomerWallets(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerWallets", reflect.TypeOf((*MockWalletGateway)(nil).GetCustomerWallets), ctx, customerID)
+}
+
+// GetHoldByID mocks base method.
+func (m *MockWalletGateway) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldByID", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldByID indicates an expected call of GetHoldByID.
+func (mr *MockWalletGatewayMockRecorder) GetHoldByID(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldByID", reflect.TypeOf((*MockWalletGateway)(nil).GetHoldByID), ctx, holdID)
+}
+
+// GetReconciliationReport mocks base method.
+func (m *MockWalletGateway) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliationReport", ctx)
+	ret0, _ := ret[0].(*entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliationReport indicates an expected call of GetReconciliationReport.
+func (mr *MockWalletGatewayMockRecorder) GetReconciliationReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationReport", reflect.TypeOf((*MockWalletGateway)(nil).GetReconciliationReport), ctx)
+}
+
+// GetRoles mocks base method.
+func (m *MockWalletGateway) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockWalletGatewayMockRecorder) GetRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockWalletGateway)(nil).GetRoles), ctx)
+}
+
+// GetSubjectRoles mocks base method.
+func (m *MockWalletGateway) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubjectRoles", ctx, subject)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubjectRoles indicates an expected call of GetSubjectRoles.
+func (mr *MockWalletGatewayMockRecorder) GetSubjectRoles(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubjectRoles", reflect.TypeOf((*MockWalletGateway)(nil).GetSubjectRoles), ctx, subject)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockWalletGateway) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockWalletGatewayMockRecorder) GetTransactionByID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockWalletGateway)(nil).GetTransactionByID), ctx, transactionID)
+}
+
+// GetWalletBalanceAt mocks base method.
+func (m *MockWalletGateway) GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletBalanceAt", ctx, walletID, at)
+	ret0, _ := ret[0].(*entity.HistoricalBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletBalanceAt indicates an expected call of GetWalletBalanceAt.
+func (mr *MockWalletGatewayMockRecorder) GetWalletBalanceAt(ctx, walletID, at interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWalletWithBalance", reflect.TypeOf((*MockWalletGateway)(nil).CreateNewWalletWithBalance), ctx, balance)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletBalanceAt", reflect.TypeOf((*MockWalletGateway)(nil).GetWalletBalanceAt), ctx, walletID, at)
 }
 
 // GetWalletByID mocks base method.
@@ -148,30 +981,253 @@ func (mr *MockWalletGatewayMockRecorder) GetWalletByID(ctx, walletID interface{}
 }
 
 // GetWalletHistoryByID mocks base method.
-func (m *MockWalletGateway) GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error) {
+func (m *MockWalletGateway) GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID)
-	ret0, _ := ret[0].([]entity.Transaction)
+	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID, filter)
+	ret0, _ := ret[0].(*entity.HistoryPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetWalletHistoryByID indicates an expected call of GetWalletHistoryByID.
-func (mr *MockWalletGatewayMockRecorder) GetWalletHistoryByID(ctx, walletID interface{}) *gomock.Call {
+func (mr *MockWalletGatewayMockRecorder) GetWalletHistoryByID(ctx, walletID, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWalletGateway)(nil).GetWalletHistoryByID), ctx, walletID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWalletGateway)(nil).GetWalletHistoryByID), ctx, walletID, filter)
 }
 
-// SendFunds mocks base method.
-func (m *MockWalletGateway) SendFunds(ctx context.Context, from, to string, amount uint) error {
+// GetWalletLimits mocks base method.
+func (m *MockWalletGateway) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletLimits indicates an expected call of GetWalletLimits.
+func (mr *MockWalletGatewayMockRecorder) GetWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletLimits", reflect.TypeOf((*MockWalletGateway)(nil).GetWalletLimits), ctx, walletID)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWalletGateway) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, owner, webhookID)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWalletGatewayMockRecorder) GetWebhookByID(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWalletGateway)(nil).GetWebhookByID), ctx, owner, webhookID)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWalletGateway) GetWebhookDeliveries(ctx context.Context, owner, webhookID string, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, owner, webhookID, filter)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWalletGatewayMockRecorder) GetWebhookDeliveries(ctx, owner, webhookID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWalletGateway)(nil).GetWebhookDeliveries), ctx, owner, webhookID, filter)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWalletGateway) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx, owner)
+	ret0, _ := ret[0].([]entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWalletGatewayMockRecorder) GetWebhooks(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWalletGateway)(nil).GetWebhooks), ctx, owner)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockWalletGateway) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockWalletGatewayMockRecorder) RecordAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockWalletGateway)(nil).RecordAuditEvent), ctx, event)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockWalletGateway) RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", ctx, transactionID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockWalletGatewayMockRecorder) RefundTransaction(ctx, transactionID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockWalletGateway)(nil).RefundTransaction), ctx, transactionID, amount)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockWalletGateway) ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, owner, webhookID, deliveryID)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockWalletGatewayMockRecorder) ReplayWebhookDelivery(ctx, owner, webhookID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockWalletGateway)(nil).ReplayWebhookDelivery), ctx, owner, webhookID, deliveryID)
+}
+
+// RevokeRole mocks base method.
+func (m *MockWalletGateway) RevokeRole(ctx context.Context, subject, role string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendFunds", ctx, from, to, amount)
+	ret := m.ctrl.Call(m, "RevokeRole", ctx, subject, role)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockWalletGatewayMockRecorder) RevokeRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockWalletGateway)(nil).RevokeRole), ctx, subject, role)
+}
+
+// SendFunds mocks base method.
+func (m *MockWalletGateway) SendFunds(ctx context.Context, from, to string, amount entity.Money, details entity.TransferDetails, idempotencyKey string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFunds", ctx, from, to, amount, details, idempotencyKey)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // SendFunds indicates an expected call of SendFunds.
-func (mr *MockWalletGatewayMockRecorder) SendFunds(ctx, from, to, amount interface{}) *gomock.Call {
+func (mr *MockWalletGatewayMockRecorder) SendFunds(ctx, from, to, amount, details, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWalletGateway)(nil).SendFunds), ctx, from, to, amount, details, idempotencyKey)
+}
+
+// SendFundsBatch mocks base method.
+func (m *MockWalletGateway) SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFundsBatch", ctx, legs)
+	ret0, _ := ret[0].([]entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFundsBatch indicates an expected call of SendFundsBatch.
+func (mr *MockWalletGatewayMockRecorder) SendFundsBatch(ctx, legs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFundsBatch", reflect.TypeOf((*MockWalletGateway)(nil).SendFundsBatch), ctx, legs)
+}
+
+// SetRole mocks base method.
+func (m *MockWalletGateway) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, role)
+	ret0, _ := ret[0].(*entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockWalletGatewayMockRecorder) SetRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockWalletGateway)(nil).SetRole), ctx, role)
+}
+
+// SetWalletLimits mocks base method.
+func (m *MockWalletGateway) SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletLimits", ctx, limits)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletLimits indicates an expected call of SetWalletLimits.
+func (mr *MockWalletGatewayMockRecorder) SetWalletLimits(ctx, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletLimits", reflect.TypeOf((*MockWalletGateway)(nil).SetWalletLimits), ctx, limits)
+}
+
+// StartReconciliation mocks base method.
+func (m *MockWalletGateway) StartReconciliation(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReconciliation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartReconciliation indicates an expected call of StartReconciliation.
+func (mr *MockWalletGatewayMockRecorder) StartReconciliation(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReconciliation", reflect.TypeOf((*MockWalletGateway)(nil).StartReconciliation), ctx)
+}
+
+// UnfreezeWallet mocks base method.
+func (m *MockWalletGateway) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeWallet", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeWallet indicates an expected call of UnfreezeWallet.
+func (mr *MockWalletGatewayMockRecorder) UnfreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeWallet", reflect.TypeOf((*MockWalletGateway)(nil).UnfreezeWallet), ctx, walletID)
+}
+
+// VoidHold mocks base method.
+func (m *MockWalletGateway) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockWalletGatewayMockRecorder) VoidHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockWalletGateway)(nil).VoidHold), ctx, holdID)
+}
+
+// Withdraw mocks base method.
+func (m *MockWalletGateway) Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockWalletGatewayMockRecorder) Withdraw(ctx, walletID, amount interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWalletGateway)(nil).SendFunds), ctx, from, to, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockWalletGateway)(nil).Withdraw), ctx, walletID, amount)
 }
@@ -1,6 +1,10 @@
 package usecase
 
-import "time"
+import (
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
 
 type Option func(*WalletUseCase)
 
@@ -10,8 +14,14 @@ func Timeout(timeout time.Duration) Option {
 	}
 }
 
-func DefaultBalance(balance uint) Option {
+func DefaultBalance(balance entity.Money) Option {
 	return func(uc *WalletUseCase) {
 		uc.defaultBalance = balance
 	}
 }
+
+func DefaultCurrency(currency string) Option {
+	return func(uc *WalletUseCase) {
+		uc.defaultCurrency = currency
+	}
+}
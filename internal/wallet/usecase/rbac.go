@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+func (uc *WalletUseCase) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	roles, err := uc.gateway.GetRoles(ctxTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetRoles - uc.gateway.GetRoles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Creating the role or replacing its permissions.
+func (uc *WalletUseCase) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !role.IsValid() {
+		return nil, entity.ErrWrongRole
+	}
+
+	role, err := uc.gateway.SetRole(ctxTimeout, role)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - SetRole - uc.gateway.SetRole: %w", err)
+	}
+
+	return role, nil
+}
+
+func (uc *WalletUseCase) DeleteRole(ctx context.Context, name string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.DeleteRole(ctxTimeout, name); err != nil {
+		return fmt.Errorf("WalletUseCase - DeleteRole - uc.gateway.DeleteRole: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *WalletUseCase) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	roles, err := uc.gateway.GetSubjectRoles(ctxTimeout, subject)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetSubjectRoles - uc.gateway.GetSubjectRoles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Assigning the role to the subject. Returns all roles of the subject.
+func (uc *WalletUseCase) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	roles, err := uc.gateway.AssignRole(ctxTimeout, subject, role)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - AssignRole - uc.gateway.AssignRole: %w", err)
+	}
+
+	return roles, nil
+}
+
+func (uc *WalletUseCase) RevokeRole(ctx context.Context, subject, role string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.RevokeRole(ctxTimeout, subject, role); err != nil {
+		return fmt.Errorf("WalletUseCase - RevokeRole - uc.gateway.RevokeRole: %w", err)
+	}
+
+	return nil
+}
+
+// Appending the event to the audit log.
+func (uc *WalletUseCase) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.RecordAuditEvent(ctxTimeout, event); err != nil {
+		return fmt.Errorf("WalletUseCase - RecordAuditEvent - uc.gateway.RecordAuditEvent: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the page of the audit log by the filter.
+func (uc *WalletUseCase) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongAuditFilter
+	}
+
+	page, err := uc.gateway.GetAuditEvents(ctxTimeout, filter)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetAuditEvents - uc.gateway.GetAuditEvents: %w", err)
+	}
+
+	return page, nil
+}
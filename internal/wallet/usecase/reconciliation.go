@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Starting the reconciliation of the balances on the worker. It is finished in the background.
+func (uc *WalletUseCase) StartReconciliation(ctx context.Context) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.StartReconciliation(ctxTimeout); err != nil {
+		return fmt.Errorf("WalletUseCase - StartReconciliation - uc.gateway.StartReconciliation: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *WalletUseCase) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	report, err := uc.gateway.GetReconciliationReport(ctxTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetReconciliationReport - uc.gateway.GetReconciliationReport: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_GetReconciliationReport(t *testing.T) {
+	for _, test := range testsGetReconciliationReport {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway)
+
+			// Call function and check the result
+			report, err := NewWallet(gateway).GetReconciliationReport(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, report, test.expectedReport)
+		})
+	}
+}
+
+var testsGetReconciliationReport = []struct {
+	name           string
+	mockBehavior   func(r *mock_usecase.MockWalletGateway)
+	expectedError  error
+	expectedReport *entity.ReconciliationReport
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().GetReconciliationReport(gomock.Any()).Return(&entity.ReconciliationReport{
+				ID:             7,
+				WalletsChecked: 10,
+				Drifts:         []entity.WalletDrift{},
+			}, nil)
+		},
+		expectedError: nil,
+		expectedReport: &entity.ReconciliationReport{
+			ID:             7,
+			WalletsChecked: 10,
+			Drifts:         []entity.WalletDrift{},
+		},
+	},
+	{
+		name: "Not found",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().GetReconciliationReport(gomock.Any()).Return(nil, entity.ErrReconciliationNotFound)
+		},
+		expectedError:  entity.ErrReconciliationNotFound,
+		expectedReport: nil,
+	},
+}
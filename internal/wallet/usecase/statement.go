@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Postgres keeps the time in microseconds, so the opening and the closing balances are the balances
+// at the last moments before the period and before its end.
+const _timePrecision = time.Microsecond
+
+// Building the statement of the wallet for the period from its history.
+// The history is read by pages, the opening and the closing balances are restored from the ledger,
+// because not every change of the balance has the transaction, e.g. the balance of the created wallet.
+func (uc *WalletUseCase) GetWalletStatement(
+	ctx context.Context,
+	walletID string,
+	from, to time.Time,
+) (*entity.Statement, error) {
+	if !entity.IsValidStatementPeriod(from, to) {
+		return nil, entity.ErrWrongStatementPeriod
+	}
+
+	opening, err := uc.GetWalletBalanceAt(ctx, walletID, from.Add(-_timePrecision))
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWalletStatement - uc.GetWalletBalanceAt: %w", err)
+	}
+
+	var transactions []entity.Transaction
+
+	filter := entity.HistoryFilter{
+		Since: from,
+		Until: to,
+		Limit: entity.MaxHistoryLimit,
+	}
+
+	for {
+		page, err := uc.GetWalletHistoryByID(ctx, walletID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("WalletUseCase - GetWalletStatement - uc.GetWalletHistoryByID: %w", err)
+		}
+
+		transactions = append(transactions, page.Transactions...)
+		if len(transactions) > entity.MaxStatementEntries {
+			return nil, entity.ErrStatementTooLarge
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+
+		filter.Cursor = page.NextCursor
+	}
+
+	closing, err := uc.GetWalletBalanceAt(ctx, walletID, to.Add(-_timePrecision))
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWalletStatement - uc.GetWalletBalanceAt: %w", err)
+	}
+
+	statement, err := entity.NewStatement(walletID, opening.Currency, from, to, opening.Balance, closing.Balance, transactions)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWalletStatement - entity.NewStatement: %w", err)
+	}
+
+	statement.GeneratedAt = time.Now().UTC()
+
+	return statement, nil
+}
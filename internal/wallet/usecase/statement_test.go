@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_GetWalletStatement(t *testing.T) {
+	for _, test := range testsGetWalletStatement {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.walletID)
+
+			// Call function and check the result
+			statement, err := NewWallet(gateway).GetWalletStatement(context.Background(), test.walletID, test.from, test.to)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			if statement != nil {
+				assert.Equal(t, statement.GeneratedAt.IsZero(), false)
+				statement.GeneratedAt = time.Time{}
+			}
+
+			assert.Equal(t, statement, test.expectedStatement)
+		})
+	}
+}
+
+var (
+	startOfMarch = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	startOfApril = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var testsGetWalletStatement = []struct {
+	name              string
+	mockBehavior      func(r *mock_usecase.MockWalletGateway, walletID string)
+	walletID          string
+	from              time.Time
+	to                time.Time
+	expectedError     error
+	expectedStatement *entity.Statement
+}{
+	{
+		name: "Ok with two pages",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
+			filter := entity.HistoryFilter{Since: startOfMarch, Until: startOfApril, Limit: entity.MaxHistoryLimit}
+			cursor := entity.HistoryCursor{Time: startOfMarch.Add(2 * time.Hour), ID: "2"}.String()
+
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, startOfMarch.Add(-time.Microsecond)).
+				Return(&entity.HistoricalBalance{WalletID: walletID, Balance: 10000, Currency: "USD"}, nil)
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).Return(&entity.HistoryPage{
+				Transactions: []entity.Transaction{{
+					ID: "2", Time: startOfMarch.Add(2 * time.Hour), From: walletID, To: "eb376add88bf8e70f80787266a0801d5",
+					Amount: 4000, Type: entity.TransactionTypeTransfer, Status: entity.TransactionStatusCompleted,
+				}},
+				NextCursor: cursor,
+			}, nil)
+
+			filter.Cursor = cursor
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).Return(&entity.HistoryPage{
+				Transactions: []entity.Transaction{{
+					ID: "1", Time: startOfMarch.Add(time.Hour), From: "eb376add88bf8e70f80787266a0801d5", To: walletID,
+					Amount: 1000, Type: entity.TransactionTypeTransfer, Status: entity.TransactionStatusCompleted,
+				}},
+			}, nil)
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, startOfApril.Add(-time.Microsecond)).
+				Return(&entity.HistoricalBalance{WalletID: walletID, Balance: 7000, Currency: "USD"}, nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		from:          startOfMarch,
+		to:            startOfApril,
+		expectedError: nil,
+		expectedStatement: &entity.Statement{
+			WalletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Currency:       "USD",
+			From:           startOfMarch,
+			To:             startOfApril,
+			OpeningBalance: 10000,
+			ClosingBalance: 7000,
+			Entries: []entity.StatementEntry{
+				{
+					TransactionID: "1",
+					Time:          startOfMarch.Add(time.Hour),
+					Type:          entity.TransactionTypeTransfer,
+					Status:        entity.TransactionStatusCompleted,
+					Direction:     entity.EntryDirectionCredit,
+					Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+					Amount:        1000,
+					Balance:       11000,
+				},
+				{
+					TransactionID: "2",
+					Time:          startOfMarch.Add(2 * time.Hour),
+					Type:          entity.TransactionTypeTransfer,
+					Status:        entity.TransactionStatusCompleted,
+					Direction:     entity.EntryDirectionDebit,
+					Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+					Amount:        4000,
+					Balance:       7000,
+				},
+			},
+		},
+	},
+	{
+		name: "Ok - wallet is created in the period",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
+			filter := entity.HistoryFilter{Since: startOfMarch, Until: startOfApril, Limit: entity.MaxHistoryLimit}
+
+			// The balance of the created wallet is credited without the transaction
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, startOfMarch.Add(-time.Microsecond)).
+				Return(&entity.HistoricalBalance{WalletID: walletID, Balance: 0, Currency: "USD"}, nil)
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).Return(&entity.HistoryPage{
+				Transactions: []entity.Transaction{{
+					ID: "1", Time: startOfMarch.Add(time.Hour), From: walletID, To: "eb376add88bf8e70f80787266a0801d5",
+					Amount: 1000, Type: entity.TransactionTypeTransfer, Status: entity.TransactionStatusCompleted,
+				}},
+			}, nil)
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, startOfApril.Add(-time.Microsecond)).
+				Return(&entity.HistoricalBalance{WalletID: walletID, Balance: 9000, Currency: "USD"}, nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		from:          startOfMarch,
+		to:            startOfApril,
+		expectedError: nil,
+		expectedStatement: &entity.Statement{
+			WalletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Currency:       "USD",
+			From:           startOfMarch,
+			To:             startOfApril,
+			OpeningBalance: 0,
+			ClosingBalance: 9000,
+			Entries: []entity.StatementEntry{
+				{
+					TransactionID: "1",
+					Time:          startOfMarch.Add(time.Hour),
+					Type:          entity.TransactionTypeTransfer,
+					Status:        entity.TransactionStatusCompleted,
+					Direction:     entity.EntryDirectionDebit,
+					Counterparty:  "eb376add88bf8e70f80787266a0801d5",
+					Amount:        1000,
+					Balance:       9000,
+				},
+			},
+		},
+	},
+	{
+		name:              "Wrong period",
+		mockBehavior:      func(_ *mock_usecase.MockWalletGateway, _ string) {},
+		walletID:          "5b53700ed469fa6a09ea72bb78f36fd9",
+		from:              startOfApril,
+		to:                startOfMarch,
+		expectedError:     entity.ErrWrongStatementPeriod,
+		expectedStatement: nil,
+	},
+	{
+		name: "Wallet not found",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, gomock.Any()).Return(nil, entity.ErrWalletNotFound)
+		},
+		walletID:          "5b53700ed469fa6a09ea72bb78f36fd9",
+		from:              startOfMarch,
+		to:                startOfApril,
+		expectedError:     entity.ErrWalletNotFound,
+		expectedStatement: nil,
+	},
+	{
+		name: "Something went wrong",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
+			r.EXPECT().GetWalletBalanceAt(gomock.Any(), walletID, gomock.Any()).
+				Return(&entity.HistoricalBalance{WalletID: walletID, Balance: 10000, Currency: "USD"}, nil)
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, gomock.Any()).Return(nil, errSomethingWentWrong)
+		},
+		walletID:          "5b53700ed469fa6a09ea72bb78f36fd9",
+		from:              startOfMarch,
+		to:                startOfApril,
+		expectedError:     errSomethingWentWrong,
+		expectedStatement: nil,
+	},
+}
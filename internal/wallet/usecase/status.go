@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+func (uc *WalletUseCase) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	wallet, err := uc.gateway.FreezeWallet(ctxTimeout, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - FreezeWallet - uc.gateway.FreezeWallet: %w", err)
+	}
+
+	return wallet, nil
+}
+
+func (uc *WalletUseCase) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	wallet, err := uc.gateway.UnfreezeWallet(ctxTimeout, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - UnfreezeWallet - uc.gateway.UnfreezeWallet: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Closing the wallet. The remaining balance is swept to the wallet "to".
+func (uc *WalletUseCase) CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if len(walletID) == 0 || len(to) == 0 {
+		return nil, entity.ErrEmptyWallet
+	}
+
+	if walletID == to {
+		return nil, entity.ErrSenderIsReceiver
+	}
+
+	wallet, err := uc.gateway.CloseWallet(ctxTimeout, walletID, to)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - CloseWallet - uc.gateway.CloseWallet: %w", err)
+	}
+
+	return wallet, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_CloseWallet(t *testing.T) {
+	for _, test := range testsCloseWallet {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.walletID, test.to)
+
+			// Call function and check the result
+			wallet, err := NewWallet(gateway).CloseWallet(context.Background(), test.walletID, test.to)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, wallet, test.expectedWallet)
+		})
+	}
+}
+
+var testsCloseWallet = []struct {
+	name           string
+	mockBehavior   func(r *mock_usecase.MockWalletGateway, walletID, to string)
+	walletID       string
+	to             string
+	expectedError  error
+	expectedWallet *entity.Wallet
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID, to string) {
+			r.EXPECT().CloseWallet(gomock.Any(), walletID, to).Return(&entity.Wallet{
+				ID:     walletID,
+				Status: entity.WalletStatusClosed,
+			}, nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:            "eb376add88bf8e70f80787266a0801d5",
+		expectedError: nil,
+		expectedWallet: &entity.Wallet{
+			ID:     "5b53700ed469fa6a09ea72bb78f36fd9",
+			Status: entity.WalletStatusClosed,
+		},
+	},
+	{
+		name:           "Receiver of the sweep must be non-empty",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway, _, _ string) {},
+		walletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:             "",
+		expectedError:  entity.ErrEmptyWallet,
+		expectedWallet: nil,
+	},
+	{
+		name:           "Receiver of the sweep must be another wallet",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway, _, _ string) {},
+		walletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:             "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedError:  entity.ErrSenderIsReceiver,
+		expectedWallet: nil,
+	},
+	{
+		name: "Wallet is frozen",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID, to string) {
+			r.EXPECT().CloseWallet(gomock.Any(), walletID, to).Return(nil, entity.ErrWalletFrozen)
+		},
+		walletID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:             "eb376add88bf8e70f80787266a0801d5",
+		expectedError:  entity.ErrWalletFrozen,
+		expectedWallet: nil,
+	},
+}
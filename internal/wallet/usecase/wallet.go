@@ -9,23 +9,28 @@ import (
 )
 
 const (
-	_defaultTimeout      = 5 * time.Second
-	_defaultBalance uint = 100
+	_defaultTimeout               = 5 * time.Second
+	_defaultBalance  entity.Money = 10000
+	_defaultCurrency              = "USD"
+
+	_maxIdempotencyKeyLength = 255
 )
 
 // WalletUseCase -.
 type WalletUseCase struct {
-	gateway        WalletGateway
-	timeout        time.Duration
-	defaultBalance uint
+	gateway         WalletGateway
+	timeout         time.Duration
+	defaultBalance  entity.Money
+	defaultCurrency string
 }
 
 // New -.
 func NewWallet(gw WalletGateway, opts ...Option) *WalletUseCase {
 	uc := &WalletUseCase{
-		gateway:        gw,
-		timeout:        _defaultTimeout,
-		defaultBalance: _defaultBalance,
+		gateway:         gw,
+		timeout:         _defaultTimeout,
+		defaultBalance:  _defaultBalance,
+		defaultCurrency: _defaultCurrency,
 	}
 
 	for _, opt := range opts {
@@ -35,11 +40,34 @@ func NewWallet(gw WalletGateway, opts ...Option) *WalletUseCase {
 	return uc
 }
 
-func (uc *WalletUseCase) CreateNewWalletWithDefaultBalance(ctx context.Context) (*entity.Wallet, error) {
+// Creating a new wallet of the type in the currency. If the currency is empty, then the default one is used.
+// If the type is empty, then the personal wallet is created. If the owner is empty, then the wallet is anonymous.
+func (uc *WalletUseCase) CreateNewWalletWithDefaultBalance(
+	ctx context.Context,
+	currency string,
+	walletType string,
+	ownerID string,
+) (*entity.Wallet, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
 	defer cancel()
 
-	wallet, err := uc.gateway.CreateNewWalletWithBalance(ctxTimeout, uc.defaultBalance)
+	if currency == "" {
+		currency = uc.defaultCurrency
+	}
+
+	if !entity.IsValidCurrency(currency) {
+		return nil, entity.ErrWrongCurrency
+	}
+
+	if walletType == "" {
+		walletType = entity.WalletTypePersonal
+	}
+
+	if !entity.IsValidWalletType(walletType) {
+		return nil, entity.ErrWrongWalletType
+	}
+
+	wallet, err := uc.gateway.CreateNewWalletWithBalance(ctxTimeout, uc.defaultBalance, currency, walletType, ownerID)
 	if err != nil {
 		return nil,
 			fmt.Errorf("WalletUseCase - CreateNewWalletWithDefaultBalance - uc.gateway.CreateNewWalletWithBalance: %w", err)
@@ -48,43 +76,114 @@ func (uc *WalletUseCase) CreateNewWalletWithDefaultBalance(ctx context.Context)
 	return wallet, nil
 }
 
-func (uc *WalletUseCase) SendFunds(ctx context.Context, from string, to string, amount uint) error {
+func (uc *WalletUseCase) SendFunds(
+	ctx context.Context,
+	from string,
+	to string,
+	amount entity.Money,
+	details entity.TransferDetails,
+	idempotencyKey string,
+) (*entity.Transaction, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
 	defer cancel()
 
-	if amount <= 0 {
-		return entity.ErrWrongAmount
+	if !amount.IsPositive() {
+		return nil, entity.ErrWrongAmount
 	}
 
 	if len(from) == 0 || len(to) == 0 {
-		return entity.ErrEmptyWallet
+		return nil, entity.ErrEmptyWallet
 	}
 
 	if from == to {
-		return entity.ErrSenderIsReceiver
+		return nil, entity.ErrSenderIsReceiver
 	}
 
-	err := uc.gateway.SendFunds(ctxTimeout, from, to, amount)
+	if len(idempotencyKey) > _maxIdempotencyKeyLength {
+		return nil, entity.ErrWrongIdempotencyKey
+	}
+
+	transaction, err := uc.gateway.SendFunds(ctxTimeout, from, to, amount, details, idempotencyKey)
 	if err != nil {
-		return fmt.Errorf("WalletUseCase - SendFunds - uc.gateway.SendFunds: %w", err)
+		return nil, fmt.Errorf("WalletUseCase - SendFunds - uc.gateway.SendFunds: %w", err)
 	}
 
-	return nil
+	return transaction, nil
 }
 
-func (uc *WalletUseCase) GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error) {
+func (uc *WalletUseCase) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
 	defer cancel()
 
-	transactions, err := uc.gateway.GetWalletHistoryByID(ctxTimeout, walletID)
+	transaction, err := uc.gateway.GetTransactionByID(ctxTimeout, transactionID)
 	if err != nil {
 		return nil,
-			fmt.Errorf("WalletUseCase - GetWalletHistoryByID - uc.gateway.GetWalletHistoryByID: %w", err)
+			fmt.Errorf("WalletUseCase - GetTransactionByID - uc.gateway.GetTransactionByID: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Sending funds of all transfers of the batch as one unit: either all of them are made or none.
+func (uc *WalletUseCase) SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := entity.ValidateTransferLegs(legs); err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.gateway.SendFundsBatch(ctxTimeout, legs)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - SendFundsBatch - uc.gateway.SendFundsBatch: %w", err)
 	}
 
 	return transactions, nil
 }
 
+// Refunding the transfer. If the amount is zero, then the whole remaining amount is refunded.
+func (uc *WalletUseCase) RefundTransaction(
+	ctx context.Context,
+	transactionID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if amount < 0 {
+		return nil, entity.ErrWrongAmount
+	}
+
+	refund, err := uc.gateway.RefundTransaction(ctxTimeout, transactionID, amount)
+	if err != nil {
+		return nil,
+			fmt.Errorf("WalletUseCase - RefundTransaction - uc.gateway.RefundTransaction: %w", err)
+	}
+
+	return refund, nil
+}
+
+func (uc *WalletUseCase) GetWalletHistoryByID(
+	ctx context.Context,
+	walletID string,
+	filter entity.HistoryFilter,
+) (*entity.HistoryPage, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongHistoryFilter
+	}
+
+	page, err := uc.gateway.GetWalletHistoryByID(ctxTimeout, walletID, filter)
+	if err != nil {
+		return nil,
+			fmt.Errorf("WalletUseCase - GetWalletHistoryByID - uc.gateway.GetWalletHistoryByID: %w", err)
+	}
+
+	return page, nil
+}
+
 func (uc *WalletUseCase) GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error) {
 	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
 	defer cancel()
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,7 +26,8 @@ func Test_CreateNewWalletWithDefaultBalance(t *testing.T) {
 			test.mockBehavior(gateway)
 
 			// Call function and check the result
-			wallet, err := NewWallet(gateway).CreateNewWalletWithDefaultBalance(context.Background())
+			wallet, err := NewWallet(gateway).
+				CreateNewWalletWithDefaultBalance(context.Background(), test.currency, test.walletType, test.ownerID)
 			if !errors.Is(err, test.expectedError) {
 				t.Errorf("expected %v, got %v", test.expectedError, err)
 			}
@@ -38,27 +40,115 @@ func Test_CreateNewWalletWithDefaultBalance(t *testing.T) {
 var testsCreateNewWalletWithBalance = []struct {
 	name           string
 	mockBehavior   func(r *mock_usecase.MockWalletGateway)
+	currency       string
+	walletType     string
+	ownerID        string
 	expectedError  error
 	expectedWallet *entity.Wallet
 }{
 	{
 		name: "Ok",
 		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
-			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance).Return(&entity.Wallet{
-				ID:      "5b53700ed469fa6a09ea72bb78f36fd9",
-				Balance: 100,
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, _defaultCurrency, entity.WalletTypePersonal, "").Return(&entity.Wallet{
+				ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Balance:  100,
+				Currency: _defaultCurrency,
 			}, nil)
 		},
 		expectedError: nil,
 		expectedWallet: &entity.Wallet{
-			ID:      "5b53700ed469fa6a09ea72bb78f36fd9",
-			Balance: 100,
+			ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Balance:  100,
+			Currency: _defaultCurrency,
 		},
 	},
+	{
+		name: "Ok - with currency",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, "EUR", entity.WalletTypePersonal, "").Return(&entity.Wallet{
+				ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Balance:  100,
+				Currency: "EUR",
+			}, nil)
+		},
+		currency:      "EUR",
+		expectedError: nil,
+		expectedWallet: &entity.Wallet{
+			ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Balance:  100,
+			Currency: "EUR",
+		},
+	},
+	{
+		name: "Ok - with type",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, _defaultCurrency, entity.WalletTypeMerchant, "").
+				Return(&entity.Wallet{
+					ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+					Balance:  100,
+					Currency: _defaultCurrency,
+					Type:     entity.WalletTypeMerchant,
+				}, nil)
+		},
+		walletType:    entity.WalletTypeMerchant,
+		expectedError: nil,
+		expectedWallet: &entity.Wallet{
+			ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Balance:  100,
+			Currency: _defaultCurrency,
+			Type:     entity.WalletTypeMerchant,
+		},
+	},
+	{
+		name: "Ok - with owner",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, _defaultCurrency, entity.WalletTypePersonal,
+				"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").Return(&entity.Wallet{
+				ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Balance:  100,
+				Currency: _defaultCurrency,
+				Type:     entity.WalletTypePersonal,
+				OwnerID:  "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+			}, nil)
+		},
+		ownerID:       "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		expectedError: nil,
+		expectedWallet: &entity.Wallet{
+			ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Balance:  100,
+			Currency: _defaultCurrency,
+			Type:     entity.WalletTypePersonal,
+			OwnerID:  "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		},
+	},
+	{
+		name: "Owner not found",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, _defaultCurrency, entity.WalletTypePersonal,
+				"9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21").Return(nil, entity.ErrCustomerNotFound)
+		},
+		ownerID:        "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		expectedError:  entity.ErrCustomerNotFound,
+		expectedWallet: nil,
+	},
+	{
+		name:           "Unknown type",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway) {},
+		walletType:     "corporate",
+		expectedError:  entity.ErrWrongWalletType,
+		expectedWallet: nil,
+	},
+	{
+		name:           "Currency must be ISO 4217 code",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway) {},
+		currency:       "usd",
+		expectedError:  entity.ErrWrongCurrency,
+		expectedWallet: nil,
+	},
 	{
 		name: "Something went wrong",
 		mockBehavior: func(r *mock_usecase.MockWalletGateway) {
-			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance).Return(nil, errSomethingWentWrong)
+			r.EXPECT().CreateNewWalletWithBalance(gomock.Any(), _defaultBalance, _defaultCurrency, entity.WalletTypePersonal, "").Return(nil, errSomethingWentWrong)
 		},
 		expectedError:  errSomethingWentWrong,
 		expectedWallet: nil,
@@ -73,38 +163,85 @@ func Test_SendFunds(t *testing.T) {
 			defer c.Finish()
 
 			gateway := mock_usecase.NewMockWalletGateway(c)
-			test.mockBehavior(gateway, test.from, test.to, test.amount)
+			test.mockBehavior(gateway, test.from, test.to, test.amount, test.idempotencyKey)
 
 			// Call function and check the result
-			err := NewWallet(gateway).SendFunds(context.Background(), test.from, test.to, test.amount)
+			transaction, err := NewWallet(gateway).
+				SendFunds(context.Background(), test.from, test.to, test.amount, entity.TransferDetails{}, test.idempotencyKey)
 			if !errors.Is(err, test.expectedError) {
 				t.Errorf("expected %v, got %v", test.expectedError, err)
 			}
+
+			assert.Equal(t, transaction, test.expectedTransaction)
 		})
 	}
 }
 
 var testsSendFunds = []struct {
-	name          string
-	mockBehavior  func(r *mock_usecase.MockWalletGateway, from, to string, amount uint)
-	from          string
-	to            string
-	amount        uint
-	expectedError error
+	name                string
+	mockBehavior        func(r *mock_usecase.MockWalletGateway, from, to string, amount entity.Money, key string)
+	from                string
+	to                  string
+	amount              entity.Money
+	idempotencyKey      string
+	expectedError       error
+	expectedTransaction *entity.Transaction
 }{
 	{
 		name: "Ok",
-		mockBehavior: func(r *mock_usecase.MockWalletGateway, from, to string, amount uint) {
-			r.EXPECT().SendFunds(gomock.Any(), from, to, amount).Return(nil)
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, from, to string, amount entity.Money, key string) {
+			r.EXPECT().SendFunds(gomock.Any(), from, to, amount, entity.TransferDetails{}, key).Return(&entity.Transaction{
+				ID:     "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				From:   from,
+				To:     to,
+				Amount: amount,
+			}, nil)
 		},
 		from:          "5b53700ed469fa6a09ea72bb78f36fd9",
 		to:            "eb376add88bf8e70f80787266a0801d5",
 		amount:        100,
 		expectedError: nil,
+		expectedTransaction: &entity.Transaction{
+			ID:     "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			From:   "5b53700ed469fa6a09ea72bb78f36fd9",
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+	},
+	{
+		name: "Ok - with idempotency key",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, from, to string, amount entity.Money, key string) {
+			r.EXPECT().SendFunds(gomock.Any(), from, to, amount, entity.TransferDetails{}, key).Return(&entity.Transaction{
+				ID:     "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				From:   from,
+				To:     to,
+				Amount: amount,
+			}, nil)
+		},
+		from:           "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:             "eb376add88bf8e70f80787266a0801d5",
+		amount:         100,
+		idempotencyKey: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		expectedError:  nil,
+		expectedTransaction: &entity.Transaction{
+			ID:     "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			From:   "5b53700ed469fa6a09ea72bb78f36fd9",
+			To:     "eb376add88bf8e70f80787266a0801d5",
+			Amount: 100,
+		},
+	},
+	{
+		name:           "Idempotency key must be not longer than 255",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway, _, _ string, _ entity.Money, _ string) {},
+		from:           "5b53700ed469fa6a09ea72bb78f36fd9",
+		to:             "eb376add88bf8e70f80787266a0801d5",
+		amount:         100,
+		idempotencyKey: strings.Repeat("k", 256),
+		expectedError:  entity.ErrWrongIdempotencyKey,
 	},
 	{
 		name:          "Amount must be greater than 0",
-		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ uint) {},
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ entity.Money, _ string) {},
 		from:          "5b53700ed469fa6a09ea72bb78f36fd9",
 		to:            "eb376add88bf8e70f80787266a0801d5",
 		amount:        0,
@@ -112,7 +249,7 @@ var testsSendFunds = []struct {
 	},
 	{
 		name:          "Wallets ID`s must be non-empty",
-		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ uint) {},
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ entity.Money, _ string) {},
 		from:          "5b53700ed469fa6a09ea72bb78f36fd9",
 		to:            "",
 		amount:        100,
@@ -120,7 +257,7 @@ var testsSendFunds = []struct {
 	},
 	{
 		name:          "Wallets from and to must be not equal",
-		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ uint) {},
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _, _ string, _ entity.Money, _ string) {},
 		from:          "5b53700ed469fa6a09ea72bb78f36fd9",
 		to:            "5b53700ed469fa6a09ea72bb78f36fd9",
 		amount:        100,
@@ -128,8 +265,8 @@ var testsSendFunds = []struct {
 	},
 	{
 		name: "Something went wrong",
-		mockBehavior: func(r *mock_usecase.MockWalletGateway, from, to string, amount uint) {
-			r.EXPECT().SendFunds(gomock.Any(), from, to, amount).Return(errSomethingWentWrong)
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, from, to string, amount entity.Money, key string) {
+			r.EXPECT().SendFunds(gomock.Any(), from, to, amount, entity.TransferDetails{}, key).Return(nil, errSomethingWentWrong)
 		},
 		from:          "5b53700ed469fa6a09ea72bb78f36fd9",
 		to:            "eb376add88bf8e70f80787266a0801d5",
@@ -138,79 +275,184 @@ var testsSendFunds = []struct {
 	},
 }
 
-func Test_GetWalletHistoryByID(t *testing.T) {
-	for _, test := range testsGetWalletHistoryByID {
+func Test_RefundTransaction(t *testing.T) {
+	for _, test := range testsRefundTransaction {
 		t.Run(test.name, func(t *testing.T) {
 			// Init Dependencies
 			c := gomock.NewController(t)
 			defer c.Finish()
 
 			gateway := mock_usecase.NewMockWalletGateway(c)
-			test.mockBehavior(gateway, test.walletID)
+			test.mockBehavior(gateway, test.transactionID, test.amount)
 
 			// Call function and check the result
-			transactions, err := NewWallet(gateway).GetWalletHistoryByID(context.Background(), test.walletID)
+			refund, err := NewWallet(gateway).RefundTransaction(context.Background(), test.transactionID, test.amount)
 			if !errors.Is(err, test.expectedError) {
 				t.Errorf("expected %v, got %v", test.expectedError, err)
 			}
 
-			for i, tr := range transactions {
-				assert.Equal(t, tr, test.expectedTransactions[i])
-			}
+			assert.Equal(t, refund, test.expectedRefund)
 		})
 	}
 }
 
-var testsGetWalletHistoryByID = []struct {
-	name                 string
-	mockBehavior         func(r *mock_usecase.MockWalletGateway, walletID string)
-	walletID             string
-	expectedTransactions []entity.Transaction
-	expectedError        error
+var testsRefundTransaction = []struct {
+	name           string
+	mockBehavior   func(r *mock_usecase.MockWalletGateway, transactionID string, amount entity.Money)
+	transactionID  string
+	amount         entity.Money
+	expectedError  error
+	expectedRefund *entity.Transaction
 }{
 	{
 		name: "Ok",
-		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
-			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID).Return([]entity.Transaction{
-				{
-					Time:   time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
-					From:   "5b53700ed469fa6a09ea72bb78f36fd9",
-					To:     "eb376add88bf8e70f80787266a0801d5",
-					Amount: 30,
-				},
-				{
-					Time:   time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
-					From:   "eb376add88bf8e70f80787266a0801d5",
-					To:     "5b53700ed469fa6a09ea72bb78f36fd9",
-					Amount: 30,
-				},
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, transactionID string, amount entity.Money) {
+			r.EXPECT().RefundTransaction(gomock.Any(), transactionID, amount).Return(&entity.Transaction{
+				Type:     entity.TransactionTypeRefund,
+				Amount:   amount,
+				ParentID: transactionID,
 			}, nil)
 		},
-		walletID: "5b53700ed469fa6a09ea72bb78f36fd9",
-		expectedTransactions: []entity.Transaction{
+		transactionID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		amount:        10,
+		expectedError: nil,
+		expectedRefund: &entity.Transaction{
+			Type:     entity.TransactionTypeRefund,
+			Amount:   10,
+			ParentID: "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		},
+	},
+	{
+		name:           "Amount must be not negative",
+		mockBehavior:   func(_ *mock_usecase.MockWalletGateway, _ string, _ entity.Money) {},
+		transactionID:  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		amount:         -10,
+		expectedError:  entity.ErrWrongAmount,
+		expectedRefund: nil,
+	},
+	{
+		name: "Refund exceeds amount",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, transactionID string, amount entity.Money) {
+			r.EXPECT().RefundTransaction(gomock.Any(), transactionID, amount).Return(nil, entity.ErrRefundExceedsAmount)
+		},
+		transactionID:  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+		amount:         100,
+		expectedError:  entity.ErrRefundExceedsAmount,
+		expectedRefund: nil,
+	},
+}
+
+func Test_GetWalletHistoryByID(t *testing.T) {
+	for _, test := range testsGetWalletHistoryByID {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.walletID, test.filter)
+
+			// Call function and check the result
+			page, err := NewWallet(gateway).GetWalletHistoryByID(context.Background(), test.walletID, test.filter)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, page, test.expectedPage)
+		})
+	}
+}
+
+func historyPage(nextCursor string) *entity.HistoryPage {
+	return &entity.HistoryPage{
+		Transactions: []entity.Transaction{
 			{
-				Time:   time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
-				From:   "5b53700ed469fa6a09ea72bb78f36fd9",
-				To:     "eb376add88bf8e70f80787266a0801d5",
-				Amount: 30,
+				Time:     time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
+				From:     "5b53700ed469fa6a09ea72bb78f36fd9",
+				To:       "eb376add88bf8e70f80787266a0801d5",
+				Amount:   30,
+				Currency: "USD",
 			},
 			{
-				Time:   time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
-				From:   "eb376add88bf8e70f80787266a0801d5",
-				To:     "5b53700ed469fa6a09ea72bb78f36fd9",
-				Amount: 30,
+				Time:     time.Date(2024, time.February, 4, 17, 25, 35, 0, time.UTC),
+				From:     "eb376add88bf8e70f80787266a0801d5",
+				To:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Amount:   30,
+				Currency: "USD",
 			},
 		},
+		NextCursor: nextCursor,
+	}
+}
+
+var testsGetWalletHistoryByID = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletGateway, walletID string, filter entity.HistoryFilter)
+	walletID      string
+	filter        entity.HistoryFilter
+	expectedPage  *entity.HistoryPage
+	expectedError error
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string, filter entity.HistoryFilter) {
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).Return(historyPage(""), nil)
+		},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedPage:  historyPage(""),
+		expectedError: nil,
+	},
+	{
+		name: "Ok - filtered page with next cursor",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string, filter entity.HistoryFilter) {
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).
+				Return(historyPage("MjAyNC0wMi0wNFQxNzoyNTozNVp8N2M5ZTY2Nzk"), nil)
+		},
+		walletID: "5b53700ed469fa6a09ea72bb78f36fd9",
+		filter: entity.HistoryFilter{
+			Direction:    entity.DirectionOutgoing,
+			Counterparty: "eb376add88bf8e70f80787266a0801d5",
+			Since:        time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			Until:        time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			MinAmount:    10,
+			MaxAmount:    100,
+			Limit:        2,
+		},
+		expectedPage:  historyPage("MjAyNC0wMi0wNFQxNzoyNTozNVp8N2M5ZTY2Nzk"),
 		expectedError: nil,
 	},
+	{
+		name:          "Wrong direction",
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _ string, _ entity.HistoryFilter) {},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		filter:        entity.HistoryFilter{Direction: "sideways"},
+		expectedPage:  nil,
+		expectedError: entity.ErrWrongHistoryFilter,
+	},
+	{
+		name:          "Too large page",
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _ string, _ entity.HistoryFilter) {},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		filter:        entity.HistoryFilter{Limit: entity.MaxHistoryLimit + 1},
+		expectedPage:  nil,
+		expectedError: entity.ErrWrongHistoryFilter,
+	},
+	{
+		name:          "Wrong cursor",
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _ string, _ entity.HistoryFilter) {},
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		filter:        entity.HistoryFilter{Cursor: "not a cursor"},
+		expectedPage:  nil,
+		expectedError: entity.ErrWrongHistoryFilter,
+	},
 	{
 		name: "Something went wrong",
-		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
-			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID).Return([]entity.Transaction{}, errSomethingWentWrong)
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string, filter entity.HistoryFilter) {
+			r.EXPECT().GetWalletHistoryByID(gomock.Any(), walletID, filter).Return(nil, errSomethingWentWrong)
 		},
-		walletID:             "5b53700ed469fa6a09ea72bb78f36fd9",
-		expectedTransactions: []entity.Transaction{},
-		expectedError:        errSomethingWentWrong,
+		walletID:      "5b53700ed469fa6a09ea72bb78f36fd9",
+		expectedPage:  nil,
+		expectedError: errSomethingWentWrong,
 	},
 }
 
@@ -246,14 +488,16 @@ var testsGetWalletByID = []struct {
 		name: "Ok",
 		mockBehavior: func(r *mock_usecase.MockWalletGateway, walletID string) {
 			r.EXPECT().GetWalletByID(gomock.Any(), walletID).Return(&entity.Wallet{
-				ID:      "5b53700ed469fa6a09ea72bb78f36fd9",
-				Balance: 100,
+				ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+				Balance:  100,
+				Currency: "USD",
 			}, nil)
 		},
 		expectedError: nil,
 		expectedWallet: &entity.Wallet{
-			ID:      "5b53700ed469fa6a09ea72bb78f36fd9",
-			Balance: 100,
+			ID:       "5b53700ed469fa6a09ea72bb78f36fd9",
+			Balance:  100,
+			Currency: "USD",
 		},
 	},
 	{
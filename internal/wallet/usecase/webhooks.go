@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating a new webhook subscription. The signing secret is generated by the worker.
+func (uc *WalletUseCase) CreateWebhook(
+	ctx context.Context,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !subscription.IsValid() {
+		return nil, entity.ErrWrongWebhook
+	}
+
+	subscription, err := uc.gateway.CreateWebhook(ctxTimeout, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - CreateWebhook - uc.gateway.CreateWebhook: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (uc *WalletUseCase) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	subscriptions, err := uc.gateway.GetWebhooks(ctxTimeout, owner)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWebhooks - uc.gateway.GetWebhooks: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (uc *WalletUseCase) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	subscription, err := uc.gateway.GetWebhookByID(ctxTimeout, owner, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWebhookByID - uc.gateway.GetWebhookByID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (uc *WalletUseCase) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if err := uc.gateway.DeleteWebhook(ctxTimeout, owner, webhookID); err != nil {
+		return fmt.Errorf("WalletUseCase - DeleteWebhook - uc.gateway.DeleteWebhook: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *WalletUseCase) GetWebhookDeliveries(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	filter entity.WebhookDeliveryFilter,
+) ([]entity.WebhookDelivery, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongWebhookDeliveryFilter
+	}
+
+	deliveries, err := uc.gateway.GetWebhookDeliveries(ctxTimeout, owner, webhookID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - GetWebhookDeliveries - uc.gateway.GetWebhookDeliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// Sending the delivery once again, e.g. after the subscriber is fixed.
+func (uc *WalletUseCase) ReplayWebhookDelivery(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	deliveryID int64,
+) (*entity.WebhookDelivery, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, _defaultTimeout)
+	defer cancel()
+
+	delivery, err := uc.gateway.ReplayWebhookDelivery(ctxTimeout, owner, webhookID, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletUseCase - ReplayWebhookDelivery - uc.gateway.ReplayWebhookDelivery: %w", err)
+	}
+
+	return delivery, nil
+}
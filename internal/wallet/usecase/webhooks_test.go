@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/wallet/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_CreateWebhook(t *testing.T) {
+	for _, test := range testsCreateWebhook {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.subscription)
+
+			// Call function and check the result
+			subscription, err := NewWallet(gateway).CreateWebhook(context.Background(), test.subscription)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, subscription, test.expectedSubscription)
+		})
+	}
+}
+
+var testsCreateWebhook = []struct {
+	name                 string
+	mockBehavior         func(r *mock_usecase.MockWalletGateway, subscription *entity.WebhookSubscription)
+	subscription         *entity.WebhookSubscription
+	expectedError        error
+	expectedSubscription *entity.WebhookSubscription
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, subscription *entity.WebhookSubscription) {
+			r.EXPECT().CreateWebhook(gomock.Any(), subscription).Return(&entity.WebhookSubscription{
+				ID:         "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19",
+				Owner:      "operations",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{entity.WebhookEventTransferCompleted},
+				Secret:     "whsec_1",
+			}, nil)
+		},
+		subscription: &entity.WebhookSubscription{
+			Owner:      "operations",
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{entity.WebhookEventTransferCompleted},
+		},
+		expectedError: nil,
+		expectedSubscription: &entity.WebhookSubscription{
+			ID:         "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19",
+			Owner:      "operations",
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{entity.WebhookEventTransferCompleted},
+			Secret:     "whsec_1",
+		},
+	},
+	{
+		name:         "Unknown event type",
+		mockBehavior: func(_ *mock_usecase.MockWalletGateway, _ *entity.WebhookSubscription) {},
+		subscription: &entity.WebhookSubscription{
+			Owner:      "operations",
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{"wallet.deleted"},
+		},
+		expectedError:        entity.ErrWrongWebhook,
+		expectedSubscription: nil,
+	},
+	{
+		name:         "Relative url",
+		mockBehavior: func(_ *mock_usecase.MockWalletGateway, _ *entity.WebhookSubscription) {},
+		subscription: &entity.WebhookSubscription{
+			Owner:      "operations",
+			URL:        "/hooks",
+			EventTypes: []string{entity.WebhookEventHoldExpired},
+		},
+		expectedError:        entity.ErrWrongWebhook,
+		expectedSubscription: nil,
+	},
+	{
+		name: "Wallet not found",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, subscription *entity.WebhookSubscription) {
+			r.EXPECT().CreateWebhook(gomock.Any(), subscription).Return(nil, entity.ErrWalletNotFound)
+		},
+		subscription: &entity.WebhookSubscription{
+			Owner:      "operations",
+			URL:        "https://example.com/hooks",
+			EventTypes: []string{entity.WebhookEventWalletFrozen},
+			WalletID:   "5b53700ed469fa6a09ea72bb78f36fd9",
+		},
+		expectedError:        entity.ErrWalletNotFound,
+		expectedSubscription: nil,
+	},
+}
+
+func Test_GetWebhookDeliveries(t *testing.T) {
+	for _, test := range testsGetWebhookDeliveries {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			gateway := mock_usecase.NewMockWalletGateway(c)
+			test.mockBehavior(gateway, test.filter)
+
+			// Call function and check the result
+			deliveries, err := NewWallet(gateway).GetWebhookDeliveries(context.Background(), "operations",
+				"3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19", test.filter)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, len(deliveries), test.expectedCount)
+		})
+	}
+}
+
+var testsGetWebhookDeliveries = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletGateway, filter entity.WebhookDeliveryFilter)
+	filter        entity.WebhookDeliveryFilter
+	expectedError error
+	expectedCount int
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletGateway, filter entity.WebhookDeliveryFilter) {
+			r.EXPECT().GetWebhookDeliveries(gomock.Any(), "operations", "3f1c2a8e-5d4b-4c1a-9e7f-2b6d8a0c4e19", filter).
+				Return([]entity.WebhookDelivery{{ID: 2}, {ID: 1}}, nil)
+		},
+		filter:        entity.WebhookDeliveryFilter{Status: entity.WebhookDeliveryFailed, Limit: 10},
+		expectedError: nil,
+		expectedCount: 2,
+	},
+	{
+		name:          "Unknown status",
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _ entity.WebhookDeliveryFilter) {},
+		filter:        entity.WebhookDeliveryFilter{Status: "lost"},
+		expectedError: entity.ErrWrongWebhookDeliveryFilter,
+		expectedCount: 0,
+	},
+	{
+		name:          "Limit is too big",
+		mockBehavior:  func(_ *mock_usecase.MockWalletGateway, _ entity.WebhookDeliveryFilter) {},
+		filter:        entity.WebhookDeliveryFilter{Limit: entity.MaxWebhookDeliveriesLimit + 1},
+		expectedError: entity.ErrWrongWebhookDeliveryFilter,
+		expectedCount: 0,
+	},
+}
@@ -0,0 +1,42 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type balanceRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the wallet balances for rmq rpc.
+func newBalanceRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &balanceRoutes{w}
+	{
+		routes["getWalletBalanceAt"] = r.getWalletBalanceAt()
+	}
+}
+
+// Handles a remote "getWalletBalanceAt" call.
+func (r *balanceRoutes) getWalletBalanceAt() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WalletBalanceAtRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - balanceRoutes - getWalletBalanceAt - json.Unmarshal: %w", err)
+		}
+
+		balance, err := r.w.GetWalletBalanceAt(context.Background(), request.WalletID, request.At)
+		if err != nil {
+			return nil, remoteError("balanceRoutes - getWalletBalanceAt - r.w.GetWalletBalanceAt", err)
+		}
+
+		return balance, nil
+	}
+}
@@ -0,0 +1,80 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type customerRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the customers for rmq rpc.
+func newCustomerRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &customerRoutes{w}
+	{
+		routes["createCustomer"] = r.createCustomer()
+		routes["getCustomerByID"] = r.getCustomerByID()
+		routes["getCustomerWallets"] = r.getCustomerWallets()
+	}
+}
+
+// Handles a remote "createCustomer" call.
+func (r *customerRoutes) createCustomer() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.Customer
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - customerRoutes - createCustomer - json.Unmarshal: %w", err)
+		}
+
+		customer, err := r.w.CreateCustomer(context.Background(), &request)
+		if err != nil {
+			return nil, remoteError("customerRoutes - createCustomer - r.w.CreateCustomer", err)
+		}
+
+		return customer, nil
+	}
+}
+
+// Handles a remote "getCustomerByID" call.
+func (r *customerRoutes) getCustomerByID() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.GetCustomerByIDRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - customerRoutes - getCustomerByID - json.Unmarshal: %w", err)
+		}
+
+		customer, err := r.w.GetCustomerByID(context.Background(), request.CustomerID)
+		if err != nil {
+			return nil, remoteError("customerRoutes - getCustomerByID - r.w.GetCustomerByID", err)
+		}
+
+		return customer, nil
+	}
+}
+
+// Handles a remote "getCustomerWallets" call.
+func (r *customerRoutes) getCustomerWallets() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.GetCustomerByIDRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - customerRoutes - getCustomerWallets - json.Unmarshal: %w", err)
+		}
+
+		wallets, err := r.w.GetCustomerWallets(context.Background(), request.CustomerID)
+		if err != nil {
+			return nil, remoteError("customerRoutes - getCustomerWallets - r.w.GetCustomerWallets", err)
+		}
+
+		return wallets, nil
+	}
+}
@@ -0,0 +1,61 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type fundingRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the deposits and the withdrawals for rmq rpc.
+func newFundingRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &fundingRoutes{w}
+	{
+		routes["deposit"] = r.deposit()
+		routes["withdraw"] = r.withdraw()
+	}
+}
+
+// Handles a remote "deposit" call.
+func (r *fundingRoutes) deposit() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.FundingRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - fundingRoutes - deposit - json.Unmarshal: %w", err)
+		}
+
+		transaction, err := r.w.Deposit(context.Background(), request.WalletID, request.Amount)
+		if err != nil {
+			return nil, remoteError("fundingRoutes - deposit - r.w.Deposit", err)
+		}
+
+		return transaction, nil
+	}
+}
+
+// Handles a remote "withdraw" call.
+func (r *fundingRoutes) withdraw() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.FundingRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - fundingRoutes - withdraw - json.Unmarshal: %w", err)
+		}
+
+		transaction, err := r.w.Withdraw(context.Background(), request.WalletID, request.Amount)
+		if err != nil {
+			return nil, remoteError("fundingRoutes - withdraw - r.w.Withdraw", err)
+		}
+
+		return transaction, nil
+	}
+}
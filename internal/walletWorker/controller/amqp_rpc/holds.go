@@ -0,0 +1,107 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type holdRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the holds for rmq rpc.
+func newHoldRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &holdRoutes{w}
+	{
+		routes["authorizeHold"] = r.authorizeHold()
+		routes["captureHold"] = r.captureHold()
+		routes["voidHold"] = r.voidHold()
+		routes["getHoldByID"] = r.getHoldByID()
+	}
+}
+
+// Handles a remote "authorizeHold" call.
+func (r *holdRoutes) authorizeHold() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.AuthorizeHoldRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - holdRoutes - authorizeHold - json.Unmarshal: %w", err)
+		}
+
+		if !request.Amount.IsPositive() {
+			return nil, entity.ErrWrongAmount
+		}
+
+		hold, err := r.w.AuthorizeHold(context.Background(), request.WalletID, request.Amount, request.TTL)
+		if err != nil {
+			return nil, remoteError("holdRoutes - authorizeHold - r.w.AuthorizeHold", err)
+		}
+
+		return hold, nil
+	}
+}
+
+// Handles a remote "captureHold" call.
+func (r *holdRoutes) captureHold() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.CaptureHoldRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - holdRoutes - captureHold - json.Unmarshal: %w", err)
+		}
+
+		if request.Amount < 0 {
+			return nil, entity.ErrWrongAmount
+		}
+
+		hold, err := r.w.CaptureHold(context.Background(), request.HoldID, request.To, request.Amount)
+		if err != nil {
+			return nil, remoteError("holdRoutes - captureHold - r.w.CaptureHold", err)
+		}
+
+		return hold, nil
+	}
+}
+
+// Handles a remote "voidHold" call.
+func (r *holdRoutes) voidHold() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.VoidHoldRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - holdRoutes - voidHold - json.Unmarshal: %w", err)
+		}
+
+		hold, err := r.w.VoidHold(context.Background(), request.HoldID)
+		if err != nil {
+			return nil, remoteError("holdRoutes - voidHold - r.w.VoidHold", err)
+		}
+
+		return hold, nil
+	}
+}
+
+// Handles a remote "getHoldByID" call.
+func (r *holdRoutes) getHoldByID() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.GetHoldByIDRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - holdRoutes - getHoldByID - json.Unmarshal: %w", err)
+		}
+
+		hold, err := r.w.GetHoldByID(context.Background(), request.HoldID)
+		if err != nil {
+			return nil, remoteError("holdRoutes - getHoldByID - r.w.GetHoldByID", err)
+		}
+
+		return hold, nil
+	}
+}
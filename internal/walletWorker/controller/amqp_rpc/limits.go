@@ -0,0 +1,79 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type limitsRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the spending limits for rmq rpc.
+func newLimitsRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &limitsRoutes{w}
+	{
+		routes["getWalletLimits"] = r.getWalletLimits()
+		routes["setWalletLimits"] = r.setWalletLimits()
+		routes["deleteWalletLimits"] = r.deleteWalletLimits()
+	}
+}
+
+// Handles a remote "getWalletLimits" call.
+func (r *limitsRoutes) getWalletLimits() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WalletLimitsRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - limitsRoutes - getWalletLimits - json.Unmarshal: %w", err)
+		}
+
+		limits, err := r.w.GetWalletLimits(context.Background(), request.WalletID)
+		if err != nil {
+			return nil, remoteError("limitsRoutes - getWalletLimits - r.w.GetWalletLimits", err)
+		}
+
+		return limits, nil
+	}
+}
+
+// Handles a remote "setWalletLimits" call.
+func (r *limitsRoutes) setWalletLimits() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.SpendingLimits
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - limitsRoutes - setWalletLimits - json.Unmarshal: %w", err)
+		}
+
+		limits, err := r.w.SetWalletLimits(context.Background(), &request)
+		if err != nil {
+			return nil, remoteError("limitsRoutes - setWalletLimits - r.w.SetWalletLimits", err)
+		}
+
+		return limits, nil
+	}
+}
+
+// Handles a remote "deleteWalletLimits" call.
+func (r *limitsRoutes) deleteWalletLimits() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WalletLimitsRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - limitsRoutes - deleteWalletLimits - json.Unmarshal: %w", err)
+		}
+
+		if err := r.w.DeleteWalletLimits(context.Background(), request.WalletID); err != nil {
+			return nil, remoteError("limitsRoutes - deleteWalletLimits - r.w.DeleteWalletLimits", err)
+		}
+
+		return nil, nil
+	}
+}
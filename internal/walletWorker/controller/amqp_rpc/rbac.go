@@ -0,0 +1,166 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type rbacRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the roles and the audit log for rmq rpc.
+func newRBACRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &rbacRoutes{w}
+	{
+		routes["getRoles"] = r.getRoles()
+		routes["setRole"] = r.setRole()
+		routes["deleteRole"] = r.deleteRole()
+		routes["getSubjectRoles"] = r.getSubjectRoles()
+		routes["assignRole"] = r.assignRole()
+		routes["revokeRole"] = r.revokeRole()
+		routes["recordAuditEvent"] = r.recordAuditEvent()
+		routes["getAuditEvents"] = r.getAuditEvents()
+	}
+}
+
+// Handles a remote "getRoles" call.
+func (r *rbacRoutes) getRoles() server.CallHandler {
+	return func(_ *amqp.Delivery) (interface{}, error) {
+		roles, err := r.w.GetRoles(context.Background())
+		if err != nil {
+			return nil, remoteError("rbacRoutes - getRoles - r.w.GetRoles", err)
+		}
+
+		return roles, nil
+	}
+}
+
+// Handles a remote "setRole" call.
+func (r *rbacRoutes) setRole() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.Role
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - setRole - json.Unmarshal: %w", err)
+		}
+
+		role, err := r.w.SetRole(context.Background(), &request)
+		if err != nil {
+			return nil, remoteError("rbacRoutes - setRole - r.w.SetRole", err)
+		}
+
+		return role, nil
+	}
+}
+
+// Handles a remote "deleteRole" call.
+func (r *rbacRoutes) deleteRole() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.RoleRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - deleteRole - json.Unmarshal: %w", err)
+		}
+
+		if err := r.w.DeleteRole(context.Background(), request.Name); err != nil {
+			return nil, remoteError("rbacRoutes - deleteRole - r.w.DeleteRole", err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Handles a remote "getSubjectRoles" call.
+func (r *rbacRoutes) getSubjectRoles() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.SubjectRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - getSubjectRoles - json.Unmarshal: %w", err)
+		}
+
+		roles, err := r.w.GetSubjectRoles(context.Background(), request.Subject)
+		if err != nil {
+			return nil, remoteError("rbacRoutes - getSubjectRoles - r.w.GetSubjectRoles", err)
+		}
+
+		return roles, nil
+	}
+}
+
+// Handles a remote "assignRole" call.
+func (r *rbacRoutes) assignRole() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.RoleAssignmentRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - assignRole - json.Unmarshal: %w", err)
+		}
+
+		roles, err := r.w.AssignRole(context.Background(), request.Subject, request.Role)
+		if err != nil {
+			return nil, remoteError("rbacRoutes - assignRole - r.w.AssignRole", err)
+		}
+
+		return roles, nil
+	}
+}
+
+// Handles a remote "revokeRole" call.
+func (r *rbacRoutes) revokeRole() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.RoleAssignmentRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - revokeRole - json.Unmarshal: %w", err)
+		}
+
+		if err := r.w.RevokeRole(context.Background(), request.Subject, request.Role); err != nil {
+			return nil, remoteError("rbacRoutes - revokeRole - r.w.RevokeRole", err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Handles a remote "recordAuditEvent" call.
+func (r *rbacRoutes) recordAuditEvent() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.AuditEvent
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - recordAuditEvent - json.Unmarshal: %w", err)
+		}
+
+		if err := r.w.RecordAuditEvent(context.Background(), &request); err != nil {
+			return nil, remoteError("rbacRoutes - recordAuditEvent - r.w.RecordAuditEvent", err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Handles a remote "getAuditEvents" call.
+func (r *rbacRoutes) getAuditEvents() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.AuditFilter
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - rbacRoutes - getAuditEvents - json.Unmarshal: %w", err)
+		}
+
+		page, err := r.w.GetAuditEvents(context.Background(), request)
+		if err != nil {
+			return nil, remoteError("rbacRoutes - getAuditEvents - r.w.GetAuditEvents", err)
+		}
+
+		return page, nil
+	}
+}
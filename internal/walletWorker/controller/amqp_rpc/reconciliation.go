@@ -0,0 +1,45 @@
+package amqprpc
+
+import (
+	"context"
+
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type reconciliationRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the reconciliation of the balances for rmq rpc.
+func newReconciliationRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &reconciliationRoutes{w}
+	{
+		routes["startReconciliation"] = r.startReconciliation()
+		routes["getReconciliationReport"] = r.getReconciliationReport()
+	}
+}
+
+// Handles a remote "startReconciliation" call.
+func (r *reconciliationRoutes) startReconciliation() server.CallHandler {
+	return func(_ *amqp.Delivery) (interface{}, error) {
+		if err := r.w.StartReconciliation(context.Background()); err != nil {
+			return nil, remoteError("reconciliationRoutes - startReconciliation - r.w.StartReconciliation", err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Handles a remote "getReconciliationReport" call.
+func (r *reconciliationRoutes) getReconciliationReport() server.CallHandler {
+	return func(_ *amqp.Delivery) (interface{}, error) {
+		report, err := r.w.GetReconciliationReport(context.Background())
+		if err != nil {
+			return nil, remoteError("reconciliationRoutes - getReconciliationReport - r.w.GetReconciliationReport", err)
+		}
+
+		return report, nil
+	}
+}
@@ -1,6 +1,10 @@
 package amqprpc
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
 	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
 	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
 )
@@ -9,7 +13,29 @@ func NewRouter(r usecase.WalletWorker) map[string]server.CallHandler {
 	routes := make(map[string]server.CallHandler)
 	{
 		newWalletWorkerRoutes(routes, r)
+		newHoldRoutes(routes, r)
+		newStatusRoutes(routes, r)
+		newLimitsRoutes(routes, r)
+		newFundingRoutes(routes, r)
+		newBalanceRoutes(routes, r)
+		newCustomerRoutes(routes, r)
+		newRBACRoutes(routes, r)
+		newWebhookRoutes(routes, r)
+		newReconciliationRoutes(routes, r)
 	}
 
 	return routes
 }
+
+// Converting the error of the use case to the error passed through rmq rpc.
+func remoteError(operation string, err error) error {
+	if errors.Is(err, entity.ErrWalletNotFound) {
+		return entity.ErrNotFound
+	}
+
+	if remoteErr := entity.ToRemoteError(err); remoteErr != nil {
+		return remoteErr
+	}
+
+	return fmt.Errorf("amqp_rpc - %s: %w", operation, err)
+}
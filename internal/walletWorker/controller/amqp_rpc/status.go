@@ -0,0 +1,80 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type statusRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the wallet lifecycle for rmq rpc.
+func newStatusRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &statusRoutes{w}
+	{
+		routes["freezeWallet"] = r.freezeWallet()
+		routes["unfreezeWallet"] = r.unfreezeWallet()
+		routes["closeWallet"] = r.closeWallet()
+	}
+}
+
+// Handles a remote "freezeWallet" call.
+func (r *statusRoutes) freezeWallet() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.ChangeWalletStatusRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - statusRoutes - freezeWallet - json.Unmarshal: %w", err)
+		}
+
+		wallet, err := r.w.FreezeWallet(context.Background(), request.WalletID)
+		if err != nil {
+			return nil, remoteError("statusRoutes - freezeWallet - r.w.FreezeWallet", err)
+		}
+
+		return wallet, nil
+	}
+}
+
+// Handles a remote "unfreezeWallet" call.
+func (r *statusRoutes) unfreezeWallet() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.ChangeWalletStatusRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - statusRoutes - unfreezeWallet - json.Unmarshal: %w", err)
+		}
+
+		wallet, err := r.w.UnfreezeWallet(context.Background(), request.WalletID)
+		if err != nil {
+			return nil, remoteError("statusRoutes - unfreezeWallet - r.w.UnfreezeWallet", err)
+		}
+
+		return wallet, nil
+	}
+}
+
+// Handles a remote "closeWallet" call.
+func (r *statusRoutes) closeWallet() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.CloseWalletRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - statusRoutes - closeWallet - json.Unmarshal: %w", err)
+		}
+
+		wallet, err := r.w.CloseWallet(context.Background(), request.WalletID, request.To)
+		if err != nil {
+			return nil, remoteError("statusRoutes - closeWallet - r.w.CloseWallet", err)
+		}
+
+		return wallet, nil
+	}
+}
@@ -0,0 +1,136 @@
+package amqprpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/rmq_rpc/server"
+	"github.com/streadway/amqp"
+)
+
+type webhookRoutes struct {
+	w usecase.WalletWorker
+}
+
+// Вeclaring routes of the webhook subscriptions for rmq rpc.
+func newWebhookRoutes(routes map[string]server.CallHandler, w usecase.WalletWorker) {
+	r := &webhookRoutes{w}
+	{
+		routes["createWebhook"] = r.createWebhook()
+		routes["getWebhooks"] = r.getWebhooks()
+		routes["getWebhookByID"] = r.getWebhookByID()
+		routes["deleteWebhook"] = r.deleteWebhook()
+		routes["getWebhookDeliveries"] = r.getWebhookDeliveries()
+		routes["replayWebhookDelivery"] = r.replayWebhookDelivery()
+	}
+}
+
+// Handles a remote "createWebhook" call.
+func (r *webhookRoutes) createWebhook() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WebhookSubscription
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - createWebhook - json.Unmarshal: %w", err)
+		}
+
+		subscription, err := r.w.CreateWebhook(context.Background(), &request)
+		if err != nil {
+			return nil, remoteError("webhookRoutes - createWebhook - r.w.CreateWebhook", err)
+		}
+
+		return subscription, nil
+	}
+}
+
+// Handles a remote "getWebhooks" call.
+func (r *webhookRoutes) getWebhooks() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WebhookRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - getWebhooks - json.Unmarshal: %w", err)
+		}
+
+		subscriptions, err := r.w.GetWebhooks(context.Background(), request.Owner)
+		if err != nil {
+			return nil, remoteError("webhookRoutes - getWebhooks - r.w.GetWebhooks", err)
+		}
+
+		return subscriptions, nil
+	}
+}
+
+// Handles a remote "getWebhookByID" call.
+func (r *webhookRoutes) getWebhookByID() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WebhookRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - getWebhookByID - json.Unmarshal: %w", err)
+		}
+
+		subscription, err := r.w.GetWebhookByID(context.Background(), request.Owner, request.WebhookID)
+		if err != nil {
+			return nil, remoteError("webhookRoutes - getWebhookByID - r.w.GetWebhookByID", err)
+		}
+
+		return subscription, nil
+	}
+}
+
+// Handles a remote "deleteWebhook" call.
+func (r *webhookRoutes) deleteWebhook() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WebhookRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - deleteWebhook - json.Unmarshal: %w", err)
+		}
+
+		if err := r.w.DeleteWebhook(context.Background(), request.Owner, request.WebhookID); err != nil {
+			return nil, remoteError("webhookRoutes - deleteWebhook - r.w.DeleteWebhook", err)
+		}
+
+		return nil, nil
+	}
+}
+
+// Handles a remote "getWebhookDeliveries" call.
+func (r *webhookRoutes) getWebhookDeliveries() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.WebhookDeliveriesRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - getWebhookDeliveries - json.Unmarshal: %w", err)
+		}
+
+		deliveries, err := r.w.GetWebhookDeliveries(context.Background(), request.Owner, request.WebhookID, request.Filter)
+		if err != nil {
+			return nil, remoteError("webhookRoutes - getWebhookDeliveries - r.w.GetWebhookDeliveries", err)
+		}
+
+		return deliveries, nil
+	}
+}
+
+// Handles a remote "replayWebhookDelivery" call.
+func (r *webhookRoutes) replayWebhookDelivery() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.ReplayWebhookDeliveryRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - webhookRoutes - replayWebhookDelivery - json.Unmarshal: %w", err)
+		}
+
+		delivery, err := r.w.ReplayWebhookDelivery(context.Background(), request.Owner, request.WebhookID, request.DeliveryID)
+		if err != nil {
+			return nil, remoteError("webhookRoutes - replayWebhookDelivery - r.w.ReplayWebhookDelivery", err)
+		}
+
+		return delivery, nil
+	}
+}
@@ -3,7 +3,6 @@ package amqprpc
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 
 	"github.com/egor-denisov/wallet-rielta/internal/entity"
@@ -22,6 +21,9 @@ func newWalletWorkerRoutes(routes map[string]server.CallHandler, w usecase.Walle
 	{
 		routes["createNewWallet"] = r.createNewWalletWithBalance()
 		routes["sendFunds"] = r.sendFunds()
+		routes["sendFundsBatch"] = r.sendFundsBatch()
+		routes["refundTransaction"] = r.refundTransaction()
+		routes["getTransactionByID"] = r.getTransactionByID()
 		routes["getWalletHistoryByID"] = r.getWalletHistoryByID()
 		routes["getWalletByID"] = r.getWalletByID()
 	}
@@ -36,10 +38,19 @@ func (r *walletWorkerRoutes) createNewWalletWithBalance() server.CallHandler {
 			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - createNewWalletWithBalance - json.Unmarshal: %w", err)
 		}
 
-		wallet, err := r.w.CreateNewWalletWithBalance(context.Background(), request.Balance)
+		if request.Balance < 0 {
+			return nil, entity.ErrWrongAmount
+		}
+
+		wallet, err := r.w.CreateNewWalletWithBalance(
+			context.Background(),
+			request.Balance,
+			request.Currency,
+			request.Type,
+			request.OwnerID,
+		)
 		if err != nil {
-			return nil,
-				fmt.Errorf("amqp_rpc - walletWorkerRoutes - createNewWalletWithBalance - r.w.CreateNewWalletWithBalance: %w", err)
+			return nil, remoteError("walletWorkerRoutes - createNewWalletWithBalance - r.w.CreateNewWalletWithBalance", err)
 		}
 
 		return wallet, nil
@@ -55,16 +66,81 @@ func (r *walletWorkerRoutes) sendFunds() server.CallHandler {
 			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - sendFunds - json.Unmarshal: %w", err)
 		}
 
-		err := r.w.SendFunds(context.Background(), request.From, request.To, request.Amount)
+		if !request.Amount.IsPositive() {
+			return nil, entity.ErrWrongAmount
+		}
+
+		transaction, err := r.w.SendFunds(
+			context.Background(),
+			request.From,
+			request.To,
+			request.Amount,
+			request.TransferDetails,
+			request.IdempotencyKey,
+		)
+		if err != nil {
+			return nil, remoteError("walletWorkerRoutes - sendFunds - r.w.SendFunds", err)
+		}
+
+		return transaction, nil
+	}
+}
+
+// Handles a remote "sendFundsBatch" call.
+func (r *walletWorkerRoutes) sendFundsBatch() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.SendFundsBatchRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - sendFundsBatch - json.Unmarshal: %w", err)
+		}
+
+		transactions, err := r.w.SendFundsBatch(context.Background(), request.Legs)
+		if err != nil {
+			return nil, remoteError("walletWorkerRoutes - sendFundsBatch - r.w.SendFundsBatch", err)
+		}
+
+		return transactions, nil
+	}
+}
+
+// Handles a remote "getTransactionByID" call.
+func (r *walletWorkerRoutes) getTransactionByID() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.GetTransactionByIDRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - getTransactionByID - json.Unmarshal: %w", err)
+		}
+
+		transaction, err := r.w.GetTransactionByID(context.Background(), request.TransactionID)
 		if err != nil {
-			if errors.Is(err, entity.ErrWalletNotFound) {
-				return nil, entity.ErrNotFound
-			}
+			return nil, remoteError("walletWorkerRoutes - getTransactionByID - r.w.GetTransactionByID", err)
+		}
 
-			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - sendFunds - r.w.SendFunds: %w", err)
+		return transaction, nil
+	}
+}
+
+// Handles a remote "refundTransaction" call.
+func (r *walletWorkerRoutes) refundTransaction() server.CallHandler {
+	return func(d *amqp.Delivery) (interface{}, error) {
+		var request entity.RefundTransactionRequest
+
+		if err := json.Unmarshal(d.Body, &request); err != nil {
+			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - refundTransaction - json.Unmarshal: %w", err)
+		}
+
+		if request.Amount < 0 {
+			return nil, entity.ErrWrongAmount
+		}
+
+		refund, err := r.w.RefundTransaction(context.Background(), request.TransactionID, request.Amount)
+		if err != nil {
+			return nil, remoteError("walletWorkerRoutes - refundTransaction - r.w.RefundTransaction", err)
 		}
 
-		return nil, nil
+		return refund, nil
 	}
 }
 
@@ -77,16 +153,12 @@ func (r *walletWorkerRoutes) getWalletHistoryByID() server.CallHandler {
 			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - GetWalletHistoryByID - json.Unmarshal: %w", err)
 		}
 
-		transactions, err := r.w.GetWalletHistoryByID(context.Background(), request.WalletID)
+		page, err := r.w.GetWalletHistoryByID(context.Background(), request.WalletID, request.Filter)
 		if err != nil {
-			if errors.Is(err, entity.ErrWalletNotFound) {
-				return nil, entity.ErrNotFound
-			}
-
-			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - GetWalletHistoryByID - r.w.GetWalletHistoryByID: %w", err)
+			return nil, remoteError("walletWorkerRoutes - GetWalletHistoryByID - r.w.GetWalletHistoryByID", err)
 		}
 
-		return transactions, nil
+		return page, nil
 	}
 }
 
@@ -101,11 +173,7 @@ func (r *walletWorkerRoutes) getWalletByID() server.CallHandler {
 
 		wallet, err := r.w.GetWalletByID(context.Background(), request.WalletID)
 		if err != nil {
-			if errors.Is(err, entity.ErrWalletNotFound) {
-				return nil, entity.ErrNotFound
-			}
-
-			return nil, fmt.Errorf("amqp_rpc - walletWorkerRoutes - GetWalletByID - r.w.GetWalletByID: %w", err)
+			return nil, remoteError("walletWorkerRoutes - GetWalletByID - r.w.GetWalletByID", err)
 		}
 
 		return wallet, nil
@@ -0,0 +1,44 @@
+// Package events publishes the outbox events of the wallets to the RabbitMQ topic exchange.
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/publisher"
+)
+
+const _contentType = "application/json"
+
+// Publisher - the sink of the messages, which waits for their confirmation.
+type Publisher interface {
+	Publish(ctx context.Context, msg publisher.Message) error
+}
+
+type Gateway struct {
+	publisher Publisher
+}
+
+func New(p Publisher) *Gateway {
+	return &Gateway{p}
+}
+
+// Publishing the event with its routing key. The id of the event is the message id,
+// so the consumers are able to skip the events, which are published twice.
+func (gw *Gateway) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	err := gw.publisher.Publish(ctx, publisher.Message{
+		RoutingKey:  event.RoutingKey,
+		MessageID:   strconv.FormatInt(event.ID, 10),
+		Type:        event.EventType,
+		ContentType: _contentType,
+		Timestamp:   event.CreatedAt,
+		Body:        event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("EventsGateway - Publish - gw.publisher.Publish: %w", err)
+	}
+
+	return nil
+}
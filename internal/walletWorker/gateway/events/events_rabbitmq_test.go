@@ -0,0 +1,85 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/rabbitmq/publisher"
+	"github.com/magiconair/properties/assert"
+)
+
+var errBroker = errors.New("broker is unavailable")
+
+type recorder struct {
+	messages []publisher.Message
+	err      error
+}
+
+func (r *recorder) Publish(_ context.Context, msg publisher.Message) error {
+	r.messages = append(r.messages, msg)
+	return r.err
+}
+
+func Test_Publish(t *testing.T) {
+	for _, test := range testsPublish {
+		t.Run(test.name, func(t *testing.T) {
+			sink := &recorder{err: test.publishErr}
+
+			err := New(sink).Publish(context.Background(), test.event)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, sink.messages, []publisher.Message{test.expectedMessage})
+		})
+	}
+}
+
+var testsPublish = []struct {
+	name            string
+	event           *entity.OutboxEvent
+	publishErr      error
+	expectedError   error
+	expectedMessage publisher.Message
+}{
+	{
+		name: "Ok",
+		event: &entity.OutboxEvent{
+			ID:         42,
+			RoutingKey: "wallet.5b53700ed469fa6a09ea72bb78f36fd9.credited",
+			EventType:  "wallet.credited",
+			Payload:    []byte(`{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9"}`),
+			CreatedAt:  time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC),
+		},
+		expectedError: nil,
+		expectedMessage: publisher.Message{
+			RoutingKey:  "wallet.5b53700ed469fa6a09ea72bb78f36fd9.credited",
+			MessageID:   "42",
+			Type:        "wallet.credited",
+			ContentType: "application/json",
+			Timestamp:   time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC),
+			Body:        []byte(`{"walletId":"5b53700ed469fa6a09ea72bb78f36fd9"}`),
+		},
+	},
+	{
+		name: "Broker is unavailable",
+		event: &entity.OutboxEvent{
+			ID:         43,
+			RoutingKey: "wallet.5b53700ed469fa6a09ea72bb78f36fd9.debited",
+			EventType:  "wallet.debited",
+			Payload:    []byte(`{}`),
+		},
+		publishErr:    errBroker,
+		expectedError: errBroker,
+		expectedMessage: publisher.Message{
+			RoutingKey:  "wallet.5b53700ed469fa6a09ea72bb78f36fd9.debited",
+			MessageID:   "43",
+			Type:        "wallet.debited",
+			ContentType: "application/json",
+			Body:        []byte(`{}`),
+		},
+	},
+}
@@ -0,0 +1,58 @@
+package funding
+
+import (
+	"context"
+	"errors"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/google/uuid"
+)
+
+const _fakeProviderName = "fake"
+
+var ErrWrongFakeStatus = errors.New("funding - fake - wrong status")
+
+// FakeProvider - local funding provider, which settles every deposit and withdrawal
+// with the configured status: completed, failed or pending.
+type FakeProvider struct {
+	status string
+}
+
+func NewFake(status string) (*FakeProvider, error) {
+	result := entity.FundingResult{Status: status}
+	if !result.IsValid() {
+		return nil, ErrWrongFakeStatus
+	}
+
+	return &FakeProvider{status}, nil
+}
+
+func (p *FakeProvider) Name() string {
+	return _fakeProviderName
+}
+
+func (p *FakeProvider) Deposit(_ context.Context, _ *entity.Transaction) (*entity.FundingResult, error) {
+	return p.result(), nil
+}
+
+func (p *FakeProvider) Withdraw(_ context.Context, _ *entity.Transaction) (*entity.FundingResult, error) {
+	return p.result(), nil
+}
+
+// Status - the operations are settled at once, so the outcome is the configured status.
+func (p *FakeProvider) Status(_ context.Context, transaction *entity.Transaction) (*entity.FundingResult, error) {
+	result := p.result()
+	if transaction.ProviderReference != "" {
+		result.Reference = transaction.ProviderReference
+	}
+
+	return result, nil
+}
+
+// Creating the outcome of the operation with the unique reference.
+func (p *FakeProvider) result() *entity.FundingResult {
+	return &entity.FundingResult{
+		Status:    p.status,
+		Reference: _fakeProviderName + "-" + uuid.NewString(),
+	}
+}
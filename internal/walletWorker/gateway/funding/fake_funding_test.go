@@ -0,0 +1,45 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_FakeProvider(t *testing.T) {
+	for _, status := range []string{
+		entity.TransactionStatusCompleted,
+		entity.TransactionStatusFailed,
+		entity.TransactionStatusPending,
+	} {
+		t.Run(status, func(t *testing.T) {
+			provider, err := NewFake(status)
+			if err != nil {
+				t.Fatalf("expected nil, got %v", err)
+			}
+
+			deposit, _ := provider.Deposit(context.Background(), &entity.Transaction{})
+			withdrawal, _ := provider.Withdraw(context.Background(), &entity.Transaction{})
+
+			assert.Equal(t, deposit.Status, status)
+			assert.Equal(t, withdrawal.Status, status)
+			assert.Equal(t, strings.HasPrefix(deposit.Reference, "fake-"), true)
+			assert.Equal(t, deposit.Reference != withdrawal.Reference, true)
+
+			settled, _ := provider.Status(context.Background(), &entity.Transaction{ProviderReference: deposit.Reference})
+
+			assert.Equal(t, settled.Status, status)
+			assert.Equal(t, settled.Reference, deposit.Reference)
+		})
+	}
+}
+
+func Test_NewFake_WrongStatus(t *testing.T) {
+	if _, err := NewFake("succeed"); !errors.Is(err, ErrWrongFakeStatus) {
+		t.Errorf("expected %v, got %v", ErrWrongFakeStatus, err)
+	}
+}
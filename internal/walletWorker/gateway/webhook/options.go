@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"time"
+)
+
+type Option func(*Sender)
+
+// Timeout - the time limit of the delivery request.
+func Timeout(timeout time.Duration) Option {
+	return func(s *Sender) {
+		s.client.Timeout = timeout
+	}
+}
+
+// PrivateNetworks - allowing the delivery to the loopback and private addresses, e.g. to the local subscribers in the tests.
+func PrivateNetworks() Option {
+	return func(s *Sender) {
+		s.allowPrivate = true
+	}
+}
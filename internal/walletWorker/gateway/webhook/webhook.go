@@ -0,0 +1,138 @@
+// Package webhook implements sending of the wallet events to the subscribers signed with HMAC-SHA256.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Headers of the delivery request.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+const (
+	_defaultTimeout = 10 * time.Second
+	_dialTimeout    = 5 * time.Second
+	// Only the beginning of the response is read, the subscriber must answer with 2xx status
+	_maxResponseSize = 4 << 10
+)
+
+var (
+	ErrNoSubscription = errors.New("webhook - delivery without subscription")
+	ErrRejected       = errors.New("webhook - delivery is rejected")
+	ErrForbiddenAddr  = errors.New("webhook - address is not public")
+)
+
+type Sender struct {
+	client       *http.Client
+	now          func() time.Time
+	allowPrivate bool
+}
+
+func NewSender(opts ...Option) *Sender {
+	s := &Sender{
+		client: &http.Client{
+			Timeout: _defaultTimeout,
+			// The redirects are not followed, so the events are sent only to the registered url
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	dialer := &net.Dialer{Timeout: _dialTimeout, Control: s.checkAddr}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The proxy would be dialed instead of the subscriber, so its address could not be checked
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	s.client.Transport = transport
+
+	return s
+}
+
+// Checking the resolved address right before connecting,
+// so the names pointing to the internal networks are rejected too.
+func (s *Sender) checkAddr(_, address string, _ syscall.RawConn) error {
+	if s.allowPrivate {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("webhook - checkAddr - netip.ParseAddrPort: %w", err)
+	}
+
+	if !entity.IsPublicWebhookAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddr, addrPort.Addr())
+	}
+
+	return nil
+}
+
+// Send - posting the payload of the delivery to the url of its subscription.
+// Returns the status code of the response, which is zero if the subscriber is unavailable.
+func (s *Sender) Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	if delivery.Subscription == nil {
+		return 0, ErrNoSubscription
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("webhook - Send - http.NewRequestWithContext: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "wallet-webhooks/1.0")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Subscription.Secret, s.now(), delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook - Send - s.client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+	// Reading the response, so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, _maxResponseSize))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("%w: status %d", ErrRejected, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign - getting the signature header of the payload: "t=<unix time>,v1=<hex HMAC-SHA256>".
+// The time and the payload are signed as "<unix time>.<payload>", so the subscriber can reject the old requests.
+func Sign(secret string, at time.Time, payload []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
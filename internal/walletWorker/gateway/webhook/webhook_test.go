@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+const testSecret = "whsec_test"
+
+var testPayload = []byte(`{"id":"6b1d3e0a-2c4f-4a8b-9d7e-1f0a2b3c4d5e","type":"transfer.completed"}`)
+
+// Verifying the signature of the request the same way as the subscriber does.
+func verify(t *testing.T, r *http.Request, body []byte) {
+	t.Helper()
+
+	parts := strings.Split(r.Header.Get(SignatureHeader), ",")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "t=") || !strings.HasPrefix(parts[1], "v1=") {
+		t.Fatalf("malformed signature header %q", r.Header.Get(SignatureHeader))
+	}
+
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(strings.TrimPrefix(parts[0], "t=") + "." + string(body)))
+
+	if !hmac.Equal([]byte(strings.TrimPrefix(parts[1], "v1=")), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+		t.Errorf("wrong signature %q", parts[1])
+	}
+}
+
+func Test_SenderSend(t *testing.T) {
+	for _, test := range testsSenderSend {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				verify(t, r, body)
+				assert.Equal(t, r.Method, http.MethodPost)
+				assert.Equal(t, r.Header.Get("Content-Type"), "application/json")
+				assert.Equal(t, r.Header.Get(EventHeader), entity.WebhookEventTransferCompleted)
+				assert.Equal(t, r.Header.Get(DeliveryHeader), "42")
+				assert.Equal(t, string(body), string(testPayload))
+
+				if test.redirect {
+					http.Redirect(w, r, "/other", http.StatusFound)
+					return
+				}
+
+				w.WriteHeader(test.responseStatus)
+			}))
+			defer server.Close()
+
+			statusCode, err := NewSender(PrivateNetworks()).Send(context.Background(), &entity.WebhookDelivery{
+				ID:        42,
+				EventType: entity.WebhookEventTransferCompleted,
+				Payload:   testPayload,
+				Subscription: &entity.WebhookSubscription{
+					URL:    server.URL + "/hooks",
+					Secret: testSecret,
+				},
+			})
+
+			assert.Equal(t, statusCode, test.expectedStatusCode)
+			assert.Equal(t, errors.Is(err, ErrRejected), test.expectedRejected)
+		})
+	}
+}
+
+var testsSenderSend = []struct {
+	name               string
+	responseStatus     int
+	redirect           bool
+	expectedStatusCode int
+	expectedRejected   bool
+}{
+	{
+		name:               "Delivered",
+		responseStatus:     http.StatusOK,
+		expectedStatusCode: http.StatusOK,
+	},
+	{
+		name:               "Delivered without content",
+		responseStatus:     http.StatusNoContent,
+		expectedStatusCode: http.StatusNoContent,
+	},
+	{
+		name:               "Subscriber error",
+		responseStatus:     http.StatusServiceUnavailable,
+		expectedStatusCode: http.StatusServiceUnavailable,
+		expectedRejected:   true,
+	},
+	{
+		name:               "Redirect is not followed",
+		redirect:           true,
+		expectedStatusCode: http.StatusFound,
+		expectedRejected:   true,
+	},
+}
+
+func Test_SenderSend_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	statusCode, err := NewSender(Timeout(10*time.Millisecond), PrivateNetworks()).Send(context.Background(), &entity.WebhookDelivery{
+		Payload:      testPayload,
+		Subscription: &entity.WebhookSubscription{URL: server.URL, Secret: testSecret},
+	})
+
+	assert.Equal(t, statusCode, 0)
+	assert.Equal(t, err != nil && !errors.Is(err, ErrRejected), true)
+}
+
+func Test_SenderSend_PrivateAddr(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("the request is sent to the loopback address")
+	}))
+	defer server.Close()
+
+	statusCode, err := NewSender().Send(context.Background(), &entity.WebhookDelivery{
+		Payload:      testPayload,
+		Subscription: &entity.WebhookSubscription{URL: server.URL, Secret: testSecret},
+	})
+
+	assert.Equal(t, statusCode, 0)
+	assert.Equal(t, errors.Is(err, ErrForbiddenAddr), true)
+}
+
+func Test_SenderSend_NoSubscription(t *testing.T) {
+	if _, err := NewSender().Send(context.Background(), &entity.WebhookDelivery{}); !errors.Is(err, ErrNoSubscription) {
+		t.Errorf("expected %v, got %v", ErrNoSubscription, err)
+	}
+}
+
+func Test_Sign(t *testing.T) {
+	at := time.Date(2024, 5, 7, 12, 0, 0, 0, time.UTC)
+
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte("1715083200." + string(testPayload)))
+
+	assert.Equal(t, Sign(testSecret, at, testPayload), "t=1715083200,v1="+hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,98 @@
+// Package metrics exposes the outcomes of the background jobs of the worker as Prometheus metrics.
+package metrics
+
+import (
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	_namespace = "wallet"
+	_subsystem = "reconciliation"
+)
+
+// Reconciliation - the metrics of the last reconciliation of the balances.
+type Reconciliation struct {
+	lastRun        prometheus.Gauge
+	duration       prometheus.Gauge
+	walletsChecked prometheus.Gauge
+	driftedWallets *prometheus.GaugeVec
+	driftAmount    *prometheus.GaugeVec
+}
+
+func NewReconciliation(registerer prometheus.Registerer) *Reconciliation {
+	m := &Reconciliation{
+		lastRun: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: _namespace,
+			Subsystem: _subsystem,
+			Name:      "last_run_timestamp_seconds",
+			Help:      "Time of the end of the last reconciliation.",
+		}),
+		duration: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: _namespace,
+			Subsystem: _subsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration of the last reconciliation.",
+		}),
+		walletsChecked: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: _namespace,
+			Subsystem: _subsystem,
+			Name:      "wallets_checked",
+			Help:      "Wallets checked by the last reconciliation.",
+		}),
+		driftedWallets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: _namespace,
+			Subsystem: _subsystem,
+			Name:      "drifted_wallets",
+			Help:      "Wallets, which balance differs from the expected one by the source.",
+		}, []string{"source"}),
+		driftAmount: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: _namespace,
+			Subsystem: _subsystem,
+			Name:      "drift_amount",
+			Help:      "Sum of the absolute drifts in the minor units of the currency by the source.",
+		}, []string{"source", "currency"}),
+	}
+
+	registerer.MustRegister(m.lastRun, m.duration, m.walletsChecked, m.driftedWallets, m.driftAmount)
+
+	return m
+}
+
+// Observe - replacing the metrics by the outcome of the reconciliation.
+func (m *Reconciliation) Observe(report *entity.ReconciliationReport) {
+	m.lastRun.Set(float64(report.FinishedAt.Unix()))
+	m.duration.Set(report.FinishedAt.Sub(report.StartedAt).Seconds())
+	m.walletsChecked.Set(float64(report.WalletsChecked))
+
+	m.driftedWallets.Reset()
+	m.driftAmount.Reset()
+
+	// The sources are always exposed, so the absence of the drifts is visible
+	for _, source := range []string{entity.ReconciliationSourceLedger, entity.ReconciliationSourceTransactions} {
+		m.driftedWallets.WithLabelValues(source).Set(0)
+	}
+
+	// The drifted wallets are listed in the report, so the metrics are only aggregated by the source
+	for _, drift := range report.Drifts {
+		for source, amount := range map[string]entity.Money{
+			entity.ReconciliationSourceLedger:       drift.LedgerDrift,
+			entity.ReconciliationSourceTransactions: drift.TransactionsDrift,
+		} {
+			if amount == 0 {
+				continue
+			}
+
+			m.driftedWallets.WithLabelValues(source).Inc()
+			m.driftAmount.WithLabelValues(source, drift.Currency).Add(abs(amount))
+		}
+	}
+}
+
+func abs(amount entity.Money) float64 {
+	if amount < 0 {
+		return float64(-amount)
+	}
+
+	return float64(amount)
+}
@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func Test_ReconciliationObserve(t *testing.T) {
+	for _, test := range testsReconciliationObserve {
+		t.Run(test.name, func(t *testing.T) {
+			registry := prometheus.NewRegistry()
+			m := NewReconciliation(registry)
+
+			for _, report := range test.reports {
+				m.Observe(report)
+			}
+
+			err := testutil.GatherAndCompare(registry, strings.NewReader(test.expected),
+				"wallet_reconciliation_drifted_wallets",
+				"wallet_reconciliation_drift_amount",
+				"wallet_reconciliation_wallets_checked",
+				"wallet_reconciliation_wallet_drift",
+			)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+var _reconciliationStartedAt = time.Date(2024, 2, 4, 17, 25, 35, 0, time.UTC)
+
+var testsReconciliationObserve = []struct {
+	name     string
+	reports  []*entity.ReconciliationReport
+	expected string
+}{
+	{
+		name: "Ok",
+		reports: []*entity.ReconciliationReport{
+			{
+				StartedAt:      _reconciliationStartedAt,
+				FinishedAt:     _reconciliationStartedAt.Add(2 * time.Second),
+				WalletsChecked: 10,
+				DriftedWallets: 2,
+				Drifts: []entity.WalletDrift{
+					{WalletID: "w1", Currency: "USD", LedgerDrift: 50, TransactionsDrift: -30},
+					{WalletID: "w2", Currency: "USD", TransactionsDrift: 20},
+				},
+			},
+		},
+		expected: `
+# HELP wallet_reconciliation_drift_amount Sum of the absolute drifts in the minor units of the currency by the source.
+# TYPE wallet_reconciliation_drift_amount gauge
+wallet_reconciliation_drift_amount{currency="USD",source="ledger"} 50
+wallet_reconciliation_drift_amount{currency="USD",source="transactions"} 50
+# HELP wallet_reconciliation_drifted_wallets Wallets, which balance differs from the expected one by the source.
+# TYPE wallet_reconciliation_drifted_wallets gauge
+wallet_reconciliation_drifted_wallets{source="ledger"} 1
+wallet_reconciliation_drifted_wallets{source="transactions"} 2
+# HELP wallet_reconciliation_wallets_checked Wallets checked by the last reconciliation.
+# TYPE wallet_reconciliation_wallets_checked gauge
+wallet_reconciliation_wallets_checked 10
+`,
+	},
+	{
+		name: "Drifts are fixed",
+		reports: []*entity.ReconciliationReport{
+			{
+				WalletsChecked: 10,
+				DriftedWallets: 1,
+				Drifts:         []entity.WalletDrift{{WalletID: "w1", Currency: "EUR", LedgerDrift: 5}},
+			},
+			{
+				WalletsChecked: 11,
+			},
+		},
+		expected: `
+# HELP wallet_reconciliation_drifted_wallets Wallets, which balance differs from the expected one by the source.
+# TYPE wallet_reconciliation_drifted_wallets gauge
+wallet_reconciliation_drifted_wallets{source="ledger"} 0
+wallet_reconciliation_drifted_wallets{source="transactions"} 0
+# HELP wallet_reconciliation_wallets_checked Wallets checked by the last reconciliation.
+# TYPE wallet_reconciliation_wallets_checked gauge
+wallet_reconciliation_wallets_checked 11
+`,
+	},
+}
@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Escaping the special characters of the LIKE pattern.
+var _likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// RecordAuditEvent - appending the event to the audit log. The id and the time are set by the db.
+func (r *WalletRepo) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	if _, err := r.DB.ModelContext(ctx, event).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - RecordAuditEvent - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditEvents - getting the page of the audit log by the filter, from the newest events to the oldest.
+// The resource of the filter is matched as the prefix, so all actions on the wallet are found by its path.
+func (r *WalletRepo) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	var events []entity.AuditEvent
+
+	query := r.DB.ModelContext(ctx, &events)
+
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if filter.Resource != "" {
+		query = query.Where(`resource LIKE ? ESCAPE '\'`, _likeEscaper.Replace(filter.Resource)+"%")
+	}
+
+	if filter.Outcome != "" {
+		query = query.Where("outcome = ?", filter.Outcome)
+	}
+
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where("time >= ?", filter.Since)
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where("time < ?", filter.Until)
+	}
+
+	if filter.Cursor > 0 {
+		query = query.Where("id < ?", filter.Cursor)
+	}
+
+	// One more event is selected to find out whether the next page exists
+	pageSize := filter.PageSize()
+
+	err := query.
+		Order("id DESC").
+		Limit(pageSize + 1).
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetAuditEvents - r.DB: %w", err)
+	}
+
+	page := &entity.AuditPage{Events: events}
+
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		page.NextCursor = page.Events[pageSize-1].ID
+	}
+
+	if page.Events == nil {
+		page.Events = []entity.AuditEvent{}
+	}
+
+	return page, nil
+}
+
+// Appending the action of the worker to the audit log inside the db transaction,
+// so the action is logged only if its changes are committed.
+func (r *WalletRepo) writeAuditEvent(
+	ctx context.Context,
+	tx *postgres.Tx,
+	action string,
+	resource string,
+	data interface{},
+) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("WalletRepo - writeAuditEvent - json.Marshal: %w", err)
+	}
+
+	event := &entity.AuditEvent{
+		Actor:    entity.AuditActorSystem,
+		Action:   action,
+		Resource: resource,
+		Outcome:  entity.AuditOutcomeSuccess,
+		Payload:  payload,
+	}
+
+	if _, err := tx.ModelContext(ctx, event).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - writeAuditEvent - tx: %w", err)
+	}
+
+	return nil
+}
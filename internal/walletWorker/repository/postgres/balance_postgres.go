@@ -0,0 +1,96 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Saving the balances of all wallets at the moment. The balance of every wallet is counted
+// from its previous snapshot, so only the postings since the last run are summed.
+// The wallets without postings since their previous snapshot are skipped, as it still holds their balance.
+const _snapshotBalancesQuery = `
+INSERT INTO wallet_balance_snapshots (wallet_id, time, balance)
+SELECT a.id, ?0, COALESCE(s.balance, 0) + COALESCE(d.amount, 0)
+FROM ledger_accounts AS a
+LEFT JOIN LATERAL (
+    SELECT time, balance FROM wallet_balance_snapshots
+    WHERE wallet_id = a.id AND time <= ?0
+    ORDER BY time DESC
+    LIMIT 1
+) AS s ON true
+LEFT JOIN LATERAL (
+    SELECT SUM(p.amount) AS amount
+    FROM postings AS p
+    JOIN journal_entries AS e ON e.id = p.entry_id
+    WHERE p.account_id = a.id AND e.time > COALESCE(s.time, '-infinity') AND e.time <= ?0
+) AS d ON true
+WHERE a.type = ?1 AND d.amount IS NOT NULL
+ON CONFLICT DO NOTHING`
+
+// GetWalletBalanceAt - getting the balance of the wallet at the moment.
+// The postings of the ledger are the log of all balance changes, starting with the opening entry,
+// which carries the opening balance. They are summed from the latest snapshot before the moment.
+func (r *WalletRepo) GetWalletBalanceAt(
+	ctx context.Context,
+	walletID string,
+	at time.Time,
+) (*entity.HistoricalBalance, error) {
+	wallet, err := r.GetWalletByID(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := new(entity.BalanceSnapshot)
+
+	err = r.DB.ModelContext(ctx, snapshot).
+		Where("wallet_id = ?", walletID).
+		Where("time <= ?", at).
+		Order("time DESC").
+		Limit(1).
+		Select()
+	if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+		return nil, fmt.Errorf("WalletRepo - GetWalletBalanceAt - r.DB: %w", err)
+	}
+
+	var changes entity.Money
+
+	query := r.DB.ModelContext(ctx, new(entity.Posting)).
+		ColumnExpr("COALESCE(SUM(posting.amount), 0)").
+		Join("JOIN journal_entries AS e ON e.id = posting.entry_id").
+		Where("posting.account_id = ?", walletID).
+		Where("e.time <= ?", at)
+
+	if !snapshot.Time.IsZero() {
+		query = query.Where("e.time > ?", snapshot.Time)
+	}
+
+	if err = query.Select(&changes); err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetWalletBalanceAt - r.DB: %w", err)
+	}
+
+	balance, err := snapshot.Balance.Add(changes)
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetWalletBalanceAt - snapshot.Balance.Add: %w", err)
+	}
+
+	return &entity.HistoricalBalance{
+		WalletID: walletID,
+		At:       at,
+		Balance:  balance,
+		Currency: wallet.Currency,
+	}, nil
+}
+
+// SnapshotBalances - saving the balances of the wallets changed since their previous snapshots.
+func (r *WalletRepo) SnapshotBalances(ctx context.Context, at time.Time) error {
+	if _, err := r.DB.ExecContext(ctx, _snapshotBalancesQuery, at, entity.AccountTypeWallet); err != nil {
+		return fmt.Errorf("WalletRepo - SnapshotBalances - r.DB.ExecContext: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// SendFundsBatch - moving funds of all transfers of the batch in one db transaction.
+// The fees are charged by the index of the transfer, nil fee means the free transfer.
+// If one of the transfers is rejected, then none of them is made
+// and *entity.BatchError with the errors of the rejected transfers is returned.
+func (r *WalletRepo) SendFundsBatch(ctx context.Context, transactions []entity.Transaction, fees []*entity.Transaction) error {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		// Locking all wallets of the batch at once in the order of ids, so the concurrent batches do not deadlock
+		if err := r.lockBatchWallets(ctx, tx, transactions, fees); err != nil {
+			return err
+		}
+
+		batchErr := new(entity.BatchError)
+
+		for i := range transactions {
+			err := r.sendFundsWithFee(ctx, tx, &transactions[i], fees[i])
+			if err == nil {
+				continue
+			}
+			// Checking the rest of the transfers to report all rejected ones
+			domainErr := entity.ToRemoteError(err)
+			if domainErr == nil {
+				return err
+			}
+
+			batchErr.Add(i, domainErr)
+		}
+
+		if len(batchErr.Legs) > 0 {
+			return batchErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("WalletRepo - SendFundsBatch - r.DB.RunInTransaction: %w", err)
+	}
+
+	return nil
+}
+
+// Locking the existing wallets of the batch and of its fees inside the db transaction.
+// Missing wallets are reported by the transfers themselves.
+func (r *WalletRepo) lockBatchWallets(
+	ctx context.Context,
+	tx *postgres.Tx,
+	transactions []entity.Transaction,
+	fees []*entity.Transaction,
+) error {
+	walletIDs := make([]string, 0, 2*len(transactions)+len(fees))
+	for _, transaction := range transactions {
+		walletIDs = append(walletIDs, transaction.From, transaction.To)
+	}
+
+	for _, fee := range fees {
+		if fee != nil {
+			walletIDs = append(walletIDs, fee.To)
+		}
+	}
+
+	var wallets []entity.Wallet
+
+	err := tx.ModelContext(ctx, &wallets).
+		Where("id IN (?)", postgres.In(walletIDs)).
+		Order("id").
+		For("UPDATE").
+		Select()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - lockBatchWallets - tx: %w", err)
+	}
+
+	return nil
+}
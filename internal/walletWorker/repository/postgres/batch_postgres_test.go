@@ -0,0 +1,53 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SendFundsBatch(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	first, second, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 100), newTestWallet(t, r, 0)
+
+	transactions := []entity.Transaction{
+		{Type: entity.TransactionTypeTransfer, From: first.ID, To: receiver.ID, Amount: 500},
+		{Type: entity.TransactionTypeTransfer, From: second.ID, To: receiver.ID, Amount: 200},
+		{Type: entity.TransactionTypeTransfer, From: first.ID, To: "00000000000000000000000000000000", Amount: 100},
+	}
+	// The batch is rejected as a whole with the errors of all rejected transfers
+	err := r.SendFundsBatch(ctx, transactions, make([]*entity.Transaction, len(transactions)))
+
+	var batchErr *entity.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected %v, got %v", entity.ErrBatchRejected, err)
+	}
+
+	assert.Equal(t, batchErr.Legs, []entity.LegError{
+		{Index: 1, Error: entity.ErrNotEnoughFunds.Error()},
+		{Index: 2, Error: entity.ErrWalletNotFound.Error()},
+	})
+	assert.Equal(t, getTestWallet(t, r, first.ID).Balance, entity.Money(1000))
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(0))
+
+	transactions = []entity.Transaction{
+		{Type: entity.TransactionTypeTransfer, From: first.ID, To: receiver.ID, Amount: 500},
+		{Type: entity.TransactionTypeTransfer, From: second.ID, To: receiver.ID, Amount: 100},
+		{Type: entity.TransactionTypeTransfer, From: receiver.ID, To: first.ID, Amount: 600},
+	}
+	// The transfers are made in the order of the batch, so the received funds can be sent further
+	if err := r.SendFundsBatch(ctx, transactions, make([]*entity.Transaction, len(transactions))); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, getTestWallet(t, r, first.ID).Balance, entity.Money(1100))
+	assert.Equal(t, getTestWallet(t, r, second.ID).Balance, entity.Money(0))
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(0))
+}
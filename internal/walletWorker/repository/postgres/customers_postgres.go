@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// CreateCustomer - creating new customer entry in the db.
+func (r *WalletRepo) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	if _, err := r.DB.ModelContext(ctx, customer).Insert(); err != nil {
+		return nil, fmt.Errorf("WalletRepo - CreateCustomer - r.DB: %w", err)
+	}
+
+	return customer, nil
+}
+
+// GetCustomerByID - getting customer info by customerID.
+func (r *WalletRepo) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	customer := new(entity.Customer)
+
+	err := r.DB.ModelContext(ctx, customer).
+		Where("id = ?", customerID).
+		Select()
+
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrCustomerNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - GetCustomerByID - r.DB: %w", err)
+	}
+
+	return customer, nil
+}
+
+// GetCustomerWallets - getting all wallets owned by the customer.
+func (r *WalletRepo) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	if _, err := r.GetCustomerByID(ctx, customerID); err != nil {
+		return nil, err
+	}
+
+	wallets := make([]entity.Wallet, 0)
+
+	err := r.DB.ModelContext(ctx, &wallets).
+		Where("owner_id = ?", customerID).
+		Order("id").
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetCustomerWallets - r.DB: %w", err)
+	}
+
+	for i := range wallets {
+		wallets[i].Available = wallets[i].AvailableBalance()
+	}
+
+	return wallets, nil
+}
+
+// Checking that the owner of the new wallet exists inside the db transaction.
+// The customer is locked, so it can not be removed until the wallet is created.
+func (r *WalletRepo) checkOwner(ctx context.Context, tx *postgres.Tx, customerID string) error {
+	exists, err := tx.ModelContext(ctx, new(entity.Customer)).
+		Where("id = ?", customerID).
+		For("KEY SHARE").
+		Exists()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - checkOwner - tx: %w", err)
+	}
+
+	if !exists {
+		return entity.ErrCustomerNotFound
+	}
+
+	return nil
+}
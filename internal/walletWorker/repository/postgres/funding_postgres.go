@@ -0,0 +1,186 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// BeginFunding - adding the pending deposit or withdrawal of the wallet.
+// Funds of the withdrawal are debited at once, so they can not be spent
+// while the funding provider is processing it. Withdrawals are limited by the spending limits of the wallet.
+func (r *WalletRepo) BeginFunding(ctx context.Context, transaction *entity.Transaction) error {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		wallets, err := r.lockWallets(ctx, tx, transaction.FundedWallet())
+		if err != nil {
+			return err
+		}
+
+		wallet := &wallets[0]
+		if err := wallet.CheckStatus(); err != nil {
+			return err
+		}
+
+		transaction.Currency = wallet.Currency
+		transaction.Status = entity.TransactionStatusPending
+
+		if transaction.Type == entity.TransactionTypeWithdrawal {
+			balance, err := wallet.AvailableBalance().Sub(transaction.Amount)
+			if err != nil {
+				return err
+			}
+
+			if balance < 0 {
+				return entity.ErrNotEnoughFunds
+			}
+
+			if err := r.checkLimits(ctx, tx, transaction); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ModelContext(ctx, transaction).Insert(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if err := r.writeTransactionEvents(ctx, tx, transaction); err != nil {
+			return err
+		}
+
+		if transaction.Type != entity.TransactionTypeWithdrawal {
+			return nil
+		}
+
+		return r.postFunding(ctx, tx, transaction, entity.EntryTypeWithdrawal)
+	})
+	if err != nil {
+		return fmt.Errorf("WalletRepo - BeginFunding - r.DB.RunInTransaction: %w", err)
+	}
+
+	return nil
+}
+
+// FinishFunding - settling the pending deposit or withdrawal by the outcome of the funding provider.
+// Funds of the completed deposit are credited to the wallet,
+// funds of the failed withdrawal are returned back to the wallet.
+// Frozen and closed wallets are not credited, so the funding stays pending until the wallet is active again.
+func (r *WalletRepo) FinishFunding(
+	ctx context.Context,
+	transactionID string,
+	result *entity.FundingResult,
+) (*entity.Transaction, error) {
+	transaction := new(entity.Transaction)
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		err := tx.ModelContext(ctx, transaction).
+			Where("id = ?", transactionID).
+			For("UPDATE").
+			Select()
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return entity.ErrTransactionNotFound
+			}
+
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if transaction.Status != entity.TransactionStatusPending {
+			return entity.ErrFundingNotPending
+		}
+
+		entryType := fundingEntryType(transaction.Type, result.Status)
+		if entryType != "" {
+			wallets, err := r.lockWallets(ctx, tx, transaction.FundedWallet())
+			if err != nil {
+				return err
+			}
+
+			if err := wallets[0].CheckStatus(); err != nil {
+				return err
+			}
+		}
+
+		transaction.Status = result.Status
+		transaction.ProviderReference = result.Reference
+
+		_, err = tx.ModelContext(ctx, transaction).
+			Set("status = ?status").
+			Set("provider_reference = ?provider_reference").
+			WherePK().
+			Update()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if err := r.writeTransactionEvents(ctx, tx, transaction); err != nil {
+			return err
+		}
+
+		if entryType == "" {
+			return nil
+		}
+
+		return r.postFunding(ctx, tx, transaction, entryType)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - FinishFunding - r.DB.RunInTransaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Getting the type of the entry, which credits the wallet by the outcome of the funding.
+// Returns the empty string if the outcome does not move the funds.
+func fundingEntryType(transactionType, status string) string {
+	switch {
+	case status == entity.TransactionStatusCompleted && transactionType == entity.TransactionTypeDeposit:
+		return entity.EntryTypeDeposit
+	case status == entity.TransactionStatusFailed && transactionType == entity.TransactionTypeWithdrawal:
+		return entity.EntryTypeReversal
+	}
+
+	return ""
+}
+
+// GetPendingFundings - getting the oldest deposits and withdrawals, which are pending since before the time.
+func (r *WalletRepo) GetPendingFundings(ctx context.Context, before time.Time, limit int) ([]entity.Transaction, error) {
+	var fundings []entity.Transaction
+
+	err := r.DB.ModelContext(ctx, &fundings).
+		Where("status = ?", entity.TransactionStatusPending).
+		Where("type IN (?, ?)", entity.TransactionTypeDeposit, entity.TransactionTypeWithdrawal).
+		Where("time < ?", before).
+		Order("time ASC").
+		Limit(limit).
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetPendingFundings - r.DB: %w", err)
+	}
+
+	return fundings, nil
+}
+
+// Posting the entry between the wallet and the funding system account inside the db transaction.
+// Withdrawal moves funds to the funding account, deposit and reversal move them back to the wallet.
+func (r *WalletRepo) postFunding(
+	ctx context.Context,
+	tx *postgres.Tx,
+	transaction *entity.Transaction,
+	entryType string,
+) error {
+	funding, err := r.systemAccount(ctx, tx, entity.SystemAccountFunding, transaction.Currency)
+	if err != nil {
+		return err
+	}
+
+	walletID := transaction.FundedWallet()
+	if entryType == entity.EntryTypeWithdrawal {
+		return r.postEntry(ctx, tx, entity.NewJournalEntry(entryType, walletID, funding, transaction.Amount))
+	}
+
+	return r.postEntry(ctx, tx, entity.NewJournalEntry(entryType, funding, walletID, transaction.Amount))
+}
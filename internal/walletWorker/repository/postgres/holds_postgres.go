@@ -0,0 +1,275 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// The maximum number of holds, which are expired at one call.
+const _expireHoldsBatch = 1000
+
+// AuthorizeHold - reserving funds of the wallet by the hold.
+// The balance is not changed, only the available balance is decreased.
+func (r *WalletRepo) AuthorizeHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		wallets, err := r.lockWallets(ctx, tx, hold.WalletID)
+		if err != nil {
+			return err
+		}
+
+		if err := wallets[0].CheckStatus(); err != nil {
+			return err
+		}
+
+		if wallets[0].AvailableBalance() < hold.Amount {
+			return entity.ErrNotEnoughFunds
+		}
+
+		hold.Currency = wallets[0].Currency
+		hold.Status = entity.HoldStatusAuthorized
+
+		if _, err := tx.ModelContext(ctx, hold).Insert(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return r.changeHeld(ctx, tx, hold.WalletID, hold.Amount)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - AuthorizeHold - r.DB.RunInTransaction: %w", err)
+	}
+
+	return hold, nil
+}
+
+// CaptureHold - turning the hold into the transfer to the receiver.
+// The whole hold is released, even if only a part of it is captured.
+// If the fee is passed, then it is charged by the linked transaction.
+func (r *WalletRepo) CaptureHold(
+	ctx context.Context,
+	holdID string,
+	to string,
+	amount entity.Money,
+	fee *entity.Transaction,
+) (*entity.Hold, error) {
+	var hold *entity.Hold
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		var err error
+
+		hold, err = r.lockHold(ctx, tx, holdID)
+		if err != nil {
+			return err
+		}
+
+		if !hold.IsActive(time.Now()) {
+			return entity.ErrHoldNotActive
+		}
+
+		if hold.WalletID == to {
+			return entity.ErrSenderIsReceiver
+		}
+
+		if amount == 0 {
+			amount = hold.Amount
+		}
+
+		if amount > hold.Amount {
+			return entity.ErrWrongAmount
+		}
+		// Locking all wallets of the capture in the order of ids before changing any of them,
+		// so the capture does not deadlock with the concurrent transfers
+		if err := r.lockTransferWallets(ctx, tx, hold.WalletID, to, fee); err != nil {
+			return err
+		}
+		// Releasing the reserved funds before moving them
+		if err := r.changeHeld(ctx, tx, hold.WalletID, hold.Amount.Neg()); err != nil {
+			return err
+		}
+
+		transaction := &entity.Transaction{
+			From:   hold.WalletID,
+			To:     to,
+			Amount: amount,
+		}
+
+		if err := r.sendFundsWithFee(ctx, tx, transaction, fee); err != nil {
+			return err
+		}
+
+		hold.Status = entity.HoldStatusCaptured
+		hold.CapturedTo = to
+		hold.CapturedAmount = amount
+
+		return r.updateHold(ctx, tx, hold, "status", "captured_to", "captured_amount")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - CaptureHold - r.DB.RunInTransaction: %w", err)
+	}
+
+	return hold, nil
+}
+
+// VoidHold - releasing the reserved funds of the hold.
+func (r *WalletRepo) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	var hold *entity.Hold
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		var err error
+
+		hold, err = r.lockHold(ctx, tx, holdID)
+		if err != nil {
+			return err
+		}
+
+		if !hold.IsActive(time.Now()) {
+			return entity.ErrHoldNotActive
+		}
+
+		if _, err := r.lockWallets(ctx, tx, hold.WalletID); err != nil {
+			return err
+		}
+
+		if err := r.changeHeld(ctx, tx, hold.WalletID, hold.Amount.Neg()); err != nil {
+			return err
+		}
+
+		hold.Status = entity.HoldStatusVoided
+
+		return r.updateHold(ctx, tx, hold, "status")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - VoidHold - r.DB.RunInTransaction: %w", err)
+	}
+
+	return hold, nil
+}
+
+// ExpireHolds - releasing the reserved funds of the holds, which are expired at the moment.
+// Holds locked by the concurrent capture or void are skipped.
+func (r *WalletRepo) ExpireHolds(ctx context.Context, now time.Time) error {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		var holds []entity.Hold
+		// Ordering by the wallet to release the holds in the deterministic order
+		err := tx.ModelContext(ctx, &holds).
+			Where("status = ?", entity.HoldStatusAuthorized).
+			Where("expires_at <= ?", now).
+			Order("wallet_id", "id").
+			Limit(_expireHoldsBatch).
+			For("UPDATE SKIP LOCKED").
+			Select()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if len(holds) == 0 {
+			return nil
+		}
+		// Locking the wallets of the holds at once in the order of ids, as the transfers do
+		walletIDs := make([]string, 0, len(holds))
+		for i := range holds {
+			walletIDs = append(walletIDs, holds[i].WalletID)
+		}
+
+		if _, err := r.lockWallets(ctx, tx, walletIDs...); err != nil {
+			return err
+		}
+
+		for i := range holds {
+			if err := r.changeHeld(ctx, tx, holds[i].WalletID, holds[i].Amount.Neg()); err != nil {
+				return err
+			}
+
+			holds[i].Status = entity.HoldStatusExpired
+
+			if err := r.updateHold(ctx, tx, &holds[i], "status"); err != nil {
+				return err
+			}
+
+			err := r.enqueueWebhooks(ctx, tx, entity.WebhookEventHoldExpired, &holds[i], holds[i].WalletID)
+			if err != nil {
+				return err
+			}
+
+			err = r.writeAuditEvent(ctx, tx, entity.AuditActionExpireHold, entity.AuditResourceHolds+holds[i].ID, &holds[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("WalletRepo - ExpireHolds - r.DB.RunInTransaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetHoldByID - getting hold info by holdID.
+func (r *WalletRepo) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	hold := new(entity.Hold)
+
+	err := r.DB.ModelContext(ctx, hold).
+		Where("id = ?", holdID).
+		Select()
+
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrHoldNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - GetHoldByID - r.DB: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Locking the hold for update inside the db transaction.
+func (r *WalletRepo) lockHold(ctx context.Context, tx *postgres.Tx, holdID string) (*entity.Hold, error) {
+	hold := new(entity.Hold)
+
+	err := tx.ModelContext(ctx, hold).
+		Where("id = ?", holdID).
+		For("UPDATE").
+		Select()
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrHoldNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - lockHold - tx: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Updating the columns of the hold inside the db transaction.
+func (r *WalletRepo) updateHold(ctx context.Context, tx *postgres.Tx, hold *entity.Hold, columns ...string) error {
+	if _, err := tx.ModelContext(ctx, hold).Column(columns...).WherePK().Update(); err != nil {
+		return fmt.Errorf("WalletRepo - updateHold - tx: %w", err)
+	}
+
+	return nil
+}
+
+// Changing the reserved funds of the wallet inside the db transaction.
+func (r *WalletRepo) changeHeld(ctx context.Context, tx *postgres.Tx, walletID string, amount entity.Money) error {
+	res, err := tx.ModelContext(ctx, new(entity.Wallet)).
+		Set("held = held + ?", amount).
+		Where("id = ?", walletID).
+		Update()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - changeHeld - tx: %w", err)
+	}
+
+	if res.RowsAffected() == 0 {
+		return entity.ErrWalletNotFound
+	}
+
+	return nil
+}
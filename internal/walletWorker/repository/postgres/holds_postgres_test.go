@@ -0,0 +1,130 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_CaptureHold(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	hold, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 300, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, getTestWallet(t, r, sender.ID).Held, entity.Money(300))
+	// The part of the hold is captured, the rest of it is released
+	hold, err = r.CaptureHold(ctx, hold.ID, receiver.ID, 200, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, hold.Status, entity.HoldStatusCaptured)
+	assert.Equal(t, hold.CapturedAmount, entity.Money(200))
+
+	wallet := getTestWallet(t, r, sender.ID)
+	assert.Equal(t, wallet.Balance, entity.Money(800))
+	assert.Equal(t, wallet.Held, entity.Money(0))
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(200))
+
+	if _, err := r.CaptureHold(ctx, hold.ID, receiver.ID, 0, nil); !errors.Is(err, entity.ErrHoldNotActive) {
+		t.Fatalf("expected %v, got %v", entity.ErrHoldNotActive, err)
+	}
+}
+
+func Test_CaptureHold_AboveAmount(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	hold, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 300, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.CaptureHold(ctx, hold.ID, receiver.ID, 301, nil); !errors.Is(err, entity.ErrWrongAmount) {
+		t.Fatalf("expected %v, got %v", entity.ErrWrongAmount, err)
+	}
+	// The failed capture keeps the funds reserved
+	assert.Equal(t, getTestWallet(t, r, sender.ID).Held, entity.Money(300))
+}
+
+func Test_VoidHold(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender := newTestWallet(t, r, 1000)
+
+	hold, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 1000, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The reserved funds are not available for another hold
+	_, err = r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 1, ExpiresAt: time.Now().Add(time.Hour)})
+	if !errors.Is(err, entity.ErrNotEnoughFunds) {
+		t.Fatalf("expected %v, got %v", entity.ErrNotEnoughFunds, err)
+	}
+
+	hold, err = r.VoidHold(ctx, hold.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, hold.Status, entity.HoldStatusVoided)
+
+	wallet := getTestWallet(t, r, sender.ID)
+	assert.Equal(t, wallet.Balance, entity.Money(1000))
+	assert.Equal(t, wallet.Held, entity.Money(0))
+
+	if _, err := r.VoidHold(ctx, hold.ID); !errors.Is(err, entity.ErrHoldNotActive) {
+		t.Fatalf("expected %v, got %v", entity.ErrHoldNotActive, err)
+	}
+}
+
+func Test_ExpireHolds(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender := newTestWallet(t, r, 1000)
+
+	expired, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 300, ExpiresAt: time.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: sender.ID, Amount: 200, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the hold, which is expired by the time, is released
+	if err := r.ExpireHolds(ctx, time.Now().Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	hold, err := r.GetHoldByID(ctx, expired.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, hold.Status, entity.HoldStatusExpired)
+
+	hold, err = r.GetHoldByID(ctx, active.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, hold.Status, entity.HoldStatusAuthorized)
+	assert.Equal(t, getTestWallet(t, r, sender.ID).Held, entity.Money(200))
+}
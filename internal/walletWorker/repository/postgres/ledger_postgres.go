@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Opening the ledger account for the wallet inside the db transaction.
+func (r *WalletRepo) openAccount(ctx context.Context, tx *postgres.Tx, wallet *entity.Wallet) error {
+	account := &entity.LedgerAccount{
+		ID:       wallet.ID,
+		Type:     entity.AccountTypeWallet,
+		Currency: wallet.Currency,
+	}
+
+	if _, err := tx.ModelContext(ctx, account).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - openAccount - tx: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the system account in the currency inside the db transaction.
+// The account is opened at the first use.
+func (r *WalletRepo) systemAccount(ctx context.Context, tx *postgres.Tx, account, currency string) (string, error) {
+	systemAccount := &entity.LedgerAccount{
+		ID:       entity.SystemAccount(account, currency),
+		Type:     entity.AccountTypeSystem,
+		Currency: currency,
+	}
+
+	_, err := tx.ModelContext(ctx, systemAccount).
+		OnConflict("DO NOTHING").
+		Insert()
+	if err != nil {
+		return "", fmt.Errorf("WalletRepo - systemAccount - tx: %w", err)
+	}
+
+	return systemAccount.ID, nil
+}
+
+// Posting the balanced journal entry inside the db transaction.
+// Balances of the wallets are changed only through the postings.
+func (r *WalletRepo) postEntry(ctx context.Context, tx *postgres.Tx, entry *entity.JournalEntry) error {
+	if !entry.IsBalanced() {
+		return entity.ErrUnbalancedEntry
+	}
+
+	if _, err := tx.ModelContext(ctx, entry).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - postEntry - tx: %w", err)
+	}
+
+	for i := range entry.Postings {
+		entry.Postings[i].EntryID = entry.ID
+	}
+
+	if _, err := tx.ModelContext(ctx, &entry.Postings).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - postEntry - tx: %w", err)
+	}
+	// Applying the postings to the balances of the wallets.
+	// Every change of the balance is written to the outbox in the same db transaction.
+	events := make([]entity.OutboxEvent, 0, len(entry.Postings))
+
+	for _, posting := range entry.Postings {
+		if entity.IsSystemAccount(posting.AccountID) {
+			continue
+		}
+
+		wallet := new(entity.Wallet)
+
+		res, err := tx.ModelContext(ctx, wallet).
+			Set("balance = balance + ?", posting.Amount).
+			Where("id = ?", posting.AccountID).
+			Returning("balance, currency").
+			Update()
+		if err != nil {
+			return fmt.Errorf("WalletRepo - postEntry - tx: %w", err)
+		}
+
+		if res.RowsAffected() == 0 {
+			return entity.ErrWalletNotFound
+		}
+
+		event, err := entity.NewBalanceChangeEvent(&entity.BalanceChange{
+			WalletID:  posting.AccountID,
+			Amount:    posting.Amount,
+			Balance:   wallet.Balance,
+			Currency:  wallet.Currency,
+			EntryID:   entry.ID,
+			EntryType: entry.Type,
+			Time:      entry.Time,
+		})
+		if err != nil {
+			return fmt.Errorf("WalletRepo - postEntry - entity.NewBalanceChangeEvent: %w", err)
+		}
+
+		events = append(events, *event)
+	}
+
+	return r.writeOutbox(ctx, tx, events)
+}
+
+// Locking the wallets for update inside the db transaction.
+// Wallets are locked in the deterministic order to avoid deadlocks.
+func (r *WalletRepo) lockWallets(ctx context.Context, tx *postgres.Tx, walletIDs ...string) ([]entity.Wallet, error) {
+	var wallets []entity.Wallet
+
+	err := tx.ModelContext(ctx, &wallets).
+		Where("id IN (?)", postgres.In(walletIDs)).
+		Order("id").
+		For("UPDATE").
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - lockWallets - tx: %w", err)
+	}
+
+	if len(wallets) != len(unique(walletIDs)) {
+		return nil, entity.ErrWalletNotFound
+	}
+
+	return wallets, nil
+}
+
+// Getting the unique values of the slice.
+func unique(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Rolling windows of the spending limits.
+const (
+	_dailyWindow   = 24 * time.Hour
+	_monthlyWindow = 30 * 24 * time.Hour
+)
+
+// GetWalletLimits - getting the spending limits of the wallet.
+// If the limits are not set, then the empty ones are returned.
+func (r *WalletRepo) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	if _, err := r.GetWalletByID(ctx, walletID); err != nil {
+		return nil, err
+	}
+
+	limits := &entity.SpendingLimits{WalletID: walletID}
+
+	err := r.DB.ModelContext(ctx, limits).
+		WherePK().
+		Select()
+	if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+		return nil, fmt.Errorf("WalletRepo - GetWalletLimits - r.DB: %w", err)
+	}
+
+	return limits, nil
+}
+
+// SetWalletLimits - creating or replacing the spending limits of the wallet.
+func (r *WalletRepo) SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error) {
+	if _, err := r.GetWalletByID(ctx, limits.WalletID); err != nil {
+		return nil, err
+	}
+
+	_, err := r.DB.ModelContext(ctx, limits).
+		OnConflict("(wallet_id) DO UPDATE").
+		Set("per_transaction = EXCLUDED.per_transaction").
+		Set("daily = EXCLUDED.daily").
+		Set("monthly = EXCLUDED.monthly").
+		Insert()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - SetWalletLimits - r.DB: %w", err)
+	}
+
+	return limits, nil
+}
+
+// DeleteWalletLimits - removing all spending limits of the wallet.
+func (r *WalletRepo) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	if _, err := r.GetWalletByID(ctx, walletID); err != nil {
+		return err
+	}
+
+	_, err := r.DB.ModelContext(ctx, new(entity.SpendingLimits)).
+		Where("wallet_id = ?", walletID).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - DeleteWalletLimits - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// Checking that the transfer or the withdrawal does not exceed the spending limits of the sender.
+// The sender is locked, so the concurrent transfers are checked one by one.
+func (r *WalletRepo) checkLimits(ctx context.Context, tx *postgres.Tx, transaction *entity.Transaction) error {
+	limits := &entity.SpendingLimits{WalletID: transaction.From}
+
+	err := tx.ModelContext(ctx, limits).
+		WherePK().
+		Select()
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil
+		}
+
+		return fmt.Errorf("WalletRepo - checkLimits - tx: %w", err)
+	}
+
+	windows := []struct {
+		kind   string
+		limit  entity.Money
+		window time.Duration
+	}{
+		{entity.LimitPerTransaction, limits.PerTransaction, 0},
+		{entity.LimitDaily, limits.Daily, _dailyWindow},
+		{entity.LimitMonthly, limits.Monthly, _monthlyWindow},
+	}
+
+	var exceeded *entity.LimitExceededError
+
+	for _, w := range windows {
+		if w.limit == 0 {
+			continue
+		}
+
+		var spent entity.Money
+
+		if w.window > 0 {
+			spent, err = r.spentAmount(ctx, tx, transaction.From, w.window)
+			if err != nil {
+				return err
+			}
+		}
+
+		remaining := max(w.limit-spent, 0)
+		// Reporting the first exceeded limit with the smallest remaining allowance
+		switch {
+		case exceeded != nil:
+			exceeded.Remaining = min(exceeded.Remaining, remaining)
+		case transaction.Amount > remaining:
+			exceeded = &entity.LimitExceededError{Limit: w.kind, Remaining: remaining}
+		}
+	}
+
+	if exceeded != nil {
+		return exceeded
+	}
+
+	return nil
+}
+
+// Getting the sum of the outgoing transfers and withdrawals of the wallet over the rolling window.
+// Failed withdrawals are returned back to the wallet, so they are not counted.
+func (r *WalletRepo) spentAmount(
+	ctx context.Context,
+	tx *postgres.Tx,
+	walletID string,
+	window time.Duration,
+) (entity.Money, error) {
+	var spent entity.Money
+
+	err := tx.ModelContext(ctx, new(entity.Transaction)).
+		ColumnExpr("COALESCE(SUM(amount), 0)").
+		Where("from_wallet_id = ?", walletID).
+		Where("type IN (?, ?)", entity.TransactionTypeTransfer, entity.TransactionTypeWithdrawal).
+		Where("status <> ?", entity.TransactionStatusFailed).
+		Where("time > now() - ? * interval '1 second'", int64(window.Seconds())).
+		Select(&spent)
+	if err != nil {
+		return 0, fmt.Errorf("WalletRepo - spentAmount - tx: %w", err)
+	}
+
+	return spent, nil
+}
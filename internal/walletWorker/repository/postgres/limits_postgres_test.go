@@ -0,0 +1,93 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+// Sending the transfer and checking the exceeded limit. The empty limit means that the transfer is made.
+func checkTestTransfer(t *testing.T, r *WalletRepo, from, to string, amount entity.Money, expected entity.LimitExceededError) {
+	t.Helper()
+
+	err := r.SendFunds(context.Background(), &entity.Transaction{
+		Type:   entity.TransactionTypeTransfer,
+		From:   from,
+		To:     to,
+		Amount: amount,
+	}, nil, nil)
+
+	var limitErr *entity.LimitExceededError
+
+	switch {
+	case expected.Limit == "" && err != nil:
+		t.Fatalf("expected nil, got %v", err)
+	case expected.Limit == "":
+	case !errors.As(err, &limitErr):
+		t.Fatalf("expected %v, got %v", entity.ErrLimitExceeded, err)
+	default:
+		assert.Equal(t, *limitErr, expected)
+	}
+}
+
+func Test_SpendingLimits(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 10000), newTestWallet(t, r, 0)
+
+	_, err := r.SetWalletLimits(ctx, &entity.SpendingLimits{WalletID: sender.ID, PerTransaction: 600, Daily: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkTestTransfer(t, r, sender.ID, receiver.ID, 600, entity.LimitExceededError{})
+	// The first exceeded limit is reported with the smallest remaining allowance
+	checkTestTransfer(t, r, sender.ID, receiver.ID, 601,
+		entity.LimitExceededError{Limit: entity.LimitPerTransaction, Remaining: 400})
+	checkTestTransfer(t, r, sender.ID, receiver.ID, 500, entity.LimitExceededError{Limit: entity.LimitDaily, Remaining: 400})
+	// The withdrawals are spent from the same window
+	withdrawal := &entity.Transaction{Type: entity.TransactionTypeWithdrawal, From: sender.ID, Amount: 300, Provider: "fake"}
+	if err := r.BeginFunding(ctx, withdrawal); err != nil {
+		t.Fatal(err)
+	}
+
+	checkTestTransfer(t, r, sender.ID, receiver.ID, 200, entity.LimitExceededError{Limit: entity.LimitDaily, Remaining: 100})
+
+	exceeding := &entity.Transaction{Type: entity.TransactionTypeWithdrawal, From: sender.ID, Amount: 200, Provider: "fake"}
+
+	var limitErr *entity.LimitExceededError
+	if err := r.BeginFunding(ctx, exceeding); !errors.As(err, &limitErr) {
+		t.Fatalf("expected %v, got %v", entity.ErrLimitExceeded, err)
+	}
+	// The failed withdrawal is returned back to the window
+	_, err = r.FinishFunding(ctx, withdrawal.ID, &entity.FundingResult{Status: entity.TransactionStatusFailed})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkTestTransfer(t, r, sender.ID, receiver.ID, 400, entity.LimitExceededError{})
+	// The refunds are not limited
+	if err := r.DeleteWalletLimits(ctx, sender.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = r.SetWalletLimits(ctx, &entity.SpendingLimits{WalletID: receiver.ID, Daily: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transfer := &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 100}
+	if err := r.SendFunds(ctx, transfer, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.RefundTransaction(ctx, transfer.ID, 0); err != nil {
+		t.Fatal(err)
+	}
+}
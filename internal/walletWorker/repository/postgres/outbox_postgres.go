@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Claiming the unpublished events in the order of their writing.
+// Claimed events are not given to another relay until the lease is over.
+func (r *WalletRepo) ClaimOutboxEvents(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]entity.OutboxEvent, error) {
+	var events []entity.OutboxEvent
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		err := tx.ModelContext(ctx, &events).
+			Where("published_at IS NULL").
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			Order("id").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Select()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		_, err = tx.ModelContext(ctx, new(entity.OutboxEvent)).
+			Set("locked_until = ?", now.Add(lease)).
+			Where("id IN (?)", postgres.In(outboxEventIDs(events))).
+			Update()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - ClaimOutboxEvents - r.DB.RunInTransaction: %w", err)
+	}
+
+	return events, nil
+}
+
+// Marking the events, which are confirmed by the message broker, as published.
+func (r *WalletRepo) MarkOutboxEventsPublished(ctx context.Context, events []entity.OutboxEvent, now time.Time) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := r.DB.ModelContext(ctx, new(entity.OutboxEvent)).
+		Set("published_at = ?", now).
+		Set("locked_until = NULL").
+		Where("id IN (?)", postgres.In(outboxEventIDs(events))).
+		Update()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - MarkOutboxEventsPublished - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// Releasing the claimed events, which are not published, so the next run of the relay publishes them again.
+func (r *WalletRepo) ReleaseOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := r.DB.ModelContext(ctx, new(entity.OutboxEvent)).
+		Set("locked_until = NULL").
+		Where("id IN (?)", postgres.In(outboxEventIDs(events))).
+		Where("published_at IS NULL").
+		Update()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - ReleaseOutboxEvents - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// Removing the events, which are published before the time.
+func (r *WalletRepo) PruneOutboxEvents(ctx context.Context, before time.Time) error {
+	_, err := r.DB.ModelContext(ctx, new(entity.OutboxEvent)).
+		Where("published_at < ?", before).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - PruneOutboxEvents - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// Writing the events inside the db transaction, so the events are published only if the changes are committed.
+func (r *WalletRepo) writeOutbox(ctx context.Context, tx *postgres.Tx, events []entity.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ModelContext(ctx, &events).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - writeOutbox - tx: %w", err)
+	}
+
+	return nil
+}
+
+// Writing the events of the new or the updated transaction inside the db transaction.
+func (r *WalletRepo) writeTransactionEvents(ctx context.Context, tx *postgres.Tx, transaction *entity.Transaction) error {
+	events, err := entity.NewTransactionEvents(transaction)
+	if err != nil {
+		return fmt.Errorf("WalletRepo - writeTransactionEvents - entity.NewTransactionEvents: %w", err)
+	}
+
+	return r.writeOutbox(ctx, tx, events)
+}
+
+// Getting the ids of the events.
+func outboxEventIDs(events []entity.OutboxEvent) []int64 {
+	ids := make([]int64, 0, len(events))
+	for i := range events {
+		ids = append(ids, events[i].ID)
+	}
+
+	return ids
+}
@@ -0,0 +1,96 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+	"github.com/magiconair/properties/assert"
+)
+
+// The limit of the events claimed by the test at once, so all unpublished events are claimed.
+const _testOutboxLimit = 100000
+
+func Test_OutboxEvents(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+	// Publishing the events written before
+	events, err := r.ClaimOutboxEvents(ctx, now, time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.MarkOutboxEventsPublished(ctx, events, now); err != nil {
+		t.Fatal(err)
+	}
+	// The events are written with the transfer
+	transfer := &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 100}
+	if err := r.SendFunds(ctx, transfer, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err = r.ClaimOutboxEvents(ctx, now, time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) == 0 {
+		t.Fatal("no events of the transfer")
+	}
+	// The claimed events are not given to another relay until the lease is over
+	claimed, err := r.ClaimOutboxEvents(ctx, now, time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(claimed), 0)
+
+	claimed, err = r.ClaimOutboxEvents(ctx, now.Add(time.Minute), time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, outboxEventIDs(claimed), outboxEventIDs(events))
+	// The released events are claimed at once
+	if err := r.ReleaseOutboxEvents(ctx, claimed); err != nil {
+		t.Fatal(err)
+	}
+
+	claimed, err = r.ClaimOutboxEvents(ctx, now, time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, outboxEventIDs(claimed), outboxEventIDs(events))
+	// The published events are not claimed again
+	if err := r.MarkOutboxEventsPublished(ctx, claimed, now); err != nil {
+		t.Fatal(err)
+	}
+
+	claimed, err = r.ClaimOutboxEvents(ctx, now.Add(time.Hour), time.Minute, _testOutboxLimit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(claimed), 0)
+	// The published events are removed after the retention
+	if err := r.PruneOutboxEvents(ctx, now.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := r.DB.ModelContext(ctx, (*entity.OutboxEvent)(nil)).
+		Where("id IN (?)", postgres.In(outboxEventIDs(events))).
+		Exists()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, exists, false)
+}
@@ -0,0 +1,119 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// GetRoles - getting all roles ordered by name.
+func (r *WalletRepo) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	roles := make([]entity.Role, 0)
+
+	if err := r.DB.ModelContext(ctx, &roles).Order("name").Select(); err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetRoles - r.DB: %w", err)
+	}
+
+	return roles, nil
+}
+
+// SetRole - creating the role or replacing the permissions of the existing one.
+func (r *WalletRepo) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	_, err := r.DB.ModelContext(ctx, role).
+		OnConflict("(name) DO UPDATE").
+		Set("permissions = EXCLUDED.permissions").
+		Insert()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - SetRole - r.DB: %w", err)
+	}
+
+	return role, nil
+}
+
+// DeleteRole - deleting the role together with its assignments.
+func (r *WalletRepo) DeleteRole(ctx context.Context, name string) error {
+	result, err := r.DB.ModelContext(ctx, new(entity.Role)).
+		Where("name = ?", name).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - DeleteRole - r.DB: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entity.ErrRoleNotFound
+	}
+
+	return nil
+}
+
+// GetSubjectRoles - getting all roles assigned to the subject ordered by name.
+func (r *WalletRepo) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	roles := make([]entity.Role, 0)
+
+	err := r.DB.ModelContext(ctx, &roles).
+		Join("JOIN role_assignments AS a ON a.role = role.name").
+		Where("a.subject = ?", subject).
+		Order("role.name").
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetSubjectRoles - r.DB: %w", err)
+	}
+
+	return roles, nil
+}
+
+// AssignRole - assigning the existing role to the subject. Assigning twice has no effect.
+func (r *WalletRepo) AssignRole(ctx context.Context, subject, role string) error {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		// The role is locked, so it can not be removed until it is assigned
+		exists, err := tx.ModelContext(ctx, new(entity.Role)).
+			Where("name = ?", role).
+			For("KEY SHARE").
+			Exists()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if !exists {
+			return entity.ErrRoleNotFound
+		}
+
+		_, err = tx.ModelContext(ctx, &entity.RoleAssignment{Subject: subject, Role: role}).
+			OnConflict("DO NOTHING").
+			Insert()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRoleNotFound) {
+			return err
+		}
+
+		return fmt.Errorf("WalletRepo - AssignRole - r.DB.RunInTransaction: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeRole - removing the role from the subject.
+func (r *WalletRepo) RevokeRole(ctx context.Context, subject, role string) error {
+	result, err := r.DB.ModelContext(ctx, new(entity.RoleAssignment)).
+		Where("subject = ?", subject).
+		Where("role = ?", role).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - RevokeRole - r.DB: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entity.ErrRoleNotFound
+	}
+
+	return nil
+}
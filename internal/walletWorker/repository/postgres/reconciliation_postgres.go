@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Expected balances of the wallets: the sum of the postings and the opening balance with the booked transactions.
+// Failed transactions and pending deposits have not moved the funds.
+// The transactions booked before the wallet is opened in the ledger are already in its opening balance.
+const _walletBalancesQuery = `
+SELECT w.id AS wallet_id, w.currency, w.balance,
+    COALESCE(l.amount, 0) AS ledger_balance,
+    COALESCE(o.amount, 0) + COALESCE(t.amount, 0) AS transactions_balance
+FROM wallets AS w
+LEFT JOIN (
+    SELECT account_id, SUM(amount) AS amount FROM postings GROUP BY account_id
+) AS l ON l.account_id = w.id
+LEFT JOIN (
+    SELECT p.account_id, SUM(p.amount) AS amount
+    FROM postings AS p
+    JOIN journal_entries AS e ON e.id = p.entry_id
+    WHERE e.type = ?0
+    GROUP BY p.account_id
+) AS o ON o.account_id = w.id
+LEFT JOIN (
+    SELECT wallet_id, SUM(amount) AS amount
+    FROM (
+        SELECT tr.to_wallet_id AS wallet_id, tr.amount FROM transactions AS tr
+        JOIN ledger_accounts AS a ON a.id = tr.to_wallet_id
+        WHERE tr.time >= a.opened_at AND tr.status <> ?1 AND NOT (tr.status = ?2 AND tr.type = ?3)
+        UNION ALL
+        SELECT tr.from_wallet_id AS wallet_id, -tr.amount FROM transactions AS tr
+        JOIN ledger_accounts AS a ON a.id = tr.from_wallet_id
+        WHERE tr.time >= a.opened_at AND tr.status <> ?1 AND NOT (tr.status = ?2 AND tr.type = ?3)
+    ) AS moves
+    GROUP BY wallet_id
+) AS t ON t.wallet_id = w.id
+WHERE w.balance <> COALESCE(l.amount, 0)
+    OR w.balance <> COALESCE(o.amount, 0) + COALESCE(t.amount, 0)
+ORDER BY w.id
+`
+
+// ReconcileBalances - comparing the balances of the wallets with the ones recomputed by the ledger
+// and by the transactions. All balances are read from the same snapshot of the db.
+func (r *WalletRepo) ReconcileBalances(ctx context.Context) (*entity.ReconciliationReport, error) {
+	report := new(entity.ReconciliationReport)
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ READ ONLY"); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		count, err := tx.ModelContext(ctx, new(entity.Wallet)).Count()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		var drifts []entity.WalletDrift
+
+		_, err = tx.QueryContext(ctx, &drifts, _walletBalancesQuery,
+			entity.EntryTypeOpening,
+			entity.TransactionStatusFailed,
+			entity.TransactionStatusPending,
+			entity.TransactionTypeDeposit,
+		)
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		for i := range drifts {
+			drifts[i].Calculate()
+		}
+
+		report.WalletsChecked = count
+		report.DriftedWallets = len(drifts)
+		report.Drifts = drifts
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - ReconcileBalances - r.DB.RunInTransaction: %w", err)
+	}
+
+	return report, nil
+}
+
+func (r *WalletRepo) SaveReconciliationReport(ctx context.Context, report *entity.ReconciliationReport) error {
+	if _, err := r.DB.ModelContext(ctx, report).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - SaveReconciliationReport - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WalletRepo) GetLatestReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	report := new(entity.ReconciliationReport)
+
+	err := r.DB.ModelContext(ctx, report).
+		Order("id DESC").
+		Limit(1).
+		Select()
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrReconciliationNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - GetLatestReconciliationReport - r.DB: %w", err)
+	}
+
+	return report, nil
+}
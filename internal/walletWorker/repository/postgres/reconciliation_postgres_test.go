@@ -0,0 +1,87 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+	"github.com/magiconair/properties/assert"
+)
+
+// Migrations are applied in the order of their names, starting with the test data of the pre-ledger schema.
+// PG_URL must point to the empty db.
+func newTestRepo(t *testing.T) *WalletRepo {
+	t.Helper()
+
+	url := os.Getenv("PG_URL")
+	if url == "" {
+		t.Skip("PG_URL is not set")
+	}
+
+	pg, err := postgres.New(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { pg.Close() })
+
+	migrations, err := filepath.Glob("../../../../migrations/*.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, migration := range migrations {
+		if err := pg.Migrate(migration); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return New(pg)
+}
+
+func Test_ReconcileBalances_PreLedgerTransactions(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	var pairs []struct {
+		FromWalletID string
+		ToWalletID   string
+	}
+	// The wallets of the pre-ledger transaction, which can send the funds back
+	_, err := r.DB.QueryContext(ctx, &pairs, `
+SELECT t.from_wallet_id, t.to_wallet_id FROM transactions AS t
+JOIN wallets AS w ON w.id = t.to_wallet_id
+WHERE w.balance > 0
+LIMIT 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pairs) == 0 {
+		t.Fatal("no pre-ledger transactions")
+	}
+	// The transaction booked after the ledger is opened is counted once
+	transaction := &entity.Transaction{
+		Type:   entity.TransactionTypeTransfer,
+		From:   pairs[0].ToWalletID,
+		To:     pairs[0].FromWalletID,
+		Amount: 1,
+	}
+
+	if err := r.SendFunds(ctx, transaction, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := r.ReconcileBalances(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, report.DriftedWallets, 0)
+	assert.Equal(t, len(report.Drifts), 0)
+}
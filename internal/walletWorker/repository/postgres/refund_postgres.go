@@ -0,0 +1,87 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// RefundTransaction - moving funds of the transfer back to the sender by the linked refund.
+// The sum of all refunds of the transfer never exceeds its amount.
+// If the amount is zero, then the whole remaining amount is refunded.
+func (r *WalletRepo) RefundTransaction(
+	ctx context.Context,
+	transactionID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	var refund *entity.Transaction
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		// Locking the original transfer, so the concurrent refunds are serialized
+		original := new(entity.Transaction)
+
+		err := tx.ModelContext(ctx, original).
+			Where("id = ?", transactionID).
+			For("UPDATE").
+			Select()
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return entity.ErrTransactionNotFound
+			}
+
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if original.Type != entity.TransactionTypeTransfer {
+			return entity.ErrTransactionNotRefundable
+		}
+
+		refunded, err := r.refundedAmount(ctx, tx, original.ID)
+		if err != nil {
+			return err
+		}
+
+		remaining := original.Amount - refunded
+		if amount == 0 {
+			amount = remaining
+		}
+
+		if !amount.IsPositive() || amount > remaining {
+			return entity.ErrRefundExceedsAmount
+		}
+
+		refund = &entity.Transaction{
+			Type:     entity.TransactionTypeRefund,
+			From:     original.To,
+			To:       original.From,
+			Amount:   amount,
+			ParentID: original.ID,
+		}
+
+		return r.sendFunds(ctx, tx, refund)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - RefundTransaction - r.DB.RunInTransaction: %w", err)
+	}
+
+	return refund, nil
+}
+
+// Getting the sum of the refunds of the transfer inside the db transaction.
+func (r *WalletRepo) refundedAmount(ctx context.Context, tx *postgres.Tx, transactionID string) (entity.Money, error) {
+	var refunded entity.Money
+
+	err := tx.ModelContext(ctx, new(entity.Transaction)).
+		ColumnExpr("COALESCE(SUM(amount), 0)").
+		Where("parent_id = ?", transactionID).
+		Where("type = ?", entity.TransactionTypeRefund).
+		Select(&refunded)
+	if err != nil {
+		return 0, fmt.Errorf("WalletRepo - refundedAmount - tx: %w", err)
+	}
+
+	return refunded, nil
+}
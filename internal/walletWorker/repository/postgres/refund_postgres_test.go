@@ -0,0 +1,70 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_RefundTransaction_Caps(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	transfer := &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 500}
+	if err := r.SendFunds(ctx, transfer, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	refund, err := r.RefundTransaction(ctx, transfer.ID, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, refund.ParentID, transfer.ID)
+	// The sum of the refunds can not exceed the amount of the transfer
+	if _, err := r.RefundTransaction(ctx, transfer.ID, 301); !errors.Is(err, entity.ErrRefundExceedsAmount) {
+		t.Fatalf("expected %v, got %v", entity.ErrRefundExceedsAmount, err)
+	}
+	// The zero amount refunds the rest of the transfer
+	refund, err = r.RefundTransaction(ctx, transfer.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, refund.Amount, entity.Money(300))
+
+	if _, err := r.RefundTransaction(ctx, transfer.ID, 0); !errors.Is(err, entity.ErrRefundExceedsAmount) {
+		t.Fatalf("expected %v, got %v", entity.ErrRefundExceedsAmount, err)
+	}
+
+	assert.Equal(t, getTestWallet(t, r, sender.ID).Balance, entity.Money(1000))
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(0))
+}
+
+func Test_RefundTransaction_NotRefundable(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	transfer := &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 500}
+	if err := r.SendFunds(ctx, transfer, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	refund, err := r.RefundTransaction(ctx, transfer.ID, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The refund itself is not refunded
+	if _, err := r.RefundTransaction(ctx, refund.ID, 0); !errors.Is(err, entity.ErrTransactionNotRefundable) {
+		t.Fatalf("expected %v, got %v", entity.ErrTransactionNotRefundable, err)
+	}
+}
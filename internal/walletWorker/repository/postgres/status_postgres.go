@@ -0,0 +1,131 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// SetWalletStatus - freezing or unfreezing the wallet. Closed wallet can not change its status.
+func (r *WalletRepo) SetWalletStatus(ctx context.Context, walletID string, status string) (*entity.Wallet, error) {
+	var wallet *entity.Wallet
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		wallets, err := r.lockWallets(ctx, tx, walletID)
+		if err != nil {
+			return err
+		}
+
+		wallet = &wallets[0]
+		if wallet.Status == entity.WalletStatusClosed {
+			return entity.ErrWalletClosed
+		}
+		// Setting the same status once again has no effect
+		if wallet.Status == status {
+			return nil
+		}
+
+		wallet.Status = status
+
+		return r.updateWalletStatus(ctx, tx, wallet)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - SetWalletStatus - r.DB.RunInTransaction: %w", err)
+	}
+
+	wallet.Available = wallet.AvailableBalance()
+
+	return wallet, nil
+}
+
+// CloseWallet - sweeping the remaining balance of the wallet to another one and closing it.
+// Only active wallet without active holds and pending deposits or withdrawals can be closed,
+// as the funds of the pending funding can still be credited to it.
+func (r *WalletRepo) CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error) {
+	var wallet *entity.Wallet
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		// Locking the receiver of the sweep as well to keep the order of the locks
+		wallets, err := r.lockWallets(ctx, tx, walletID, to)
+		if err != nil {
+			return err
+		}
+
+		for i := range wallets {
+			if wallets[i].ID == walletID {
+				wallet = &wallets[i]
+			}
+		}
+
+		if err := wallet.CheckStatus(); err != nil {
+			return err
+		}
+
+		if wallet.Held > 0 {
+			return entity.ErrWalletHasHolds
+		}
+
+		pending, err := tx.ModelContext(ctx, (*entity.Transaction)(nil)).
+			Where("status = ?", entity.TransactionStatusPending).
+			Where("type IN (?, ?)", entity.TransactionTypeDeposit, entity.TransactionTypeWithdrawal).
+			Where("to_wallet_id = ? OR from_wallet_id = ?", walletID, walletID).
+			Exists()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if pending {
+			return entity.ErrWalletHasFunding
+		}
+		// Sweeping the remaining balance to another wallet
+		if wallet.Balance > 0 {
+			sweep := &entity.Transaction{
+				Type:   entity.TransactionTypeSweep,
+				From:   walletID,
+				To:     to,
+				Amount: wallet.Balance,
+			}
+
+			if err := r.sendFunds(ctx, tx, sweep); err != nil {
+				return err
+			}
+
+			wallet.Balance = 0
+		}
+
+		wallet.Status = entity.WalletStatusClosed
+
+		return r.updateWalletStatus(ctx, tx, wallet)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - CloseWallet - r.DB.RunInTransaction: %w", err)
+	}
+
+	wallet.Available = wallet.AvailableBalance()
+
+	return wallet, nil
+}
+
+// Updating the status of the wallet inside the db transaction. The subscribers are notified about the change.
+func (r *WalletRepo) updateWalletStatus(ctx context.Context, tx *postgres.Tx, wallet *entity.Wallet) error {
+	_, err := tx.ModelContext(ctx, wallet).
+		Set("status = ?", wallet.Status).
+		Where("id = ?", wallet.ID).
+		Update()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - updateWalletStatus - tx: %w", err)
+	}
+
+	wallet.Available = wallet.AvailableBalance()
+
+	return r.enqueueWebhooks(ctx, tx, walletStatusEvents[wallet.Status], wallet, wallet.ID)
+}
+
+// Types of the webhook events by the new status of the wallet.
+var walletStatusEvents = map[string]string{
+	entity.WalletStatusActive: entity.WebhookEventWalletUnfrozen,
+	entity.WalletStatusFrozen: entity.WebhookEventWalletFrozen,
+	entity.WalletStatusClosed: entity.WebhookEventWalletClosed,
+}
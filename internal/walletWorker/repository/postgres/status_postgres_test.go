@@ -0,0 +1,105 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SetWalletStatus(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	sender, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	if _, err := r.SetWalletStatus(ctx, sender.ID, entity.WalletStatusFrozen); err != nil {
+		t.Fatal(err)
+	}
+	// Funds of the frozen wallet can not be moved
+	transfer := &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 100}
+	if err := r.SendFunds(ctx, transfer, nil, nil); !errors.Is(err, entity.ErrWalletFrozen) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletFrozen, err)
+	}
+
+	if _, err := r.SetWalletStatus(ctx, sender.ID, entity.WalletStatusActive); err != nil {
+		t.Fatal(err)
+	}
+
+	transfer = &entity.Transaction{Type: entity.TransactionTypeTransfer, From: sender.ID, To: receiver.ID, Amount: 100}
+	if err := r.SendFunds(ctx, transfer, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_CloseWallet(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	wallet, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	closed, err := r.CloseWallet(ctx, wallet.ID, receiver.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The remaining balance is swept to the receiver
+	assert.Equal(t, closed.Status, entity.WalletStatusClosed)
+	assert.Equal(t, getTestWallet(t, r, wallet.ID).Balance, entity.Money(0))
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(1000))
+
+	if _, err := r.SetWalletStatus(ctx, wallet.ID, entity.WalletStatusActive); !errors.Is(err, entity.ErrWalletClosed) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletClosed, err)
+	}
+
+	if _, err := r.CloseWallet(ctx, wallet.ID, receiver.ID); !errors.Is(err, entity.ErrWalletClosed) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletClosed, err)
+	}
+}
+
+func Test_CloseWallet_WithHolds(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	wallet, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	_, err := r.AuthorizeHold(ctx, &entity.Hold{WalletID: wallet.ID, Amount: 100, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.CloseWallet(ctx, wallet.ID, receiver.ID); !errors.Is(err, entity.ErrWalletHasHolds) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletHasHolds, err)
+	}
+}
+
+func Test_CloseWallet_WithPendingFunding(t *testing.T) {
+	r := newTestRepo(t)
+	ctx := context.Background()
+
+	wallet, receiver := newTestWallet(t, r, 1000), newTestWallet(t, r, 0)
+
+	deposit := &entity.Transaction{Type: entity.TransactionTypeDeposit, To: wallet.ID, Amount: 100, Provider: "fake"}
+	if err := r.BeginFunding(ctx, deposit); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.CloseWallet(ctx, wallet.ID, receiver.ID); !errors.Is(err, entity.ErrWalletHasFunding) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletHasFunding, err)
+	}
+	// The wallet is closed after the deposit is settled
+	_, err := r.FinishFunding(ctx, deposit.ID, &entity.FundingResult{Status: entity.TransactionStatusCompleted})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.CloseWallet(ctx, wallet.ID, receiver.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, getTestWallet(t, r, receiver.ID).Balance, entity.Money(1100))
+}
@@ -0,0 +1,277 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/egor-denisov/wallet-rielta/pkg/postgres"
+)
+
+// Queueing the delivery of the event to every subscription of one of the wallets.
+const _enqueueWebhooksQuery = `
+INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at)
+SELECT id, ?, ?, ?, ?
+FROM webhook_subscriptions
+WHERE ? = ANY(event_types) AND (wallet_id IS NULL OR wallet_id IN (?))`
+
+// CreateWebhook - creating new webhook subscription entry in the db.
+func (r *WalletRepo) CreateWebhook(
+	ctx context.Context,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, error) {
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		if subscription.WalletID != "" {
+			if _, err := r.lockWallets(ctx, tx, subscription.WalletID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ModelContext(ctx, subscription).Insert(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - CreateWebhook - r.DB.RunInTransaction: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// GetWebhooks - getting all webhook subscriptions of the owner.
+func (r *WalletRepo) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	subscriptions := make([]entity.WebhookSubscription, 0)
+
+	err := r.DB.ModelContext(ctx, &subscriptions).
+		ExcludeColumn("secret").
+		Where("owner = ?", owner).
+		Order("created_at", "id").
+		Select()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetWebhooks - r.DB: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// GetWebhookByID - getting the webhook subscription of the owner by its id.
+func (r *WalletRepo) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	subscription := new(entity.WebhookSubscription)
+
+	err := r.DB.ModelContext(ctx, subscription).
+		ExcludeColumn("secret").
+		Where("id = ?", webhookID).
+		Where("owner = ?", owner).
+		Select()
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrWebhookNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - GetWebhookByID - r.DB: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// DeleteWebhook - removing the webhook subscription of the owner with its delivery log.
+func (r *WalletRepo) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	result, err := r.DB.ModelContext(ctx, new(entity.WebhookSubscription)).
+		Where("id = ?", webhookID).
+		Where("owner = ?", owner).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - DeleteWebhook - r.DB: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entity.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries - getting the delivery log of the webhook subscription from the newest to the oldest.
+func (r *WalletRepo) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID string,
+	filter entity.WebhookDeliveryFilter,
+) ([]entity.WebhookDelivery, error) {
+	deliveries := make([]entity.WebhookDelivery, 0)
+
+	query := r.DB.ModelContext(ctx, &deliveries).
+		Where("subscription_id = ?", webhookID)
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if err := query.Order("id DESC").Limit(filter.Limit).Select(); err != nil {
+		return nil, fmt.Errorf("WalletRepo - GetWebhookDeliveries - r.DB: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayWebhookDelivery - scheduling the delivery of the webhook subscription once again.
+// The delivery, which is being sent at the moment, is replayed after the attempt.
+func (r *WalletRepo) ReplayWebhookDelivery(
+	ctx context.Context,
+	webhookID string,
+	deliveryID int64,
+	now time.Time,
+) (*entity.WebhookDelivery, error) {
+	delivery := new(entity.WebhookDelivery)
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		err := tx.ModelContext(ctx, delivery).
+			Where("id = ?", deliveryID).
+			Where("subscription_id = ?", webhookID).
+			For("UPDATE").
+			Select()
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return entity.ErrWebhookDeliveryNotFound
+			}
+
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		delivery.Replay(now)
+
+		_, err = tx.ModelContext(ctx, delivery).
+			Column("status", "attempts", "next_attempt_at").
+			WherePK().
+			Update()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrWebhookDeliveryNotFound) {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("WalletRepo - ReplayWebhookDelivery - r.DB.RunInTransaction: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// ClaimWebhookDeliveries - getting the deliveries, which are due at the moment, with their subscriptions.
+// The next attempt of the claimed deliveries is postponed by the lease, so they are not sent
+// by another replica, and they are sent again after the lease, if the replica stops while sending.
+func (r *WalletRepo) ClaimWebhookDeliveries(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		err := tx.ModelContext(ctx, &deliveries).
+			Where("status = ?", entity.WebhookDeliveryPending).
+			Where("next_attempt_at <= ?", now).
+			Order("next_attempt_at", "id").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Select()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(deliveries))
+		subscriptionIDs := make([]string, 0, len(deliveries))
+
+		for i := range deliveries {
+			ids = append(ids, deliveries[i].ID)
+			subscriptionIDs = append(subscriptionIDs, deliveries[i].SubscriptionID)
+		}
+
+		_, err = tx.ModelContext(ctx, new(entity.WebhookDelivery)).
+			Set("next_attempt_at = ?", now.Add(lease)).
+			Where("id IN (?)", postgres.In(ids)).
+			Update()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		var subscriptions []entity.WebhookSubscription
+
+		err = tx.ModelContext(ctx, &subscriptions).
+			Where("id IN (?)", postgres.In(subscriptionIDs)).
+			Select()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		byID := make(map[string]*entity.WebhookSubscription, len(subscriptions))
+		for i := range subscriptions {
+			byID[subscriptions[i].ID] = &subscriptions[i]
+		}
+
+		for i := range deliveries {
+			deliveries[i].Subscription = byID[deliveries[i].SubscriptionID]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - ClaimWebhookDeliveries - r.DB.RunInTransaction: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery - saving the outcome of the delivery attempt.
+func (r *WalletRepo) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	_, err := r.DB.ModelContext(ctx, delivery).
+		Column("status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at").
+		WherePK().
+		Update()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - UpdateWebhookDelivery - r.DB: %w", err)
+	}
+
+	return nil
+}
+
+// Queueing the deliveries of the event to the subscriptions of the wallets inside the db transaction,
+// so the event is delivered only if the changes are committed.
+func (r *WalletRepo) enqueueWebhooks(
+	ctx context.Context,
+	tx *postgres.Tx,
+	eventType string,
+	data interface{},
+	walletIDs ...string,
+) error {
+	event, err := entity.NewWebhookEvent(eventType, data)
+	if err != nil {
+		return fmt.Errorf("WalletRepo - enqueueWebhooks - entity.NewWebhookEvent: %w", err)
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("WalletRepo - enqueueWebhooks - json.Marshal: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, _enqueueWebhooksQuery,
+		event.ID, event.Type, string(payload), event.Time, event.Type, postgres.In(walletIDs))
+	if err != nil {
+		return fmt.Errorf("WalletRepo - enqueueWebhooks - tx.ExecContext: %w", err)
+	}
+
+	return nil
+}
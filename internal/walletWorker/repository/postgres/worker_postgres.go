@@ -18,70 +18,383 @@ func New(pg *postgres.Postgres) *WalletRepo {
 }
 
 // CreateNewWallet - creating new wallet entry  in the db.
+// The initial balance comes to the wallet from the issuance system account.
+// If the owner is set, then the wallet is linked to the existing customer.
 func (r *WalletRepo) CreateNewWallet(ctx context.Context, wallet *entity.Wallet) (*entity.Wallet, error) {
-	_, err := r.DB.ModelContext(ctx, wallet).
-		Insert()
+	balance := wallet.Balance
+
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		wallet.Balance = 0
+
+		if wallet.OwnerID != "" {
+			if err := r.checkOwner(ctx, tx, wallet.OwnerID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.ModelContext(ctx, wallet).Insert(); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		if err := r.openAccount(ctx, tx, wallet); err != nil {
+			return err
+		}
+
+		if balance == 0 {
+			return nil
+		}
+
+		issuance, err := r.systemAccount(ctx, tx, entity.SystemAccountIssuance, wallet.Currency)
+		if err != nil {
+			return err
+		}
 
+		return r.postEntry(ctx, tx, entity.NewJournalEntry(
+			entity.EntryTypeOpening,
+			issuance,
+			wallet.ID,
+			balance,
+		))
+	})
 	if err != nil {
-		return nil, fmt.Errorf("WalletRepo - CreateNewWallet - r.DB: %w", err)
+		return nil, fmt.Errorf("WalletRepo - CreateNewWallet - r.DB.RunInTransaction: %w", err)
 	}
 
+	wallet.Balance = balance
+	wallet.Available = wallet.AvailableBalance()
+
 	return wallet, nil
 }
 
 // SendFunds - decreasing the balance of the sender and an increasing the receiver.
-// Adding an entry to a transaction table.
-func (r *WalletRepo) SendFunds(ctx context.Context, transaction *entity.Transaction) error {
+// Adding an entry to a transaction table. If the fee is passed, then it is charged by the linked transaction.
+// If the idempotency key is passed, then the repeated request returns the outcome
+// and the transaction of the original one.
+func (r *WalletRepo) SendFunds(
+	ctx context.Context,
+	transaction *entity.Transaction,
+	fee *entity.Transaction,
+	key *entity.IdempotencyKey,
+) error {
 	// Using the db transaction
-	tx, err := r.DB.BeginContext(ctx)
-	if err != nil {
-		return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
+	err := r.DB.RunInTransaction(ctx, func(tx *postgres.Tx) error {
+		if key != nil {
+			// The keys are stored only for the existing senders
+			exists, err := tx.ModelContext(ctx, (*entity.Wallet)(nil)).Where("id = ?", key.WalletID).Exists()
+			if err != nil {
+				return fmt.Errorf("tx: %w", err)
+			}
+
+			if !exists {
+				return entity.ErrWalletNotFound
+			}
+
+			stored, err := r.reserveIdempotencyKey(ctx, tx, key)
+			if err != nil {
+				return err
+			}
+			// The key was already used, so return the original outcome
+			if stored != nil {
+				if err := storedOutcome(stored, key); err != nil {
+					return err
+				}
+
+				return r.storedTransaction(ctx, tx, stored, transaction)
+			}
+		}
+
+		if err := r.sendFundsWithFee(ctx, tx, transaction, fee); err != nil {
+			return err
+		}
+
+		if key == nil {
+			return nil
+		}
+		// Linking the key with the transaction for the repeated requests
+		key.TransactionID = transaction.ID
+
+		_, err := tx.ModelContext(ctx, key).
+			Set("transaction_id = ?transaction_id").
+			WherePK().
+			Update()
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+	// Saving the failed outcome, so the repeated request gets the same error.
+	// The outcome of the unknown wallet is not saved, as the key can't refer to it
+	domainErr := entity.ToRemoteError(err)
+	if domainErr == nil {
+		return fmt.Errorf("WalletRepo - SendFunds - r.DB.RunInTransaction: %w", err)
 	}
 
-	defer tx.Close()
-	// Decreasing the balance of the sender
-	res, err := r.DB.ModelContext(ctx, new(entity.Wallet)).
-		Set("balance = balance - ?", transaction.Amount).
-		Where("id = ?", transaction.From).
-		Update()
-	// If error then rollback the transaction
+	if key != nil && !errors.Is(domainErr, entity.ErrWalletNotFound) {
+		details, err := entity.ErrorDetails(domainErr)
+		if err != nil {
+			return fmt.Errorf("WalletRepo - SendFunds - entity.ErrorDetails: %w", err)
+		}
+
+		key.Error = domainErr.Error()
+		key.ErrorDetails = string(details)
+
+		if _, err := r.DB.ModelContext(ctx, key).OnConflict("DO NOTHING").Insert(); err != nil {
+			return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
+		}
+	}
+
+	return domainErr
+}
+
+// Moving funds between wallets inside the db transaction.
+func (r *WalletRepo) sendFunds(ctx context.Context, tx *postgres.Tx, transaction *entity.Transaction) error {
+	// If one of the wallets is not found then return 404
+	wallets, err := r.lockWallets(ctx, tx, transaction.From, transaction.To)
 	if err != nil {
-		_ = tx.Rollback()
-		return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
-	}
-	// If walletId is not found then return 404
-	if res.RowsAffected() == 0 {
-		_ = tx.Rollback()
-		return entity.ErrWalletNotFound
-	}
-	// Increasing the balance of the receiver
-	res, err = r.DB.ModelContext(ctx, new(entity.Wallet)).
-		Set("balance = balance + ?", transaction.Amount).
-		Where("id = ?", transaction.To).
-		Update()
-	// If error or walletId is not found then rollback the transaction
-	if err != nil || res.RowsAffected() == 0 {
-		_ = tx.Rollback()
-		return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
+		return err
+	}
+	// Funds of the frozen and closed wallets can not be moved
+	for i := range wallets {
+		if err := wallets[i].CheckStatus(); err != nil {
+			return err
+		}
+	}
+	// Transfers are allowed only between wallets in the same currency
+	if wallets[0].Currency != wallets[1].Currency {
+		return entity.ErrCurrencyMismatch
+	}
+
+	if err := checkTransfer(wallets, transaction); err != nil {
+		return err
+	}
+
+	transaction.Currency = wallets[0].Currency
+	if transaction.Type == "" {
+		transaction.Type = entity.TransactionTypeTransfer
+	}
+	// Only the transfers are limited, the refunds, the sweeps and the fees are not
+	if transaction.Type == entity.TransactionTypeTransfer {
+		if err := r.checkLimits(ctx, tx, transaction); err != nil {
+			return err
+		}
 	}
 	// Adding an entry to a transaction table
-	_, err = r.DB.ModelContext(ctx, transaction).
+	if _, err := tx.ModelContext(ctx, transaction).Insert(); err != nil {
+		return fmt.Errorf("WalletRepo - sendFunds - tx: %w", err)
+	}
+
+	if err := r.writeTransactionEvents(ctx, tx, transaction); err != nil {
+		return err
+	}
+	// Decreasing the balance of the sender and increasing the receiver
+	err = r.postEntry(ctx, tx, entity.NewJournalEntry(
+		entryType(transaction),
+		transaction.From,
+		transaction.To,
+		transaction.Amount,
+	))
+	if err != nil {
+		return err
+	}
+
+	if transaction.Type != entity.TransactionTypeTransfer {
+		return nil
+	}
+
+	return r.enqueueWebhooks(ctx, tx, entity.WebhookEventTransferCompleted, transaction, transaction.From, transaction.To)
+}
+
+// Moving funds of the transfer and charging its fee by the linked transaction inside the db transaction.
+// The sender must have enough funds for both the transfer and the fee.
+func (r *WalletRepo) sendFundsWithFee(
+	ctx context.Context,
+	tx *postgres.Tx,
+	transaction *entity.Transaction,
+	fee *entity.Transaction,
+) error {
+	if fee != nil {
+		transaction.Fee = fee.Amount
+		// The fee wallet is locked with the wallets of the transfer to keep the order of the locks
+		if err := r.lockTransferWallets(ctx, tx, transaction.From, transaction.To, fee); err != nil {
+			return err
+		}
+	}
+
+	if err := r.sendFunds(ctx, tx, transaction); err != nil {
+		return err
+	}
+
+	if fee == nil {
+		return nil
+	}
+
+	fee.ParentID = transaction.ID
+
+	return r.sendFunds(ctx, tx, fee)
+}
+
+// Locking the wallets of the transfer and the wallet of its fee at once in the order of ids inside the db transaction,
+// so the concurrent transfers do not deadlock.
+func (r *WalletRepo) lockTransferWallets(
+	ctx context.Context,
+	tx *postgres.Tx,
+	from, to string,
+	fee *entity.Transaction,
+) error {
+	walletIDs := []string{from, to}
+	if fee != nil {
+		walletIDs = append(walletIDs, fee.To)
+	}
+
+	_, err := r.lockWallets(ctx, tx, walletIDs...)
+
+	return err
+}
+
+// Getting the type of the journal entry, which moves funds of the transaction.
+func entryType(transaction *entity.Transaction) string {
+	switch transaction.Type {
+	case entity.TransactionTypeRefund:
+		return entity.EntryTypeRefund
+	case entity.TransactionTypeSweep:
+		return entity.EntryTypeSweep
+	case entity.TransactionTypeFee:
+		return entity.EntryTypeFee
+	}
+
+	return entity.EntryTypeTransfer
+}
+
+// Checking that the sender has enough available funds and the balance of the receiver is not overflowed.
+func checkTransfer(wallets []entity.Wallet, transaction *entity.Transaction) error {
+	if !transaction.Amount.IsPositive() {
+		return entity.ErrWrongAmount
+	}
+
+	for _, wallet := range wallets {
+		var err error
+
+		switch wallet.ID {
+		case transaction.From:
+			var balance entity.Money
+
+			balance, err = wallet.AvailableBalance().Sub(transaction.Amount)
+			if err == nil && balance < 0 {
+				err = entity.ErrNotEnoughFunds
+			}
+		case transaction.To:
+			_, err = wallet.Balance.Add(transaction.Amount)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reserving the idempotency key inside the db transaction.
+// If the key already exists, then returns the stored one. Concurrent requests
+// with the same key are waiting until the first one is finished.
+func (r *WalletRepo) reserveIdempotencyKey(
+	ctx context.Context,
+	tx *postgres.Tx,
+	key *entity.IdempotencyKey,
+) (*entity.IdempotencyKey, error) {
+	res, err := tx.ModelContext(ctx, key).
+		OnConflict("DO NOTHING").
 		Insert()
+	if err != nil {
+		return nil, fmt.Errorf("WalletRepo - reserveIdempotencyKey - tx: %w", err)
+	}
+
+	if res.RowsAffected() > 0 {
+		return nil, nil
+	}
 
+	stored := new(entity.IdempotencyKey)
+
+	err = tx.ModelContext(ctx, stored).
+		Where("wallet_id = ?", key.WalletID).
+		Where("key = ?", key.Key).
+		Select()
 	if err != nil {
-		_ = tx.Rollback()
-		return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
+		return nil, fmt.Errorf("WalletRepo - reserveIdempotencyKey - tx: %w", err)
 	}
-	// Make commit
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("WalletRepo - SendFunds - r.DB: %w", err)
+
+	return stored, nil
+}
+
+// Getting the outcome of the original request by the stored idempotency key.
+func storedOutcome(stored, key *entity.IdempotencyKey) error {
+	if stored.RequestHash != key.RequestHash {
+		return entity.ErrIdempotencyKeyReused
+	}
+
+	if stored.Error == "" {
+		return nil
+	}
+
+	if err := entity.ErrorWithDetails(stored.Error, []byte(stored.ErrorDetails)); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("WalletRepo - storedOutcome: %s", stored.Error) //nolint:goerr113 // unknown stored error
+}
+
+// Getting the transaction of the original request by the stored idempotency key inside the db transaction.
+// Keys stored before the transactions got ids are not linked with them.
+func (r *WalletRepo) storedTransaction(
+	ctx context.Context,
+	tx *postgres.Tx,
+	stored *entity.IdempotencyKey,
+	transaction *entity.Transaction,
+) error {
+	if stored.TransactionID == "" {
+		return nil
+	}
+
+	err := tx.ModelContext(ctx, transaction).
+		Where("id = ?", stored.TransactionID).
+		Select()
+	if err != nil {
+		return fmt.Errorf("WalletRepo - storedTransaction - tx: %w", err)
 	}
 
 	return nil
 }
 
-// GetWalletHistoryByID - getting all transaction records from the user with the walletID.
-func (r *WalletRepo) GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error) {
+// GetTransactionByID - getting the transaction by its id.
+func (r *WalletRepo) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	transaction := new(entity.Transaction)
+
+	err := r.DB.ModelContext(ctx, transaction).
+		Where("id = ?", transactionID).
+		Select()
+
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, entity.ErrTransactionNotFound
+		}
+
+		return nil, fmt.Errorf("WalletRepo - GetTransactionByID - r.DB: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// GetWalletHistoryByID - getting the page of the transactions of the wallet, which match the filter.
+// Transactions are sorted by time and id, so the cursor of the last one points to the next page.
+func (r *WalletRepo) GetWalletHistoryByID(
+	ctx context.Context,
+	walletID string,
+	filter entity.HistoryFilter,
+) (*entity.HistoryPage, error) {
 	var transactions []entity.Transaction
 
 	err := r.DB.ModelContext(ctx, new(entity.Wallet)).
@@ -96,16 +409,71 @@ func (r *WalletRepo) GetWalletHistoryByID(ctx context.Context, walletID string)
 		return nil, fmt.Errorf("WalletRepo - GetWalletHistoryByID - r.DB: %w", err)
 	}
 
-	err = r.DB.ModelContext(ctx, &transactions).
-		Where("from_wallet_id = ?", walletID).
-		WhereOr("to_wallet_id = ?", walletID).
+	query := r.DB.ModelContext(ctx, &transactions)
+
+	switch filter.Direction {
+	case entity.DirectionIncoming:
+		query = query.Where("to_wallet_id = ?", walletID)
+	case entity.DirectionOutgoing:
+		query = query.Where("from_wallet_id = ?", walletID)
+	default:
+		query = query.Where("(from_wallet_id = ? OR to_wallet_id = ?)", walletID, walletID)
+	}
+
+	if filter.Counterparty != "" {
+		query = query.Where("(from_wallet_id = ? OR to_wallet_id = ?)", filter.Counterparty, filter.Counterparty)
+	}
+
+	if filter.Reference != "" {
+		query = query.Where("reference = ?", filter.Reference)
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where("time >= ?", filter.Since)
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where("time < ?", filter.Until)
+	}
+
+	if filter.MinAmount > 0 {
+		query = query.Where("amount >= ?", filter.MinAmount)
+	}
+
+	if filter.MaxAmount > 0 {
+		query = query.Where("amount <= ?", filter.MaxAmount)
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := entity.ParseHistoryCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		query = query.Where("(time, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	// One more transaction is selected to find out whether the next page exists
+	pageSize := filter.PageSize()
+
+	err = query.
+		Order("time DESC", "id DESC").
+		Limit(pageSize + 1).
 		Select()
 
 	if err != nil {
 		return nil, fmt.Errorf("WalletRepo - GetWalletHistoryByID - r.DB: %w", err)
 	}
 
-	return transactions, nil
+	page := &entity.HistoryPage{Transactions: transactions}
+
+	if len(transactions) > pageSize {
+		page.Transactions = transactions[:pageSize]
+		last := page.Transactions[pageSize-1]
+		page.NextCursor = entity.HistoryCursor{Time: last.Time, ID: last.ID}.String()
+	}
+
+	return page, nil
 }
 
 // GetWalletByID - getting wallet info by walletID.
@@ -124,5 +492,7 @@ func (r *WalletRepo) GetWalletByID(ctx context.Context, walletID string) (*entit
 		return nil, fmt.Errorf("WalletRepo - GetWalletByID - r.DB: %w", err)
 	}
 
+	wallet.Available = wallet.AvailableBalance()
+
 	return wallet, nil
 }
@@ -0,0 +1,58 @@
+//go:build integration
+
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating the personal USD wallet with the balance.
+func newTestWallet(t *testing.T, r *WalletRepo, balance entity.Money) *entity.Wallet {
+	t.Helper()
+
+	wallet, err := r.CreateNewWallet(context.Background(), &entity.Wallet{
+		Balance:  balance,
+		Currency: "USD",
+		Type:     entity.WalletTypePersonal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return wallet
+}
+
+// Getting the current state of the wallet.
+func getTestWallet(t *testing.T, r *WalletRepo, walletID string) *entity.Wallet {
+	t.Helper()
+
+	wallet, err := r.GetWalletByID(context.Background(), walletID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return wallet
+}
+
+func Test_SendFunds_UnknownSenderWithKey(t *testing.T) {
+	r := newTestRepo(t)
+
+	transaction := &entity.Transaction{
+		Type:   entity.TransactionTypeTransfer,
+		From:   "00000000000000000000000000000000",
+		To:     "00000000000000000000000000000001",
+		Amount: 1,
+	}
+	key := &entity.IdempotencyKey{WalletID: transaction.From, Key: "unknown-sender", RequestHash: "hash"}
+
+	// The repeated request gets the same error
+	for i := 0; i < 2; i++ {
+		if err := r.SendFunds(context.Background(), transaction, nil, key); !errors.Is(err, entity.ErrWalletNotFound) {
+			t.Fatalf("expected %v, got %v", entity.ErrWalletNotFound, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Appending the event to the audit log. The id and the time of the event are set by the repository.
+func (uc *WalletWorkerUseCase) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	newEvent := &entity.AuditEvent{
+		Actor:     event.Actor,
+		Action:    event.Action,
+		Resource:  event.Resource,
+		Outcome:   event.Outcome,
+		Reason:    event.Reason,
+		RequestID: event.RequestID,
+		IP:        event.IP,
+		Payload:   event.Payload,
+	}
+
+	if err := uc.repo.RecordAuditEvent(ctx, newEvent); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - RecordAuditEvent - w.repo.RecordAuditEvent: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the page of the audit log by the filter from repository.
+func (uc *WalletWorkerUseCase) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongAuditFilter
+	}
+
+	page, err := uc.repo.GetAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetAuditEvents - w.repo.GetAuditEvents: %w", err)
+	}
+
+	return page, nil
+}
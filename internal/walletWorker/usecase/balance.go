@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Journal entries are timestamped at the start of their db transactions, so the entries
+// of the running transactions can still appear just before the current moment.
+// Snapshots are taken a bit in the past to include them.
+const _balanceSnapshotLag = time.Minute
+
+// Getting the balance of the wallet at the moment in the past.
+func (uc *WalletWorkerUseCase) GetWalletBalanceAt(
+	ctx context.Context,
+	walletID string,
+	at time.Time,
+) (*entity.HistoricalBalance, error) {
+	if at.IsZero() {
+		return nil, entity.ErrWrongBalanceTime
+	}
+
+	balance, err := uc.repo.GetWalletBalanceAt(ctx, walletID, at)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetWalletBalanceAt - w.repo.GetWalletBalanceAt: %w", err)
+	}
+
+	return balance, nil
+}
+
+// SnapshotBalances - saving the balances of the changed wallets, which speed up the balances in the past.
+// It is called periodically by the scheduler.
+func (uc *WalletWorkerUseCase) SnapshotBalances(ctx context.Context) error {
+	if err := uc.repo.SnapshotBalances(ctx, time.Now().Add(-_balanceSnapshotLag)); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - SnapshotBalances - w.repo.SnapshotBalances: %w", err)
+	}
+
+	return nil
+}
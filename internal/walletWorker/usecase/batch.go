@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Sending funds of all transfers of the batch as one unit: either all of them are made or none.
+// The fees of the transfers are charged in the same unit.
+func (uc *WalletWorkerUseCase) SendFundsBatch(
+	ctx context.Context,
+	legs []entity.TransferLeg,
+) ([]entity.Transaction, error) {
+	if err := entity.ValidateTransferLegs(legs); err != nil {
+		return nil, err
+	}
+
+	transactions := make([]entity.Transaction, len(legs))
+	fees := make([]*entity.Transaction, len(legs))
+
+	for i, leg := range legs {
+		transactions[i] = entity.Transaction{
+			Type:   entity.TransactionTypeTransfer,
+			From:   leg.From,
+			To:     leg.To,
+			Amount: leg.Amount,
+
+			TransferDetails: leg.TransferDetails,
+		}
+
+		fee, err := uc.transferFee(ctx, &transactions[i])
+		// The missing sender is reported by the repository with the rest of the rejected transfers
+		if err != nil && !errors.Is(err, entity.ErrWalletNotFound) {
+			return nil, fmt.Errorf("WalletWorkerUseCase - SendFundsBatch - uc.transferFee: %w", err)
+		}
+
+		fees[i] = fee
+	}
+
+	if err := uc.repo.SendFundsBatch(ctx, transactions, fees); err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - SendFundsBatch - w.repo.SendFundsBatch: %w", err)
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SendFundsBatch(t *testing.T) {
+	for _, test := range testsSendFundsBatch {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			transactions, err := NewWalletWorker(repo).SendFundsBatch(context.Background(), test.legs)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			var batchErr *entity.BatchError
+			if errors.As(err, &batchErr) {
+				assert.Equal(t, batchErr.Legs, test.expectedLegErrors)
+			}
+
+			assert.Equal(t, transactions, test.expectedTransactions)
+		})
+	}
+}
+
+var testsSendFundsBatch = []struct {
+	name                 string
+	legs                 []entity.TransferLeg
+	mockBehavior         func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedTransactions []entity.Transaction
+	expectedLegErrors    []entity.LegError
+	expectedError        error
+}{
+	{
+		name: "Ok",
+		legs: []entity.TransferLeg{
+			{From: _sender, To: _receiver, Amount: 1000},
+			{From: _receiver, To: _feeWallet, Amount: 500},
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().SendFundsBatch(gomock.Any(),
+				[]entity.Transaction{
+					{Type: entity.TransactionTypeTransfer, From: _sender, To: _receiver, Amount: 1000},
+					{Type: entity.TransactionTypeTransfer, From: _receiver, To: _feeWallet, Amount: 500},
+				},
+				[]*entity.Transaction{nil, nil},
+			).Return(nil)
+		},
+		expectedTransactions: []entity.Transaction{
+			{Type: entity.TransactionTypeTransfer, From: _sender, To: _receiver, Amount: 1000},
+			{Type: entity.TransactionTypeTransfer, From: _receiver, To: _feeWallet, Amount: 500},
+		},
+		expectedLegErrors: nil,
+		expectedError:     nil,
+	},
+	{
+		name:                 "Empty batch",
+		legs:                 []entity.TransferLeg{},
+		mockBehavior:         func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedTransactions: nil,
+		expectedLegErrors:    nil,
+		expectedError:        entity.ErrWrongBatchSize,
+	},
+	{
+		name:                 "Batch is too large",
+		legs:                 make([]entity.TransferLeg, entity.MaxBatchSize+1),
+		mockBehavior:         func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedTransactions: nil,
+		expectedLegErrors:    nil,
+		expectedError:        entity.ErrWrongBatchSize,
+	},
+	{
+		name: "All invalid legs are reported",
+		legs: []entity.TransferLeg{
+			{From: _sender, To: _sender, Amount: 1000},
+			{From: _sender, To: _receiver, Amount: 1000},
+			{From: _sender, To: _receiver, Amount: 0},
+		},
+		mockBehavior:         func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedTransactions: nil,
+		expectedLegErrors: []entity.LegError{
+			{Index: 0, Error: entity.ErrSenderIsReceiver.Error()},
+			{Index: 2, Error: entity.ErrWrongAmount.Error()},
+		},
+		expectedError: entity.ErrBatchRejected,
+	},
+	{
+		name: "Transfer is rejected by the repository",
+		legs: []entity.TransferLeg{
+			{From: _sender, To: _receiver, Amount: 1000},
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().SendFundsBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&entity.BatchError{Legs: []entity.LegError{{Index: 0, Error: entity.ErrNotEnoughFunds.Error()}}})
+		},
+		expectedTransactions: nil,
+		expectedLegErrors:    []entity.LegError{{Index: 0, Error: entity.ErrNotEnoughFunds.Error()}},
+		expectedError:        entity.ErrBatchRejected,
+	},
+}
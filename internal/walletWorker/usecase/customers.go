@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating a new customer in repository. The id and the time of the creation are set by the repository.
+func (uc *WalletWorkerUseCase) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	if !customer.IsValid() {
+		return nil, entity.ErrWrongCustomer
+	}
+
+	newCustomer := &entity.Customer{
+		Type:    customer.Type,
+		Name:    customer.Name,
+		Email:   customer.Email,
+		Phone:   customer.Phone,
+		Country: customer.Country,
+	}
+
+	customer, err := uc.repo.CreateCustomer(ctx, newCustomer)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CreateCustomer - w.repo.CreateCustomer: %w", err)
+	}
+
+	return customer, nil
+}
+
+// Getting customer info by id from repository.
+func (uc *WalletWorkerUseCase) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	if !isUUID(customerID) {
+		return nil, entity.ErrCustomerNotFound
+	}
+
+	customer, err := uc.repo.GetCustomerByID(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetCustomerByID - w.repo.GetCustomerByID: %w", err)
+	}
+
+	return customer, nil
+}
+
+// Getting all wallets of the customer from repository.
+func (uc *WalletWorkerUseCase) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	if !isUUID(customerID) {
+		return nil, entity.ErrCustomerNotFound
+	}
+
+	wallets, err := uc.repo.GetCustomerWallets(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetCustomerWallets - w.repo.GetCustomerWallets: %w", err)
+	}
+
+	return wallets, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Creating the fee transaction of the transfer by the fee schedule. Returns nil if the transfer is free.
+// The fee is chosen by the type of the sender wallet and the amount, it goes to the fee wallet of the currency.
+func (uc *WalletWorkerUseCase) transferFee(
+	ctx context.Context,
+	transaction *entity.Transaction,
+) (*entity.Transaction, error) {
+	if uc.fees == nil || len(uc.fees.Rules) == 0 {
+		return nil, nil
+	}
+
+	sender, err := uc.repo.GetWalletByID(ctx, transaction.From)
+	if err != nil {
+		return nil, fmt.Errorf("w.repo.GetWalletByID: %w", err)
+	}
+
+	amount, err := uc.fees.Fee(sender.Type, sender.Currency, transaction.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("uc.fees.Fee: %w", err)
+	}
+
+	if amount == 0 {
+		return nil, nil
+	}
+
+	feeWallet, ok := uc.fees.Wallet(sender.Currency)
+	if !ok {
+		return nil, entity.ErrFeeWalletNotConfigured
+	}
+	// Transfers from the fee wallet are free
+	if feeWallet == sender.ID {
+		return nil, nil
+	}
+
+	transaction.Fee = amount
+
+	return &entity.Transaction{
+		Type:   entity.TransactionTypeFee,
+		From:   sender.ID,
+		To:     feeWallet,
+		Amount: amount,
+	}, nil
+}
+
+// Checking that every fee wallet of the schedule exists, is not closed and holds the funds in its currency.
+// It is called at the start of the worker, so the wrong config does not fail the transfers.
+func (uc *WalletWorkerUseCase) CheckFees(ctx context.Context) error {
+	if uc.fees == nil {
+		return nil
+	}
+
+	for currency, walletID := range uc.fees.Wallets {
+		wallet, err := uc.repo.GetWalletByID(ctx, walletID)
+		if err != nil {
+			return fmt.Errorf("WalletWorkerUseCase - CheckFees - w.repo.GetWalletByID: %s: %w", currency, err)
+		}
+
+		if wallet.Currency != currency {
+			return fmt.Errorf("WalletWorkerUseCase - CheckFees - %s: %w", currency, entity.ErrCurrencyMismatch)
+		}
+
+		if wallet.Status == entity.WalletStatusClosed {
+			return fmt.Errorf("WalletWorkerUseCase - CheckFees - %s: %w", currency, entity.ErrWalletClosed)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+const (
+	_feeWallet = "0c7c1d9f5e219b2f4a368c0e4b8e9a55"
+	_sender    = "5b53700ed469fa6a09ea72bb78f36fd9"
+	_receiver  = "eb376add88bf8e70f80787266a0801d5"
+)
+
+var _flatFees = &entity.FeeSchedule{
+	Wallets: map[string]string{"USD": _feeWallet},
+	Rules:   []entity.FeeRule{{Kind: entity.FeeRuleFlat, Flat: 25}},
+}
+
+func Test_SendFundsBatch_Fees(t *testing.T) {
+	for _, test := range testsSendFundsBatchFees {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			transactions, err := NewWalletWorker(repo, Fees(_flatFees)).SendFundsBatch(context.Background(), test.legs)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, transactions, test.expectedTransactions)
+		})
+	}
+}
+
+var testsSendFundsBatchFees = []struct {
+	name                 string
+	legs                 []entity.TransferLeg
+	mockBehavior         func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedTransactions []entity.Transaction
+	expectedError        error
+}{
+	{
+		name: "Ok - fee of every transfer",
+		legs: []entity.TransferLeg{
+			{From: _sender, To: _receiver, Amount: 1000},
+			{From: _receiver, To: _sender, Amount: 500},
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _sender).
+				Return(&entity.Wallet{ID: _sender, Currency: "USD", Type: entity.WalletTypePersonal}, nil)
+			r.EXPECT().GetWalletByID(gomock.Any(), _receiver).
+				Return(&entity.Wallet{ID: _receiver, Currency: "USD", Type: entity.WalletTypeBusiness}, nil)
+			r.EXPECT().SendFundsBatch(gomock.Any(),
+				[]entity.Transaction{
+					{Type: entity.TransactionTypeTransfer, From: _sender, To: _receiver, Amount: 1000, Fee: 25},
+					{Type: entity.TransactionTypeTransfer, From: _receiver, To: _sender, Amount: 500, Fee: 25},
+				},
+				[]*entity.Transaction{
+					{Type: entity.TransactionTypeFee, From: _sender, To: _feeWallet, Amount: 25},
+					{Type: entity.TransactionTypeFee, From: _receiver, To: _feeWallet, Amount: 25},
+				},
+			).Return(nil)
+		},
+		expectedTransactions: []entity.Transaction{
+			{Type: entity.TransactionTypeTransfer, From: _sender, To: _receiver, Amount: 1000, Fee: 25},
+			{Type: entity.TransactionTypeTransfer, From: _receiver, To: _sender, Amount: 500, Fee: 25},
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Sender not found",
+		legs: []entity.TransferLeg{
+			{From: _sender, To: _receiver, Amount: 1000},
+		},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _sender).Return(nil, entity.ErrWalletNotFound)
+			r.EXPECT().SendFundsBatch(gomock.Any(),
+				[]entity.Transaction{{Type: entity.TransactionTypeTransfer, From: _sender, To: _receiver, Amount: 1000}},
+				[]*entity.Transaction{nil},
+			).Return(&entity.BatchError{Legs: []entity.LegError{{Index: 0, Error: entity.ErrWalletNotFound.Error()}}})
+		},
+		expectedTransactions: nil,
+		expectedError:        entity.ErrBatchRejected,
+	},
+}
+
+func Test_CaptureHold_Fee(t *testing.T) {
+	for _, test := range testsCaptureHoldFee {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			hold, err := NewWalletWorker(repo, Fees(_flatFees)).
+				CaptureHold(context.Background(), test.holdID, _receiver, test.amount)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, hold, test.expectedHold)
+		})
+	}
+}
+
+var testsCaptureHoldFee = []struct {
+	name          string
+	holdID        string
+	amount        entity.Money
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedHold  *entity.Hold
+	expectedError error
+}{
+	{
+		name:   "Ok - fee of the whole hold",
+		holdID: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e").
+				Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", WalletID: _sender, Amount: 3000}, nil)
+			r.EXPECT().GetWalletByID(gomock.Any(), _sender).
+				Return(&entity.Wallet{ID: _sender, Currency: "USD", Type: entity.WalletTypePersonal}, nil)
+			r.EXPECT().CaptureHold(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e", _receiver, entity.Money(0),
+				&entity.Transaction{Type: entity.TransactionTypeFee, From: _sender, To: _feeWallet, Amount: 25},
+			).Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Status: entity.HoldStatusCaptured}, nil)
+		},
+		expectedHold:  &entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Status: entity.HoldStatusCaptured},
+		expectedError: nil,
+	},
+	{
+		name:   "Free capture from the fee wallet",
+		holdID: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		amount: 1000,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e").
+				Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", WalletID: _feeWallet, Amount: 3000}, nil)
+			r.EXPECT().GetWalletByID(gomock.Any(), _feeWallet).
+				Return(&entity.Wallet{ID: _feeWallet, Currency: "USD", Type: entity.WalletTypePersonal}, nil)
+			r.EXPECT().CaptureHold(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e", _receiver, entity.Money(1000), nil).
+				Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Status: entity.HoldStatusCaptured}, nil)
+		},
+		expectedHold:  &entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", Status: entity.HoldStatusCaptured},
+		expectedError: nil,
+	},
+	{
+		name:   "Hold not found",
+		holdID: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e").Return(nil, entity.ErrHoldNotFound)
+		},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotFound,
+	},
+	{
+		name:   "Fee wallet is not configured",
+		holdID: "0f8fad5b-d9cb-469f-a165-70867728950e",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), "0f8fad5b-d9cb-469f-a165-70867728950e").
+				Return(&entity.Hold{ID: "0f8fad5b-d9cb-469f-a165-70867728950e", WalletID: _sender, Amount: 3000}, nil)
+			r.EXPECT().GetWalletByID(gomock.Any(), _sender).
+				Return(&entity.Wallet{ID: _sender, Currency: "EUR", Type: entity.WalletTypePersonal}, nil)
+		},
+		expectedHold:  nil,
+		expectedError: entity.ErrFeeWalletNotConfigured,
+	},
+}
+
+func Test_CheckFees(t *testing.T) {
+	for _, test := range testsCheckFees {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			err := NewWalletWorker(repo, Fees(_flatFees)).CheckFees(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+var testsCheckFees = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedError error
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _feeWallet).
+				Return(&entity.Wallet{ID: _feeWallet, Currency: "USD", Status: entity.WalletStatusActive}, nil)
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Fee wallet is not found",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _feeWallet).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedError: entity.ErrWalletNotFound,
+	},
+	{
+		name: "Fee wallet has another currency",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _feeWallet).
+				Return(&entity.Wallet{ID: _feeWallet, Currency: "EUR", Status: entity.WalletStatusActive}, nil)
+		},
+		expectedError: entity.ErrCurrencyMismatch,
+	},
+	{
+		name: "Fee wallet is closed",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetWalletByID(gomock.Any(), _feeWallet).
+				Return(&entity.Wallet{ID: _feeWallet, Currency: "USD", Status: entity.WalletStatusClosed}, nil)
+		},
+		expectedError: entity.ErrWalletClosed,
+	},
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Pending deposits and withdrawals, which are settled by one run at once.
+const _fundingSettleBatch = 100
+
+// Depositing funds to the wallet from the funding provider.
+func (uc *WalletWorkerUseCase) Deposit(
+	ctx context.Context,
+	walletID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	deposit := &entity.Transaction{
+		Type:   entity.TransactionTypeDeposit,
+		To:     walletID,
+		Amount: amount,
+	}
+
+	transaction, err := uc.fund(ctx, deposit)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - Deposit - uc.fund: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Withdrawing funds from the wallet to the funding provider.
+func (uc *WalletWorkerUseCase) Withdraw(
+	ctx context.Context,
+	walletID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	withdrawal := &entity.Transaction{
+		Type:   entity.TransactionTypeWithdrawal,
+		From:   walletID,
+		Amount: amount,
+	}
+
+	transaction, err := uc.fund(ctx, withdrawal)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - Withdraw - uc.fund: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Recording the pending deposit or withdrawal, passing it to the funding provider
+// and settling it by the outcome. If the outcome is unknown or still pending, e.g. the provider does not respond,
+// then the funding stays pending until it is settled by SettleFundings.
+// Only the failure reported by the provider reverses the withdrawal.
+// The funding of the wallet frozen meanwhile also stays pending until the wallet is unfrozen.
+func (uc *WalletWorkerUseCase) fund(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+	if uc.funding == nil {
+		return nil, entity.ErrFundingUnavailable
+	}
+
+	if !transaction.Amount.IsPositive() {
+		return nil, entity.ErrWrongAmount
+	}
+
+	transaction.Provider = uc.funding.Name()
+
+	if err := uc.repo.BeginFunding(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("w.repo.BeginFunding: %w", err)
+	}
+
+	call := uc.funding.Deposit
+	if transaction.Type == entity.TransactionTypeWithdrawal {
+		call = uc.funding.Withdraw
+	}
+
+	result, err := call(ctx, transaction)
+	if err != nil || !result.IsValid() || result.Status == entity.TransactionStatusPending {
+		return transaction, nil
+	}
+
+	settled, err := uc.repo.FinishFunding(ctx, transaction.ID, result)
+	if isWalletInactive(err) {
+		return transaction, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("w.repo.FinishFunding: %w", err)
+	}
+
+	return settled, nil
+}
+
+// Settling the deposits and the withdrawals, which are pending longer than the delay,
+// by their outcomes requested from the funding provider. The fundings with the unknown outcome stay pending.
+func (uc *WalletWorkerUseCase) SettleFundings(ctx context.Context, delay time.Duration) error {
+	if uc.funding == nil {
+		return nil
+	}
+
+	fundings, err := uc.repo.GetPendingFundings(ctx, time.Now().Add(-delay), _fundingSettleBatch)
+	if err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - SettleFundings - w.repo.GetPendingFundings: %w", err)
+	}
+
+	var errs []error
+
+	for i := range fundings {
+		result, err := uc.funding.Status(ctx, &fundings[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("uc.funding.Status: %w", err))
+			continue
+		}
+
+		if !result.IsValid() || result.Status == entity.TransactionStatusPending {
+			continue
+		}
+
+		// The funding can be settled by the provider call, which has finished meanwhile.
+		// The funding of the inactive wallet is settled by one of the next runs
+		_, err = uc.repo.FinishFunding(ctx, fundings[i].ID, result)
+		if err != nil && !errors.Is(err, entity.ErrFundingNotPending) && !isWalletInactive(err) {
+			errs = append(errs, fmt.Errorf("w.repo.FinishFunding: %w", err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - SettleFundings: %w", err)
+	}
+
+	return nil
+}
+
+// Checking that the funds are not moved, because the wallet is frozen or closed.
+func isWalletInactive(err error) bool {
+	return errors.Is(err, entity.ErrWalletFrozen) || errors.Is(err, entity.ErrWalletClosed)
+}
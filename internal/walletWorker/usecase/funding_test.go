@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+const _fundingID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+
+// Beginning the funding assigns the id to the pending transaction.
+func beginFunding(_ context.Context, transaction *entity.Transaction) error {
+	transaction.ID = _fundingID
+	transaction.Status = entity.TransactionStatusPending
+
+	return nil
+}
+
+func Test_Deposit(t *testing.T) {
+	for _, test := range testsDeposit {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			provider := mock_usecase.NewMockFundingProvider(c)
+			provider.EXPECT().Name().Return("fake").AnyTimes()
+			test.mockBehavior(repo, provider)
+
+			// Call function and check the result
+			transaction, err := NewWalletWorker(repo, Funding(provider)).Deposit(context.Background(), _receiver, 500)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, transaction, test.expectedTransaction)
+		})
+	}
+}
+
+var testsDeposit = []struct {
+	name                string
+	mockBehavior        func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider)
+	expectedTransaction *entity.Transaction
+	expectedError       error
+}{
+	{
+		name: "Ok - completed",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			r.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).DoAndReturn(beginFunding)
+			p.EXPECT().Deposit(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusCompleted, Reference: "ref"}, nil)
+			r.EXPECT().FinishFunding(gomock.Any(), _fundingID,
+				&entity.FundingResult{Status: entity.TransactionStatusCompleted, Reference: "ref"}).
+				Return(&entity.Transaction{ID: _fundingID, Status: entity.TransactionStatusCompleted}, nil)
+		},
+		expectedTransaction: &entity.Transaction{ID: _fundingID, Status: entity.TransactionStatusCompleted},
+		expectedError:       nil,
+	},
+	{
+		name: "Pending outcome is not settled",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			r.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).DoAndReturn(beginFunding)
+			p.EXPECT().Deposit(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusPending}, nil)
+		},
+		expectedTransaction: &entity.Transaction{
+			ID: _fundingID, Type: entity.TransactionTypeDeposit, To: _receiver, Amount: 500,
+			Status: entity.TransactionStatusPending, Provider: "fake",
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Provider is unavailable",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			r.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).DoAndReturn(beginFunding)
+			p.EXPECT().Deposit(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+		},
+		expectedTransaction: &entity.Transaction{
+			ID: _fundingID, Type: entity.TransactionTypeDeposit, To: _receiver, Amount: 500,
+			Status: entity.TransactionStatusPending, Provider: "fake",
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Wallet is frozen meanwhile",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			r.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).DoAndReturn(beginFunding)
+			p.EXPECT().Deposit(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusCompleted}, nil)
+			r.EXPECT().FinishFunding(gomock.Any(), _fundingID, gomock.Any()).Return(nil, entity.ErrWalletFrozen)
+		},
+		expectedTransaction: &entity.Transaction{
+			ID: _fundingID, Type: entity.TransactionTypeDeposit, To: _receiver, Amount: 500,
+			Status: entity.TransactionStatusPending, Provider: "fake",
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Wallet is closed",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, _ *mock_usecase.MockFundingProvider) {
+			r.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).Return(entity.ErrWalletClosed)
+		},
+		expectedTransaction: nil,
+		expectedError:       entity.ErrWalletClosed,
+	},
+}
+
+func Test_SettleFundings(t *testing.T) {
+	for _, test := range testsSettleFundings {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			provider := mock_usecase.NewMockFundingProvider(c)
+			repo.EXPECT().GetPendingFundings(gomock.Any(), gomock.Any(), _fundingSettleBatch).
+				Return([]entity.Transaction{{ID: _fundingID, Type: entity.TransactionTypeDeposit}}, nil)
+			test.mockBehavior(repo, provider)
+
+			// Call function and check the result
+			err := NewWalletWorker(repo, Funding(provider)).SettleFundings(context.Background(), time.Minute)
+			assert.Equal(t, err != nil, test.expectedError)
+		})
+	}
+}
+
+var testsSettleFundings = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider)
+	expectedError bool
+}{
+	{
+		name: "Ok - settled",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			p.EXPECT().Status(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusFailed}, nil)
+			r.EXPECT().FinishFunding(gomock.Any(), _fundingID, &entity.FundingResult{Status: entity.TransactionStatusFailed}).
+				Return(&entity.Transaction{ID: _fundingID, Status: entity.TransactionStatusFailed}, nil)
+		},
+		expectedError: false,
+	},
+	{
+		name: "Still pending",
+		mockBehavior: func(_ *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			p.EXPECT().Status(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusPending}, nil)
+		},
+		expectedError: false,
+	},
+	{
+		name: "Wallet is frozen",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			p.EXPECT().Status(gomock.Any(), gomock.Any()).
+				Return(&entity.FundingResult{Status: entity.TransactionStatusCompleted}, nil)
+			r.EXPECT().FinishFunding(gomock.Any(), _fundingID, gomock.Any()).Return(nil, entity.ErrWalletFrozen)
+		},
+		expectedError: false,
+	},
+	{
+		name: "Provider is unavailable",
+		mockBehavior: func(_ *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockFundingProvider) {
+			p.EXPECT().Status(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+		},
+		expectedError: true,
+	},
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Reserving funds of the wallet until the hold is captured, voided or expired.
+// If the ttl is zero, then the default one is used.
+func (uc *WalletWorkerUseCase) AuthorizeHold(
+	ctx context.Context,
+	walletID string,
+	amount entity.Money,
+	ttl time.Duration,
+) (*entity.Hold, error) {
+	if !amount.IsPositive() {
+		return nil, entity.ErrWrongAmount
+	}
+
+	if ttl == 0 {
+		ttl = uc.holdTTL
+	}
+
+	if ttl < 0 || ttl > uc.holdMaxTTL {
+		return nil, entity.ErrWrongHoldTTL
+	}
+
+	hold := &entity.Hold{
+		WalletID:  walletID,
+		Amount:    amount,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+
+	hold, err := uc.repo.AuthorizeHold(ctx, hold)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - AuthorizeHold - w.repo.AuthorizeHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Turning the hold into the transfer to the receiver.
+// If the amount is zero, then the whole hold is captured.
+func (uc *WalletWorkerUseCase) CaptureHold(
+	ctx context.Context,
+	holdID string,
+	to string,
+	amount entity.Money,
+) (*entity.Hold, error) {
+	if amount < 0 {
+		return nil, entity.ErrWrongAmount
+	}
+
+	if !isUUID(holdID) {
+		return nil, entity.ErrHoldNotFound
+	}
+	// The fee is chosen by the captured amount, the amount of the hold is not changed until it is captured
+	hold, err := uc.repo.GetHoldByID(ctx, holdID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CaptureHold - w.repo.GetHoldByID: %w", err)
+	}
+
+	captured := amount
+	if captured == 0 {
+		captured = hold.Amount
+	}
+
+	fee, err := uc.transferFee(ctx, &entity.Transaction{From: hold.WalletID, To: to, Amount: captured})
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CaptureHold - uc.transferFee: %w", err)
+	}
+
+	hold, err = uc.repo.CaptureHold(ctx, holdID, to, amount, fee)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CaptureHold - w.repo.CaptureHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Releasing the reserved funds of the hold.
+func (uc *WalletWorkerUseCase) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	if !isUUID(holdID) {
+		return nil, entity.ErrHoldNotFound
+	}
+
+	hold, err := uc.repo.VoidHold(ctx, holdID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - VoidHold - w.repo.VoidHold: %w", err)
+	}
+
+	return hold, nil
+}
+
+// Getting hold info by id from repository.
+func (uc *WalletWorkerUseCase) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	if !isUUID(holdID) {
+		return nil, entity.ErrHoldNotFound
+	}
+
+	hold, err := uc.repo.GetHoldByID(ctx, holdID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetHoldByID - w.repo.GetHoldByID: %w", err)
+	}
+
+	return hold, nil
+}
+
+// ExpireHolds - releasing the funds of the holds, which are expired.
+// It is called periodically by the scheduler.
+func (uc *WalletWorkerUseCase) ExpireHolds(ctx context.Context) error {
+	if err := uc.repo.ExpireHolds(ctx, time.Now()); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - ExpireHolds - w.repo.ExpireHolds: %w", err)
+	}
+
+	return nil
+}
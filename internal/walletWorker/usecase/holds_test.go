@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+const _holdID = "0f8fad5b-d9cb-469f-a165-70867728950e"
+
+func Test_AuthorizeHold(t *testing.T) {
+	for _, test := range testsAuthorizeHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			uc := NewWalletWorker(repo, HoldTTL(time.Hour), HoldMaxTTL(24*time.Hour))
+
+			_, err := uc.AuthorizeHold(context.Background(), _sender, test.amount, test.ttl)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+// Checking that the hold expires after the ttl from now.
+func expiresIn(ttl time.Duration) func(context.Context, *entity.Hold) (*entity.Hold, error) {
+	return func(_ context.Context, hold *entity.Hold) (*entity.Hold, error) {
+		if expiresAt := time.Now().Add(ttl); hold.ExpiresAt.After(expiresAt) ||
+			hold.ExpiresAt.Before(expiresAt.Add(-time.Minute)) {
+			return nil, errors.New("wrong expiration time")
+		}
+
+		return hold, nil
+	}
+}
+
+var testsAuthorizeHold = []struct {
+	name          string
+	amount        entity.Money
+	ttl           time.Duration
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedError error
+}{
+	{
+		name:   "Ok - default ttl",
+		amount: 300,
+		ttl:    0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().AuthorizeHold(gomock.Any(), gomock.Any()).DoAndReturn(expiresIn(time.Hour))
+		},
+		expectedError: nil,
+	},
+	{
+		name:   "Ok - custom ttl",
+		amount: 300,
+		ttl:    2 * time.Hour,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().AuthorizeHold(gomock.Any(), gomock.Any()).DoAndReturn(expiresIn(2 * time.Hour))
+		},
+		expectedError: nil,
+	},
+	{
+		name:          "Wrong amount",
+		amount:        0,
+		ttl:           0,
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedError: entity.ErrWrongAmount,
+	},
+	{
+		name:          "Ttl is above the maximum",
+		amount:        300,
+		ttl:           48 * time.Hour,
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedError: entity.ErrWrongHoldTTL,
+	},
+	{
+		name:          "Negative ttl",
+		amount:        300,
+		ttl:           -time.Hour,
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedError: entity.ErrWrongHoldTTL,
+	},
+	{
+		name:   "Not enough funds",
+		amount: 300,
+		ttl:    0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().AuthorizeHold(gomock.Any(), gomock.Any()).Return(nil, entity.ErrNotEnoughFunds)
+		},
+		expectedError: entity.ErrNotEnoughFunds,
+	},
+}
+
+func Test_CaptureHold(t *testing.T) {
+	for _, test := range testsCaptureHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			hold, err := NewWalletWorker(repo).CaptureHold(context.Background(), test.holdID, _receiver, test.amount)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, hold, test.expectedHold)
+		})
+	}
+}
+
+var testsCaptureHold = []struct {
+	name          string
+	holdID        string
+	amount        entity.Money
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedHold  *entity.Hold
+	expectedError error
+}{
+	{
+		name:   "Ok - whole hold",
+		holdID: _holdID,
+		amount: 0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), _holdID).
+				Return(&entity.Hold{ID: _holdID, WalletID: _sender, Amount: 300}, nil)
+			r.EXPECT().CaptureHold(gomock.Any(), _holdID, _receiver, entity.Money(0), nil).
+				Return(&entity.Hold{ID: _holdID, Status: entity.HoldStatusCaptured, CapturedAmount: 300}, nil)
+		},
+		expectedHold:  &entity.Hold{ID: _holdID, Status: entity.HoldStatusCaptured, CapturedAmount: 300},
+		expectedError: nil,
+	},
+	{
+		name:          "Wrong amount",
+		holdID:        _holdID,
+		amount:        -1,
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedHold:  nil,
+		expectedError: entity.ErrWrongAmount,
+	},
+	{
+		name:          "Hold id is not uuid",
+		holdID:        "hold",
+		amount:        0,
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotFound,
+	},
+	{
+		name:   "Hold is not found",
+		holdID: _holdID,
+		amount: 0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), _holdID).Return(nil, entity.ErrHoldNotFound)
+		},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotFound,
+	},
+	{
+		name:   "Hold is not active",
+		holdID: _holdID,
+		amount: 0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().GetHoldByID(gomock.Any(), _holdID).
+				Return(&entity.Hold{ID: _holdID, WalletID: _sender, Amount: 300}, nil)
+			r.EXPECT().CaptureHold(gomock.Any(), _holdID, _receiver, entity.Money(0), nil).
+				Return(nil, entity.ErrHoldNotActive)
+		},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotActive,
+	},
+}
+
+func Test_VoidHold(t *testing.T) {
+	for _, test := range testsVoidHold {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			hold, err := NewWalletWorker(repo).VoidHold(context.Background(), test.holdID)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, hold, test.expectedHold)
+		})
+	}
+}
+
+var testsVoidHold = []struct {
+	name          string
+	holdID        string
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedHold  *entity.Hold
+	expectedError error
+}{
+	{
+		name:   "Ok",
+		holdID: _holdID,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().VoidHold(gomock.Any(), _holdID).
+				Return(&entity.Hold{ID: _holdID, Status: entity.HoldStatusVoided}, nil)
+		},
+		expectedHold:  &entity.Hold{ID: _holdID, Status: entity.HoldStatusVoided},
+		expectedError: nil,
+	},
+	{
+		name:          "Hold id is not uuid",
+		holdID:        "hold",
+		mockBehavior:  func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotFound,
+	},
+	{
+		name:   "Hold is not active",
+		holdID: _holdID,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().VoidHold(gomock.Any(), _holdID).Return(nil, entity.ErrHoldNotActive)
+		},
+		expectedHold:  nil,
+		expectedError: entity.ErrHoldNotActive,
+	},
+}
+
+func Test_ExpireHolds(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	repo := mock_usecase.NewMockWalletWorkerRepo(c)
+	// The holds, which are expired by now, are released
+	repo.EXPECT().ExpireHolds(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, now time.Time) error {
+		if time.Since(now) > time.Minute {
+			return errors.New("wrong time")
+		}
+
+		return nil
+	})
+
+	if err := NewWalletWorker(repo).ExpireHolds(context.Background()); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/egor-denisov/wallet-rielta/internal/entity"
 )
@@ -10,16 +11,165 @@ import (
 
 type (
 	WalletWorker interface {
-		CreateNewWalletWithBalance(ctx context.Context, balance uint) (*entity.Wallet, error)
-		SendFunds(ctx context.Context, from string, to string, amount uint) error
-		GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error)
+		CreateNewWalletWithBalance(
+			ctx context.Context,
+			balance entity.Money,
+			currency string,
+			walletType string,
+			ownerID string,
+		) (*entity.Wallet, error)
+		SendFunds(
+			ctx context.Context,
+			from string,
+			to string,
+			amount entity.Money,
+			details entity.TransferDetails,
+			idempotencyKey string,
+		) (*entity.Transaction, error)
+		GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error)
+		SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error)
+		RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error)
+		Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error)
+		GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error)
 		GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error)
+		GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error)
+		FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error)
+		CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error)
+		GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error)
+		SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error)
+		DeleteWalletLimits(ctx context.Context, walletID string) error
+		AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error)
+		CaptureHold(ctx context.Context, holdID string, to string, amount entity.Money) (*entity.Hold, error)
+		VoidHold(ctx context.Context, holdID string) (*entity.Hold, error)
+		GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error)
+		CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
+		GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error)
+		GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error)
+		GetRoles(ctx context.Context) ([]entity.Role, error)
+		SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error)
+		DeleteRole(ctx context.Context, name string) error
+		GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error)
+		AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error)
+		RevokeRole(ctx context.Context, subject, role string) error
+		RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+		GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error)
+		CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error)
+		GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error)
+		DeleteWebhook(ctx context.Context, owner, webhookID string) error
+		GetWebhookDeliveries(
+			ctx context.Context,
+			owner string,
+			webhookID string,
+			filter entity.WebhookDeliveryFilter,
+		) ([]entity.WebhookDelivery, error)
+		ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error)
+		StartReconciliation(ctx context.Context) error
+		GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error)
 	}
 
 	WalletWorkerRepo interface {
 		CreateNewWallet(ctx context.Context, wallet *entity.Wallet) (*entity.Wallet, error)
-		SendFunds(ctx context.Context, transaction *entity.Transaction) error
-		GetWalletHistoryByID(ctx context.Context, walletID string) ([]entity.Transaction, error)
+		SendFunds(
+			ctx context.Context,
+			transaction *entity.Transaction,
+			fee *entity.Transaction,
+			key *entity.IdempotencyKey,
+		) error
+		SendFundsBatch(ctx context.Context, transactions []entity.Transaction, fees []*entity.Transaction) error
+		GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error)
+		RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error)
+		BeginFunding(ctx context.Context, transaction *entity.Transaction) error
+		FinishFunding(ctx context.Context, transactionID string, result *entity.FundingResult) (*entity.Transaction, error)
+		GetPendingFundings(ctx context.Context, before time.Time, limit int) ([]entity.Transaction, error)
+		GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error)
 		GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error)
+		GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error)
+		SnapshotBalances(ctx context.Context, at time.Time) error
+		SetWalletStatus(ctx context.Context, walletID string, status string) (*entity.Wallet, error)
+		CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error)
+		GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error)
+		SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error)
+		DeleteWalletLimits(ctx context.Context, walletID string) error
+		AuthorizeHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error)
+		CaptureHold(
+			ctx context.Context,
+			holdID string,
+			to string,
+			amount entity.Money,
+			fee *entity.Transaction,
+		) (*entity.Hold, error)
+		VoidHold(ctx context.Context, holdID string) (*entity.Hold, error)
+		GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error)
+		ExpireHolds(ctx context.Context, now time.Time) error
+		CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
+		GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error)
+		GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error)
+		GetRoles(ctx context.Context) ([]entity.Role, error)
+		SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error)
+		DeleteRole(ctx context.Context, name string) error
+		GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error)
+		AssignRole(ctx context.Context, subject, role string) error
+		RevokeRole(ctx context.Context, subject, role string) error
+		RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+		GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error)
+		CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error)
+		GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error)
+		GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error)
+		DeleteWebhook(ctx context.Context, owner, webhookID string) error
+		GetWebhookDeliveries(
+			ctx context.Context,
+			webhookID string,
+			filter entity.WebhookDeliveryFilter,
+		) ([]entity.WebhookDelivery, error)
+		ReplayWebhookDelivery(
+			ctx context.Context,
+			webhookID string,
+			deliveryID int64,
+			now time.Time,
+		) (*entity.WebhookDelivery, error)
+		ClaimWebhookDeliveries(
+			ctx context.Context,
+			now time.Time,
+			lease time.Duration,
+			limit int,
+		) ([]entity.WebhookDelivery, error)
+		UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+		ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.OutboxEvent, error)
+		MarkOutboxEventsPublished(ctx context.Context, events []entity.OutboxEvent, now time.Time) error
+		ReleaseOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error
+		PruneOutboxEvents(ctx context.Context, before time.Time) error
+		ReconcileBalances(ctx context.Context) (*entity.ReconciliationReport, error)
+		SaveReconciliationReport(ctx context.Context, report *entity.ReconciliationReport) error
+		GetLatestReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error)
+	}
+
+	// FundingProvider - external source of the deposits and the target of the withdrawals.
+	FundingProvider interface {
+		Name() string
+		Deposit(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error)
+		Withdraw(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error)
+		// Status - getting the outcome of the deposit or the withdrawal, which was passed to the provider before.
+		Status(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error)
+	}
+
+	// WebhookSender - sender of the signed events to the subscribers.
+	WebhookSender interface {
+		// Send - posting the payload of the delivery to the url of its subscription.
+		// Returns the status code of the response, which is zero if the subscriber is unavailable.
+		Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error)
+	}
+
+	// EventPublisher - publisher of the outbox events to the message broker.
+	EventPublisher interface {
+		// Publish - publishing the event, returns only when the event is confirmed by the broker.
+		Publish(ctx context.Context, event *entity.OutboxEvent) error
+	}
+
+	// ReconciliationObserver - receiver of the outcomes of the reconciliation, for example the metrics.
+	ReconciliationObserver interface {
+		Observe(report *entity.ReconciliationReport)
 	}
 )
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting the spending limits of the wallet.
+func (uc *WalletWorkerUseCase) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	limits, err := uc.repo.GetWalletLimits(ctx, walletID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetWalletLimits - w.repo.GetWalletLimits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// Setting the spending limits of the wallet. Zero limit means that the spending is not limited.
+func (uc *WalletWorkerUseCase) SetWalletLimits(
+	ctx context.Context,
+	limits *entity.SpendingLimits,
+) (*entity.SpendingLimits, error) {
+	if !limits.IsValid() {
+		return nil, entity.ErrWrongLimit
+	}
+
+	limits, err := uc.repo.SetWalletLimits(ctx, limits)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - SetWalletLimits - w.repo.SetWalletLimits: %w", err)
+	}
+
+	return limits, nil
+}
+
+// Removing all spending limits of the wallet.
+func (uc *WalletWorkerUseCase) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	if err := uc.repo.DeleteWalletLimits(ctx, walletID); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - DeleteWalletLimits - w.repo.DeleteWalletLimits: %w", err)
+	}
+
+	return nil
+}
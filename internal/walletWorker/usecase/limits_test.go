@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SetWalletLimits(t *testing.T) {
+	for _, test := range testsSetWalletLimits {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			limits, err := NewWalletWorker(repo).SetWalletLimits(context.Background(), test.limits)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, limits, test.expectedLimits)
+		})
+	}
+}
+
+var testsSetWalletLimits = []struct {
+	name           string
+	limits         *entity.SpendingLimits
+	mockBehavior   func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedLimits *entity.SpendingLimits
+	expectedError  error
+}{
+	{
+		name:   "Ok",
+		limits: &entity.SpendingLimits{WalletID: _sender, PerTransaction: 500, Daily: 1000},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().SetWalletLimits(gomock.Any(), &entity.SpendingLimits{WalletID: _sender, PerTransaction: 500, Daily: 1000}).
+				Return(&entity.SpendingLimits{WalletID: _sender, PerTransaction: 500, Daily: 1000}, nil)
+		},
+		expectedLimits: &entity.SpendingLimits{WalletID: _sender, PerTransaction: 500, Daily: 1000},
+		expectedError:  nil,
+	},
+	{
+		name:           "Negative limit",
+		limits:         &entity.SpendingLimits{WalletID: _sender, Monthly: -1},
+		mockBehavior:   func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedLimits: nil,
+		expectedError:  entity.ErrWrongLimit,
+	},
+	{
+		name:   "Wallet is not found",
+		limits: &entity.SpendingLimits{WalletID: _sender, Daily: 1000},
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().SetWalletLimits(gomock.Any(), gomock.Any()).Return(nil, entity.ErrWalletNotFound)
+		},
+		expectedLimits: nil,
+		expectedError:  entity.ErrWalletNotFound,
+	},
+}
+
+func Test_Withdraw_LimitExceeded(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	repo := mock_usecase.NewMockWalletWorkerRepo(c)
+	provider := mock_usecase.NewMockFundingProvider(c)
+	provider.EXPECT().Name().Return("fake")
+	// The withdrawal is rejected before it is passed to the provider
+	repo.EXPECT().BeginFunding(gomock.Any(), gomock.Any()).
+		Return(&entity.LimitExceededError{Limit: entity.LimitDaily, Remaining: 100})
+
+	_, err := NewWalletWorker(repo, Funding(provider)).Withdraw(context.Background(), _sender, 500)
+
+	var limitErr *entity.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		t.Fatalf("expected %v, got %v", entity.ErrLimitExceeded, err)
+	}
+
+	assert.Equal(t, *limitErr, entity.LimitExceededError{Limit: entity.LimitDaily, Remaining: 100})
+}
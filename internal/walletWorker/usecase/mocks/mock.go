@@ -0,0 +1,1535 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/egor-denisov/wallet-rielta/internal/entity"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockWalletWorker is a mock of WalletWorker interface.
+type MockWalletWorker struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletWorkerMockRecorder
+}
+
+// MockWalletWorkerMockRecorder is the mock recorder for MockWalletWorker.
+type MockWalletWorkerMockRecorder struct {
+	mock *MockWalletWorker
+}
+
+// NewMockWalletWorker creates a new mock instance.
+func NewMockWalletWorker(ctrl *gomock.Controller) *MockWalletWorker {
+	mock := &MockWalletWorker{ctrl: ctrl}
+	mock.recorder = &MockWalletWorkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletWorker) EXPECT() *MockWalletWorkerMockRecorder {
+	return m.recorder
+}
+
+// AssignRole mocks base method.
+func (m *MockWalletWorker) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, subject, role)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockWalletWorkerMockRecorder) AssignRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockWalletWorker)(nil).AssignRole), ctx, subject, role)
+}
+
+// AuthorizeHold mocks base method.
+func (m *MockWalletWorker) AuthorizeHold(ctx context.Context, walletID string, amount entity.Money, ttl time.Duration) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeHold", ctx, walletID, amount, ttl)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeHold indicates an expected call of AuthorizeHold.
+func (mr *MockWalletWorkerMockRecorder) AuthorizeHold(ctx, walletID, amount, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeHold", reflect.TypeOf((*MockWalletWorker)(nil).AuthorizeHold), ctx, walletID, amount, ttl)
+}
+
+// CaptureHold mocks base method.
+func (m *MockWalletWorker) CaptureHold(ctx context.Context, holdID, to string, amount entity.Money) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, holdID, to, amount)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockWalletWorkerMockRecorder) CaptureHold(ctx, holdID, to, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockWalletWorker)(nil).CaptureHold), ctx, holdID, to, amount)
+}
+
+// CloseWallet mocks base method.
+func (m *MockWalletWorker) CloseWallet(ctx context.Context, walletID, to string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWallet", ctx, walletID, to)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseWallet indicates an expected call of CloseWallet.
+func (mr *MockWalletWorkerMockRecorder) CloseWallet(ctx, walletID, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWallet", reflect.TypeOf((*MockWalletWorker)(nil).CloseWallet), ctx, walletID, to)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockWalletWorker) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockWalletWorkerMockRecorder) CreateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockWalletWorker)(nil).CreateCustomer), ctx, customer)
+}
+
+// CreateNewWalletWithBalance mocks base method.
+func (m *MockWalletWorker) CreateNewWalletWithBalance(ctx context.Context, balance entity.Money, currency, walletType, ownerID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewWalletWithBalance", ctx, balance, currency, walletType, ownerID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewWalletWithBalance indicates an expected call of CreateNewWalletWithBalance.
+func (mr *MockWalletWorkerMockRecorder) CreateNewWalletWithBalance(ctx, balance, currency, walletType, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWalletWithBalance", reflect.TypeOf((*MockWalletWorker)(nil).CreateNewWalletWithBalance), ctx, balance, currency, walletType, ownerID)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWalletWorker) CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, subscription)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWalletWorkerMockRecorder) CreateWebhook(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWalletWorker)(nil).CreateWebhook), ctx, subscription)
+}
+
+// DeleteRole mocks base method.
+func (m *MockWalletWorker) DeleteRole(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockWalletWorkerMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockWalletWorker)(nil).DeleteRole), ctx, name)
+}
+
+// DeleteWalletLimits mocks base method.
+func (m *MockWalletWorker) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWalletLimits indicates an expected call of DeleteWalletLimits.
+func (mr *MockWalletWorkerMockRecorder) DeleteWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWalletLimits", reflect.TypeOf((*MockWalletWorker)(nil).DeleteWalletLimits), ctx, walletID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWalletWorker) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, owner, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWalletWorkerMockRecorder) DeleteWebhook(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWalletWorker)(nil).DeleteWebhook), ctx, owner, webhookID)
+}
+
+// Deposit mocks base method.
+func (m *MockWalletWorker) Deposit(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit.
+func (mr *MockWalletWorkerMockRecorder) Deposit(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockWalletWorker)(nil).Deposit), ctx, walletID, amount)
+}
+
+// FreezeWallet mocks base method.
+func (m *MockWalletWorker) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeWallet", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeWallet indicates an expected call of FreezeWallet.
+func (mr *MockWalletWorkerMockRecorder) FreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeWallet", reflect.TypeOf((*MockWalletWorker)(nil).FreezeWallet), ctx, walletID)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockWalletWorker) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, filter)
+	ret0, _ := ret[0].(*entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockWalletWorkerMockRecorder) GetAuditEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockWalletWorker)(nil).GetAuditEvents), ctx, filter)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockWalletWorker) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockWalletWorkerMockRecorder) GetCustomerByID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockWalletWorker)(nil).GetCustomerByID), ctx, customerID)
+}
+
+// GetCustomerWallets mocks base method.
+func (m *MockWalletWorker) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerWallets", ctx, customerID)
+	ret0, _ := ret[0].([]entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerWallets indicates an expected call of GetCustomerWallets.
+func (mr *MockWalletWorkerMockRecorder) GetCustomerWallets(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerWallets", reflect.TypeOf((*MockWalletWorker)(nil).GetCustomerWallets), ctx, customerID)
+}
+
+// GetHoldByID mocks base method.
+func (m *MockWalletWorker) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldByID", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldByID indicates an expected call of GetHoldByID.
+func (mr *MockWalletWorkerMockRecorder) GetHoldByID(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldByID", reflect.TypeOf((*MockWalletWorker)(nil).GetHoldByID), ctx, holdID)
+}
+
+// GetReconciliationReport mocks base method.
+func (m *MockWalletWorker) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliationReport", ctx)
+	ret0, _ := ret[0].(*entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliationReport indicates an expected call of GetReconciliationReport.
+func (mr *MockWalletWorkerMockRecorder) GetReconciliationReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationReport", reflect.TypeOf((*MockWalletWorker)(nil).GetReconciliationReport), ctx)
+}
+
+// GetRoles mocks base method.
+func (m *MockWalletWorker) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockWalletWorkerMockRecorder) GetRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockWalletWorker)(nil).GetRoles), ctx)
+}
+
+// GetSubjectRoles mocks base method.
+func (m *MockWalletWorker) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubjectRoles", ctx, subject)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubjectRoles indicates an expected call of GetSubjectRoles.
+func (mr *MockWalletWorkerMockRecorder) GetSubjectRoles(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubjectRoles", reflect.TypeOf((*MockWalletWorker)(nil).GetSubjectRoles), ctx, subject)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockWalletWorker) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockWalletWorkerMockRecorder) GetTransactionByID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockWalletWorker)(nil).GetTransactionByID), ctx, transactionID)
+}
+
+// GetWalletBalanceAt mocks base method.
+func (m *MockWalletWorker) GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletBalanceAt", ctx, walletID, at)
+	ret0, _ := ret[0].(*entity.HistoricalBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletBalanceAt indicates an expected call of GetWalletBalanceAt.
+func (mr *MockWalletWorkerMockRecorder) GetWalletBalanceAt(ctx, walletID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletBalanceAt", reflect.TypeOf((*MockWalletWorker)(nil).GetWalletBalanceAt), ctx, walletID, at)
+}
+
+// GetWalletByID mocks base method.
+func (m *MockWalletWorker) GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByID", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByID indicates an expected call of GetWalletByID.
+func (mr *MockWalletWorkerMockRecorder) GetWalletByID(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByID", reflect.TypeOf((*MockWalletWorker)(nil).GetWalletByID), ctx, walletID)
+}
+
+// GetWalletHistoryByID mocks base method.
+func (m *MockWalletWorker) GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID, filter)
+	ret0, _ := ret[0].(*entity.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletHistoryByID indicates an expected call of GetWalletHistoryByID.
+func (mr *MockWalletWorkerMockRecorder) GetWalletHistoryByID(ctx, walletID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWalletWorker)(nil).GetWalletHistoryByID), ctx, walletID, filter)
+}
+
+// GetWalletLimits mocks base method.
+func (m *MockWalletWorker) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletLimits indicates an expected call of GetWalletLimits.
+func (mr *MockWalletWorkerMockRecorder) GetWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletLimits", reflect.TypeOf((*MockWalletWorker)(nil).GetWalletLimits), ctx, walletID)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWalletWorker) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, owner, webhookID)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWalletWorkerMockRecorder) GetWebhookByID(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWalletWorker)(nil).GetWebhookByID), ctx, owner, webhookID)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWalletWorker) GetWebhookDeliveries(ctx context.Context, owner, webhookID string, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, owner, webhookID, filter)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWalletWorkerMockRecorder) GetWebhookDeliveries(ctx, owner, webhookID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWalletWorker)(nil).GetWebhookDeliveries), ctx, owner, webhookID, filter)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWalletWorker) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx, owner)
+	ret0, _ := ret[0].([]entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWalletWorkerMockRecorder) GetWebhooks(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWalletWorker)(nil).GetWebhooks), ctx, owner)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockWalletWorker) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockWalletWorkerMockRecorder) RecordAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockWalletWorker)(nil).RecordAuditEvent), ctx, event)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockWalletWorker) RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", ctx, transactionID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockWalletWorkerMockRecorder) RefundTransaction(ctx, transactionID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockWalletWorker)(nil).RefundTransaction), ctx, transactionID, amount)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockWalletWorker) ReplayWebhookDelivery(ctx context.Context, owner, webhookID string, deliveryID int64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, owner, webhookID, deliveryID)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockWalletWorkerMockRecorder) ReplayWebhookDelivery(ctx, owner, webhookID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockWalletWorker)(nil).ReplayWebhookDelivery), ctx, owner, webhookID, deliveryID)
+}
+
+// RevokeRole mocks base method.
+func (m *MockWalletWorker) RevokeRole(ctx context.Context, subject, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", ctx, subject, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockWalletWorkerMockRecorder) RevokeRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockWalletWorker)(nil).RevokeRole), ctx, subject, role)
+}
+
+// SendFunds mocks base method.
+func (m *MockWalletWorker) SendFunds(ctx context.Context, from, to string, amount entity.Money, details entity.TransferDetails, idempotencyKey string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFunds", ctx, from, to, amount, details, idempotencyKey)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFunds indicates an expected call of SendFunds.
+func (mr *MockWalletWorkerMockRecorder) SendFunds(ctx, from, to, amount, details, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWalletWorker)(nil).SendFunds), ctx, from, to, amount, details, idempotencyKey)
+}
+
+// SendFundsBatch mocks base method.
+func (m *MockWalletWorker) SendFundsBatch(ctx context.Context, legs []entity.TransferLeg) ([]entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFundsBatch", ctx, legs)
+	ret0, _ := ret[0].([]entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFundsBatch indicates an expected call of SendFundsBatch.
+func (mr *MockWalletWorkerMockRecorder) SendFundsBatch(ctx, legs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFundsBatch", reflect.TypeOf((*MockWalletWorker)(nil).SendFundsBatch), ctx, legs)
+}
+
+// SetRole mocks base method.
+func (m *MockWalletWorker) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, role)
+	ret0, _ := ret[0].(*entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockWalletWorkerMockRecorder) SetRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockWalletWorker)(nil).SetRole), ctx, role)
+}
+
+// SetWalletLimits mocks base method.
+func (m *MockWalletWorker) SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletLimits", ctx, limits)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletLimits indicates an expected call of SetWalletLimits.
+func (mr *MockWalletWorkerMockRecorder) SetWalletLimits(ctx, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletLimits", reflect.TypeOf((*MockWalletWorker)(nil).SetWalletLimits), ctx, limits)
+}
+
+// StartReconciliation mocks base method.
+func (m *MockWalletWorker) StartReconciliation(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReconciliation", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartReconciliation indicates an expected call of StartReconciliation.
+func (mr *MockWalletWorkerMockRecorder) StartReconciliation(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReconciliation", reflect.TypeOf((*MockWalletWorker)(nil).StartReconciliation), ctx)
+}
+
+// UnfreezeWallet mocks base method.
+func (m *MockWalletWorker) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeWallet", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeWallet indicates an expected call of UnfreezeWallet.
+func (mr *MockWalletWorkerMockRecorder) UnfreezeWallet(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeWallet", reflect.TypeOf((*MockWalletWorker)(nil).UnfreezeWallet), ctx, walletID)
+}
+
+// VoidHold mocks base method.
+func (m *MockWalletWorker) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockWalletWorkerMockRecorder) VoidHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockWalletWorker)(nil).VoidHold), ctx, holdID)
+}
+
+// Withdraw mocks base method.
+func (m *MockWalletWorker) Withdraw(ctx context.Context, walletID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, walletID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockWalletWorkerMockRecorder) Withdraw(ctx, walletID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockWalletWorker)(nil).Withdraw), ctx, walletID, amount)
+}
+
+// MockWalletWorkerRepo is a mock of WalletWorkerRepo interface.
+type MockWalletWorkerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletWorkerRepoMockRecorder
+}
+
+// MockWalletWorkerRepoMockRecorder is the mock recorder for MockWalletWorkerRepo.
+type MockWalletWorkerRepoMockRecorder struct {
+	mock *MockWalletWorkerRepo
+}
+
+// NewMockWalletWorkerRepo creates a new mock instance.
+func NewMockWalletWorkerRepo(ctrl *gomock.Controller) *MockWalletWorkerRepo {
+	mock := &MockWalletWorkerRepo{ctrl: ctrl}
+	mock.recorder = &MockWalletWorkerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletWorkerRepo) EXPECT() *MockWalletWorkerRepoMockRecorder {
+	return m.recorder
+}
+
+// AssignRole mocks base method.
+func (m *MockWalletWorkerRepo) AssignRole(ctx context.Context, subject, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, subject, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignRole indicates an expected call of AssignRole.
+func (mr *MockWalletWorkerRepoMockRecorder) AssignRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockWalletWorkerRepo)(nil).AssignRole), ctx, subject, role)
+}
+
+// AuthorizeHold mocks base method.
+func (m *MockWalletWorkerRepo) AuthorizeHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeHold", ctx, hold)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeHold indicates an expected call of AuthorizeHold.
+func (mr *MockWalletWorkerRepoMockRecorder) AuthorizeHold(ctx, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeHold", reflect.TypeOf((*MockWalletWorkerRepo)(nil).AuthorizeHold), ctx, hold)
+}
+
+// BeginFunding mocks base method.
+func (m *MockWalletWorkerRepo) BeginFunding(ctx context.Context, transaction *entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginFunding", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeginFunding indicates an expected call of BeginFunding.
+func (mr *MockWalletWorkerRepoMockRecorder) BeginFunding(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginFunding", reflect.TypeOf((*MockWalletWorkerRepo)(nil).BeginFunding), ctx, transaction)
+}
+
+// CaptureHold mocks base method.
+func (m *MockWalletWorkerRepo) CaptureHold(ctx context.Context, holdID, to string, amount entity.Money, fee *entity.Transaction) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, holdID, to, amount, fee)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockWalletWorkerRepoMockRecorder) CaptureHold(ctx, holdID, to, amount, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockWalletWorkerRepo)(nil).CaptureHold), ctx, holdID, to, amount, fee)
+}
+
+// ClaimOutboxEvents mocks base method.
+func (m *MockWalletWorkerRepo) ClaimOutboxEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxEvents", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxEvents indicates an expected call of ClaimOutboxEvents.
+func (mr *MockWalletWorkerRepoMockRecorder) ClaimOutboxEvents(ctx, now, lease, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxEvents", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ClaimOutboxEvents), ctx, now, lease, limit)
+}
+
+// ClaimWebhookDeliveries mocks base method.
+func (m *MockWalletWorkerRepo) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWebhookDeliveries", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWebhookDeliveries indicates an expected call of ClaimWebhookDeliveries.
+func (mr *MockWalletWorkerRepoMockRecorder) ClaimWebhookDeliveries(ctx, now, lease, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWebhookDeliveries", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ClaimWebhookDeliveries), ctx, now, lease, limit)
+}
+
+// CloseWallet mocks base method.
+func (m *MockWalletWorkerRepo) CloseWallet(ctx context.Context, walletID, to string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWallet", ctx, walletID, to)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseWallet indicates an expected call of CloseWallet.
+func (mr *MockWalletWorkerRepoMockRecorder) CloseWallet(ctx, walletID, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWallet", reflect.TypeOf((*MockWalletWorkerRepo)(nil).CloseWallet), ctx, walletID, to)
+}
+
+// CreateCustomer mocks base method.
+func (m *MockWalletWorkerRepo) CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockWalletWorkerRepoMockRecorder) CreateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockWalletWorkerRepo)(nil).CreateCustomer), ctx, customer)
+}
+
+// CreateNewWallet mocks base method.
+func (m *MockWalletWorkerRepo) CreateNewWallet(ctx context.Context, wallet *entity.Wallet) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewWallet", ctx, wallet)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewWallet indicates an expected call of CreateNewWallet.
+func (mr *MockWalletWorkerRepoMockRecorder) CreateNewWallet(ctx, wallet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewWallet", reflect.TypeOf((*MockWalletWorkerRepo)(nil).CreateNewWallet), ctx, wallet)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWalletWorkerRepo) CreateWebhook(ctx context.Context, subscription *entity.WebhookSubscription) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, subscription)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWalletWorkerRepoMockRecorder) CreateWebhook(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWalletWorkerRepo)(nil).CreateWebhook), ctx, subscription)
+}
+
+// DeleteRole mocks base method.
+func (m *MockWalletWorkerRepo) DeleteRole(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockWalletWorkerRepoMockRecorder) DeleteRole(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockWalletWorkerRepo)(nil).DeleteRole), ctx, name)
+}
+
+// DeleteWalletLimits mocks base method.
+func (m *MockWalletWorkerRepo) DeleteWalletLimits(ctx context.Context, walletID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWalletLimits indicates an expected call of DeleteWalletLimits.
+func (mr *MockWalletWorkerRepoMockRecorder) DeleteWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWalletLimits", reflect.TypeOf((*MockWalletWorkerRepo)(nil).DeleteWalletLimits), ctx, walletID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWalletWorkerRepo) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, owner, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWalletWorkerRepoMockRecorder) DeleteWebhook(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWalletWorkerRepo)(nil).DeleteWebhook), ctx, owner, webhookID)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockWalletWorkerRepo) ExpireHolds(ctx context.Context, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockWalletWorkerRepoMockRecorder) ExpireHolds(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ExpireHolds), ctx, now)
+}
+
+// FinishFunding mocks base method.
+func (m *MockWalletWorkerRepo) FinishFunding(ctx context.Context, transactionID string, result *entity.FundingResult) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishFunding", ctx, transactionID, result)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishFunding indicates an expected call of FinishFunding.
+func (mr *MockWalletWorkerRepoMockRecorder) FinishFunding(ctx, transactionID, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishFunding", reflect.TypeOf((*MockWalletWorkerRepo)(nil).FinishFunding), ctx, transactionID, result)
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockWalletWorkerRepo) GetAuditEvents(ctx context.Context, filter entity.AuditFilter) (*entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, filter)
+	ret0, _ := ret[0].(*entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockWalletWorkerRepoMockRecorder) GetAuditEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetAuditEvents), ctx, filter)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockWalletWorkerRepo) GetCustomerByID(ctx context.Context, customerID string) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetCustomerByID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetCustomerByID), ctx, customerID)
+}
+
+// GetCustomerWallets mocks base method.
+func (m *MockWalletWorkerRepo) GetCustomerWallets(ctx context.Context, customerID string) ([]entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerWallets", ctx, customerID)
+	ret0, _ := ret[0].([]entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerWallets indicates an expected call of GetCustomerWallets.
+func (mr *MockWalletWorkerRepoMockRecorder) GetCustomerWallets(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerWallets", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetCustomerWallets), ctx, customerID)
+}
+
+// GetHoldByID mocks base method.
+func (m *MockWalletWorkerRepo) GetHoldByID(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldByID", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldByID indicates an expected call of GetHoldByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetHoldByID(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetHoldByID), ctx, holdID)
+}
+
+// GetLatestReconciliationReport mocks base method.
+func (m *MockWalletWorkerRepo) GetLatestReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestReconciliationReport", ctx)
+	ret0, _ := ret[0].(*entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestReconciliationReport indicates an expected call of GetLatestReconciliationReport.
+func (mr *MockWalletWorkerRepoMockRecorder) GetLatestReconciliationReport(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliationReport", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetLatestReconciliationReport), ctx)
+}
+
+// GetPendingFundings mocks base method.
+func (m *MockWalletWorkerRepo) GetPendingFundings(ctx context.Context, before time.Time, limit int) ([]entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingFundings", ctx, before, limit)
+	ret0, _ := ret[0].([]entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingFundings indicates an expected call of GetPendingFundings.
+func (mr *MockWalletWorkerRepoMockRecorder) GetPendingFundings(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingFundings", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetPendingFundings), ctx, before, limit)
+}
+
+// GetRoles mocks base method.
+func (m *MockWalletWorkerRepo) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockWalletWorkerRepoMockRecorder) GetRoles(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetRoles), ctx)
+}
+
+// GetSubjectRoles mocks base method.
+func (m *MockWalletWorkerRepo) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubjectRoles", ctx, subject)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubjectRoles indicates an expected call of GetSubjectRoles.
+func (mr *MockWalletWorkerRepoMockRecorder) GetSubjectRoles(ctx, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubjectRoles", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetSubjectRoles), ctx, subject)
+}
+
+// GetTransactionByID mocks base method.
+func (m *MockWalletWorkerRepo) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByID indicates an expected call of GetTransactionByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetTransactionByID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetTransactionByID), ctx, transactionID)
+}
+
+// GetWalletBalanceAt mocks base method.
+func (m *MockWalletWorkerRepo) GetWalletBalanceAt(ctx context.Context, walletID string, at time.Time) (*entity.HistoricalBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletBalanceAt", ctx, walletID, at)
+	ret0, _ := ret[0].(*entity.HistoricalBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletBalanceAt indicates an expected call of GetWalletBalanceAt.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWalletBalanceAt(ctx, walletID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletBalanceAt", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWalletBalanceAt), ctx, walletID, at)
+}
+
+// GetWalletByID mocks base method.
+func (m *MockWalletWorkerRepo) GetWalletByID(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletByID", ctx, walletID)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletByID indicates an expected call of GetWalletByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWalletByID(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWalletByID), ctx, walletID)
+}
+
+// GetWalletHistoryByID mocks base method.
+func (m *MockWalletWorkerRepo) GetWalletHistoryByID(ctx context.Context, walletID string, filter entity.HistoryFilter) (*entity.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletHistoryByID", ctx, walletID, filter)
+	ret0, _ := ret[0].(*entity.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletHistoryByID indicates an expected call of GetWalletHistoryByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWalletHistoryByID(ctx, walletID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletHistoryByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWalletHistoryByID), ctx, walletID, filter)
+}
+
+// GetWalletLimits mocks base method.
+func (m *MockWalletWorkerRepo) GetWalletLimits(ctx context.Context, walletID string) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalletLimits", ctx, walletID)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalletLimits indicates an expected call of GetWalletLimits.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWalletLimits(ctx, walletID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletLimits", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWalletLimits), ctx, walletID)
+}
+
+// GetWebhookByID mocks base method.
+func (m *MockWalletWorkerRepo) GetWebhookByID(ctx context.Context, owner, webhookID string) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookByID", ctx, owner, webhookID)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookByID indicates an expected call of GetWebhookByID.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWebhookByID(ctx, owner, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWebhookByID), ctx, owner, webhookID)
+}
+
+// GetWebhookDeliveries mocks base method.
+func (m *MockWalletWorkerRepo) GetWebhookDeliveries(ctx context.Context, webhookID string, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", ctx, webhookID, filter)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWebhookDeliveries(ctx, webhookID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWebhookDeliveries), ctx, webhookID, filter)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWalletWorkerRepo) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx, owner)
+	ret0, _ := ret[0].([]entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWalletWorkerRepoMockRecorder) GetWebhooks(ctx, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWalletWorkerRepo)(nil).GetWebhooks), ctx, owner)
+}
+
+// MarkOutboxEventsPublished mocks base method.
+func (m *MockWalletWorkerRepo) MarkOutboxEventsPublished(ctx context.Context, events []entity.OutboxEvent, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventsPublished", ctx, events, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventsPublished indicates an expected call of MarkOutboxEventsPublished.
+func (mr *MockWalletWorkerRepoMockRecorder) MarkOutboxEventsPublished(ctx, events, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventsPublished", reflect.TypeOf((*MockWalletWorkerRepo)(nil).MarkOutboxEventsPublished), ctx, events, now)
+}
+
+// PruneOutboxEvents mocks base method.
+func (m *MockWalletWorkerRepo) PruneOutboxEvents(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneOutboxEvents", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneOutboxEvents indicates an expected call of PruneOutboxEvents.
+func (mr *MockWalletWorkerRepoMockRecorder) PruneOutboxEvents(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneOutboxEvents", reflect.TypeOf((*MockWalletWorkerRepo)(nil).PruneOutboxEvents), ctx, before)
+}
+
+// ReconcileBalances mocks base method.
+func (m *MockWalletWorkerRepo) ReconcileBalances(ctx context.Context) (*entity.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileBalances", ctx)
+	ret0, _ := ret[0].(*entity.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileBalances indicates an expected call of ReconcileBalances.
+func (mr *MockWalletWorkerRepoMockRecorder) ReconcileBalances(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBalances", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ReconcileBalances), ctx)
+}
+
+// RecordAuditEvent mocks base method.
+func (m *MockWalletWorkerRepo) RecordAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAuditEvent indicates an expected call of RecordAuditEvent.
+func (mr *MockWalletWorkerRepoMockRecorder) RecordAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAuditEvent", reflect.TypeOf((*MockWalletWorkerRepo)(nil).RecordAuditEvent), ctx, event)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockWalletWorkerRepo) RefundTransaction(ctx context.Context, transactionID string, amount entity.Money) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", ctx, transactionID, amount)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockWalletWorkerRepoMockRecorder) RefundTransaction(ctx, transactionID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockWalletWorkerRepo)(nil).RefundTransaction), ctx, transactionID, amount)
+}
+
+// ReleaseOutboxEvents mocks base method.
+func (m *MockWalletWorkerRepo) ReleaseOutboxEvents(ctx context.Context, events []entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseOutboxEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseOutboxEvents indicates an expected call of ReleaseOutboxEvents.
+func (mr *MockWalletWorkerRepoMockRecorder) ReleaseOutboxEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseOutboxEvents", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ReleaseOutboxEvents), ctx, events)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockWalletWorkerRepo) ReplayWebhookDelivery(ctx context.Context, webhookID string, deliveryID int64, now time.Time) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, webhookID, deliveryID, now)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockWalletWorkerRepoMockRecorder) ReplayWebhookDelivery(ctx, webhookID, deliveryID, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockWalletWorkerRepo)(nil).ReplayWebhookDelivery), ctx, webhookID, deliveryID, now)
+}
+
+// RevokeRole mocks base method.
+func (m *MockWalletWorkerRepo) RevokeRole(ctx context.Context, subject, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", ctx, subject, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockWalletWorkerRepoMockRecorder) RevokeRole(ctx, subject, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockWalletWorkerRepo)(nil).RevokeRole), ctx, subject, role)
+}
+
+// SaveReconciliationReport mocks base method.
+func (m *MockWalletWorkerRepo) SaveReconciliationReport(ctx context.Context, report *entity.ReconciliationReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReconciliationReport", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReconciliationReport indicates an expected call of SaveReconciliationReport.
+func (mr *MockWalletWorkerRepoMockRecorder) SaveReconciliationReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReconciliationReport", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SaveReconciliationReport), ctx, report)
+}
+
+// SendFunds mocks base method.
+func (m *MockWalletWorkerRepo) SendFunds(ctx context.Context, transaction, fee *entity.Transaction, key *entity.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFunds", ctx, transaction, fee, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendFunds indicates an expected call of SendFunds.
+func (mr *MockWalletWorkerRepoMockRecorder) SendFunds(ctx, transaction, fee, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFunds", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SendFunds), ctx, transaction, fee, key)
+}
+
+// SendFundsBatch mocks base method.
+func (m *MockWalletWorkerRepo) SendFundsBatch(ctx context.Context, transactions []entity.Transaction, fees []*entity.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFundsBatch", ctx, transactions, fees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendFundsBatch indicates an expected call of SendFundsBatch.
+func (mr *MockWalletWorkerRepoMockRecorder) SendFundsBatch(ctx, transactions, fees interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFundsBatch", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SendFundsBatch), ctx, transactions, fees)
+}
+
+// SetRole mocks base method.
+func (m *MockWalletWorkerRepo) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, role)
+	ret0, _ := ret[0].(*entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockWalletWorkerRepoMockRecorder) SetRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SetRole), ctx, role)
+}
+
+// SetWalletLimits mocks base method.
+func (m *MockWalletWorkerRepo) SetWalletLimits(ctx context.Context, limits *entity.SpendingLimits) (*entity.SpendingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletLimits", ctx, limits)
+	ret0, _ := ret[0].(*entity.SpendingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletLimits indicates an expected call of SetWalletLimits.
+func (mr *MockWalletWorkerRepoMockRecorder) SetWalletLimits(ctx, limits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletLimits", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SetWalletLimits), ctx, limits)
+}
+
+// SetWalletStatus mocks base method.
+func (m *MockWalletWorkerRepo) SetWalletStatus(ctx context.Context, walletID, status string) (*entity.Wallet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWalletStatus", ctx, walletID, status)
+	ret0, _ := ret[0].(*entity.Wallet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWalletStatus indicates an expected call of SetWalletStatus.
+func (mr *MockWalletWorkerRepoMockRecorder) SetWalletStatus(ctx, walletID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWalletStatus", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SetWalletStatus), ctx, walletID, status)
+}
+
+// SnapshotBalances mocks base method.
+func (m *MockWalletWorkerRepo) SnapshotBalances(ctx context.Context, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotBalances", ctx, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SnapshotBalances indicates an expected call of SnapshotBalances.
+func (mr *MockWalletWorkerRepoMockRecorder) SnapshotBalances(ctx, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotBalances", reflect.TypeOf((*MockWalletWorkerRepo)(nil).SnapshotBalances), ctx, at)
+}
+
+// UpdateWebhookDelivery mocks base method.
+func (m *MockWalletWorkerRepo) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhookDelivery indicates an expected call of UpdateWebhookDelivery.
+func (mr *MockWalletWorkerRepoMockRecorder) UpdateWebhookDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookDelivery", reflect.TypeOf((*MockWalletWorkerRepo)(nil).UpdateWebhookDelivery), ctx, delivery)
+}
+
+// VoidHold mocks base method.
+func (m *MockWalletWorkerRepo) VoidHold(ctx context.Context, holdID string) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, holdID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockWalletWorkerRepoMockRecorder) VoidHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockWalletWorkerRepo)(nil).VoidHold), ctx, holdID)
+}
+
+// MockFundingProvider is a mock of FundingProvider interface.
+type MockFundingProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockFundingProviderMockRecorder
+}
+
+// MockFundingProviderMockRecorder is the mock recorder for MockFundingProvider.
+type MockFundingProviderMockRecorder struct {
+	mock *MockFundingProvider
+}
+
+// NewMockFundingProvider creates a new mock instance.
+func NewMockFundingProvider(ctrl *gomock.Controller) *MockFundingProvider {
+	mock := &MockFundingProvider{ctrl: ctrl}
+	mock.recorder = &MockFundingProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFundingProvider) EXPECT() *MockFundingProviderMockRecorder {
+	return m.recorder
+}
+
+// Deposit mocks base method.
+func (m *MockFundingProvider) Deposit(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deposit", ctx, transaction)
+	ret0, _ := ret[0].(*entity.FundingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deposit indicates an expected call of Deposit.
+func (mr *MockFundingProviderMockRecorder) Deposit(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockFundingProvider)(nil).Deposit), ctx, transaction)
+}
+
+// Name mocks base method.
+func (m *MockFundingProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockFundingProviderMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockFundingProvider)(nil).Name))
+}
+
+// Status mocks base method.
+func (m *MockFundingProvider) Status(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, transaction)
+	ret0, _ := ret[0].(*entity.FundingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockFundingProviderMockRecorder) Status(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockFundingProvider)(nil).Status), ctx, transaction)
+}
+
+// Withdraw mocks base method.
+func (m *MockFundingProvider) Withdraw(ctx context.Context, transaction *entity.Transaction) (*entity.FundingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Withdraw", ctx, transaction)
+	ret0, _ := ret[0].(*entity.FundingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Withdraw indicates an expected call of Withdraw.
+func (mr *MockFundingProviderMockRecorder) Withdraw(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockFundingProvider)(nil).Withdraw), ctx, transaction)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface.
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender.
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance.
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockWebhookSender) Send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, delivery)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, delivery)
+}
+
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventPublisherMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), ctx, event)
+}
+
+// MockReconciliationObserver is a mock of ReconciliationObserver interface.
+type MockReconciliationObserver struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationObserverMockRecorder
+}
+
+// MockReconciliationObserverMockRecorder is the mock recorder for MockReconciliationObserver.
+type MockReconciliationObserverMockRecorder struct {
+	mock *MockReconciliationObserver
+}
+
+// NewMockReconciliationObserver creates a new mock instance.
+func NewMockReconciliationObserver(ctrl *gomock.Controller) *MockReconciliationObserver {
+	mock := &MockReconciliationObserver{ctrl: ctrl}
+	mock.recorder = &MockReconciliationObserverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationObserver) EXPECT() *MockReconciliationObserverMockRecorder {
+	return m.recorder
+}
+
+// Observe mocks base method.
+func (m *MockReconciliationObserver) Observe(report *entity.ReconciliationReport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Observe", report)
+}
+
+// Observe indicates an expected call of Observe.
+func (mr *MockReconciliationObserverMockRecorder) Observe(report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Observe", reflect.TypeOf((*MockReconciliationObserver)(nil).Observe), report)
+}
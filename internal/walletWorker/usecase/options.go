@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+type Option func(*WalletWorkerUseCase)
+
+func HoldTTL(ttl time.Duration) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.holdTTL = ttl
+	}
+}
+
+func HoldMaxTTL(ttl time.Duration) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.holdMaxTTL = ttl
+	}
+}
+
+func Funding(provider FundingProvider) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.funding = provider
+	}
+}
+
+func Fees(schedule *entity.FeeSchedule) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.fees = schedule
+	}
+}
+
+func Webhooks(sender WebhookSender) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.webhooks = sender
+	}
+}
+
+func WebhookRetries(policy entity.WebhookRetryPolicy) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.webhookRetries = policy
+	}
+}
+
+func Events(publisher EventPublisher) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.events = publisher
+	}
+}
+
+func ReconciliationMetrics(observer ReconciliationObserver) Option {
+	return func(uc *WalletWorkerUseCase) {
+		uc.reconciliationObserver = observer
+	}
+}
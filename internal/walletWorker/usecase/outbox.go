@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+const (
+	// Events, which are claimed by one relay at once.
+	_outboxBatch = 100
+	// Time, during which the claimed events are not published by another replica.
+	_outboxLease = time.Minute
+)
+
+// Publishing the outbox events to the message broker in the order of their writing.
+// The events are marked published only after the confirmation of the broker, so every event
+// is published at least once. The relay stops at the first failure to keep the order of the events.
+func (uc *WalletWorkerUseCase) RelayOutbox(ctx context.Context) error {
+	// The events are kept until the publisher is set
+	if uc.events == nil {
+		return nil
+	}
+
+	for {
+		events, err := uc.repo.ClaimOutboxEvents(ctx, time.Now(), _outboxLease, _outboxBatch)
+		if err != nil {
+			return fmt.Errorf("WalletWorkerUseCase - RelayOutbox - w.repo.ClaimOutboxEvents: %w", err)
+		}
+
+		published, publishErr := uc.publishEvents(ctx, events)
+
+		if err := uc.repo.MarkOutboxEventsPublished(ctx, events[:published], time.Now()); err != nil {
+			return fmt.Errorf("WalletWorkerUseCase - RelayOutbox - w.repo.MarkOutboxEventsPublished: %w", err)
+		}
+
+		if publishErr != nil {
+			// The background context is used, so the events are released even if the relay is stopped
+			releaseErr := uc.repo.ReleaseOutboxEvents(context.WithoutCancel(ctx), events[published:])
+			if releaseErr != nil {
+				releaseErr = fmt.Errorf("w.repo.ReleaseOutboxEvents: %w", releaseErr)
+			}
+
+			return fmt.Errorf("WalletWorkerUseCase - RelayOutbox - uc.publishEvents: %w", errors.Join(publishErr, releaseErr))
+		}
+
+		if len(events) < _outboxBatch {
+			return nil
+		}
+	}
+}
+
+// Removing the published events, which are older than the retention.
+func (uc *WalletWorkerUseCase) PruneOutbox(ctx context.Context, retention time.Duration) error {
+	if err := uc.repo.PruneOutboxEvents(ctx, time.Now().Add(-retention)); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - PruneOutbox - w.repo.PruneOutboxEvents: %w", err)
+	}
+
+	return nil
+}
+
+// Publishing the events one by one. Returns the number of the published events.
+func (uc *WalletWorkerUseCase) publishEvents(ctx context.Context, events []entity.OutboxEvent) (int, error) {
+	for i := range events {
+		if err := uc.events.Publish(ctx, &events[i]); err != nil {
+			return i, fmt.Errorf("uc.events.Publish: %w", err)
+		}
+	}
+
+	return len(events), nil
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+)
+
+var errNotConfirmed = errors.New("event is not confirmed")
+
+// Getting the events with the ids from one to the count.
+func outboxEvents(count int) []entity.OutboxEvent {
+	events := make([]entity.OutboxEvent, 0, count)
+	for i := 1; i <= count; i++ {
+		events = append(events, entity.OutboxEvent{ID: int64(i)})
+	}
+
+	return events
+}
+
+func Test_RelayOutbox(t *testing.T) {
+	for _, test := range testsRelayOutbox {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			publisher := mock_usecase.NewMockEventPublisher(c)
+			test.mockBehavior(repo, publisher)
+
+			// Call function and check the result
+			err := NewWalletWorker(repo, Events(publisher)).RelayOutbox(context.Background())
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+var testsRelayOutbox = []struct {
+	name          string
+	mockBehavior  func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockEventPublisher)
+	expectedError error
+}{
+	{
+		name: "Ok",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockEventPublisher) {
+			r.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), _outboxLease, _outboxBatch).Return(outboxEvents(2), nil)
+			p.EXPECT().Publish(gomock.Any(), &outboxEvents(2)[0]).Return(nil)
+			p.EXPECT().Publish(gomock.Any(), &outboxEvents(2)[1]).Return(nil)
+			r.EXPECT().MarkOutboxEventsPublished(gomock.Any(), outboxEvents(2), gomock.Any()).Return(nil)
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Full batch is followed by the next one",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockEventPublisher) {
+			gomock.InOrder(
+				r.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), _outboxLease, _outboxBatch).
+					Return(outboxEvents(_outboxBatch), nil),
+				r.EXPECT().MarkOutboxEventsPublished(gomock.Any(), outboxEvents(_outboxBatch), gomock.Any()).Return(nil),
+				r.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), _outboxLease, _outboxBatch).Return(nil, nil),
+				r.EXPECT().MarkOutboxEventsPublished(gomock.Any(), gomock.Len(0), gomock.Any()).Return(nil),
+			)
+			p.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).Times(_outboxBatch)
+		},
+		expectedError: nil,
+	},
+	{
+		name: "Relay stops at the first failure",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, p *mock_usecase.MockEventPublisher) {
+			r.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), _outboxLease, _outboxBatch).Return(outboxEvents(3), nil)
+			p.EXPECT().Publish(gomock.Any(), &outboxEvents(3)[0]).Return(nil)
+			p.EXPECT().Publish(gomock.Any(), &outboxEvents(3)[1]).Return(errNotConfirmed)
+			// The published event is marked, the rest of them are released in the order of their writing
+			r.EXPECT().MarkOutboxEventsPublished(gomock.Any(), outboxEvents(3)[:1], gomock.Any()).Return(nil)
+			r.EXPECT().ReleaseOutboxEvents(gomock.Any(), outboxEvents(3)[1:]).Return(nil)
+		},
+		expectedError: errNotConfirmed,
+	},
+	{
+		name: "Claim is failed",
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo, _ *mock_usecase.MockEventPublisher) {
+			r.EXPECT().ClaimOutboxEvents(gomock.Any(), gomock.Any(), _outboxLease, _outboxBatch).
+				Return(nil, errNotConfirmed)
+		},
+		expectedError: errNotConfirmed,
+	},
+}
+
+func Test_RelayOutbox_WithoutPublisher(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	// The events are kept in the outbox
+	if err := NewWalletWorker(mock_usecase.NewMockWalletWorkerRepo(c)).RelayOutbox(context.Background()); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
+
+func Test_PruneOutbox(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	repo := mock_usecase.NewMockWalletWorkerRepo(c)
+	// Only the events published before the retention are removed
+	repo.EXPECT().PruneOutboxEvents(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, before time.Time) error {
+		if time.Since(before) < time.Hour {
+			return errors.New("wrong time")
+		}
+
+		return nil
+	})
+
+	if err := NewWalletWorker(repo).PruneOutbox(context.Background(), time.Hour); err != nil {
+		t.Errorf("expected nil, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Getting all roles from repository.
+func (uc *WalletWorkerUseCase) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	roles, err := uc.repo.GetRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetRoles - w.repo.GetRoles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Creating the role or replacing its permissions.
+func (uc *WalletWorkerUseCase) SetRole(ctx context.Context, role *entity.Role) (*entity.Role, error) {
+	if !role.IsValid() {
+		return nil, entity.ErrWrongRole
+	}
+
+	role, err := uc.repo.SetRole(ctx, role)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - SetRole - w.repo.SetRole: %w", err)
+	}
+
+	return role, nil
+}
+
+// Deleting the role, it is revoked from all subjects.
+func (uc *WalletWorkerUseCase) DeleteRole(ctx context.Context, name string) error {
+	if err := uc.repo.DeleteRole(ctx, name); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - DeleteRole - w.repo.DeleteRole: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the roles assigned to the subject from repository.
+func (uc *WalletWorkerUseCase) GetSubjectRoles(ctx context.Context, subject string) ([]entity.Role, error) {
+	if !entity.IsValidSubject(subject) {
+		return nil, entity.ErrWrongSubject
+	}
+
+	roles, err := uc.repo.GetSubjectRoles(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetSubjectRoles - w.repo.GetSubjectRoles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Assigning the role to the subject. Returns all roles of the subject.
+func (uc *WalletWorkerUseCase) AssignRole(ctx context.Context, subject, role string) ([]entity.Role, error) {
+	if !entity.IsValidSubject(subject) {
+		return nil, entity.ErrWrongSubject
+	}
+
+	if err := uc.repo.AssignRole(ctx, subject, role); err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - AssignRole - w.repo.AssignRole: %w", err)
+	}
+
+	roles, err := uc.repo.GetSubjectRoles(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - AssignRole - w.repo.GetSubjectRoles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// Revoking the role from the subject.
+func (uc *WalletWorkerUseCase) RevokeRole(ctx context.Context, subject, role string) error {
+	if !entity.IsValidSubject(subject) {
+		return entity.ErrWrongSubject
+	}
+
+	if err := uc.repo.RevokeRole(ctx, subject, role); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - RevokeRole - w.repo.RevokeRole: %w", err)
+	}
+
+	return nil
+}
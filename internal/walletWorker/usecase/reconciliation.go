@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Recomputing the balances of the wallets and saving the report of their drifts.
+// The run is skipped, if the reconciliation is already running on the replica.
+func (uc *WalletWorkerUseCase) Reconcile(ctx context.Context) error {
+	if !uc.reconciling.TryLock() {
+		return nil
+	}
+	defer uc.reconciling.Unlock()
+
+	return uc.reconcile(ctx)
+}
+
+// Starting the reconciliation in the background. The report is available, when the reconciliation is finished.
+func (uc *WalletWorkerUseCase) StartReconciliation(ctx context.Context) error {
+	if !uc.reconciling.TryLock() {
+		return entity.ErrReconciliationRunning
+	}
+
+	// The reconciliation outlives the request, which has started it. Its failure is logged to the audit log.
+	go func(ctx context.Context) {
+		defer uc.reconciling.Unlock()
+
+		_ = uc.reconcile(ctx)
+	}(context.WithoutCancel(ctx))
+
+	return nil
+}
+
+// Getting the report of the last finished reconciliation from repository.
+func (uc *WalletWorkerUseCase) GetReconciliationReport(ctx context.Context) (*entity.ReconciliationReport, error) {
+	report, err := uc.repo.GetLatestReconciliationReport(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetReconciliationReport - w.repo.GetLatestReconciliationReport: %w", err)
+	}
+
+	return report, nil
+}
+
+// Reconciling the balances and appending the outcome of the run to the audit log.
+func (uc *WalletWorkerUseCase) reconcile(ctx context.Context) error {
+	report, err := uc.runReconciliation(ctx)
+
+	event := &entity.AuditEvent{
+		Actor:    entity.AuditActorSystem,
+		Action:   entity.AuditActionReconcile,
+		Resource: entity.AuditResourceReconciliation,
+		Outcome:  entity.AuditOutcomeSuccess,
+	}
+
+	if err != nil {
+		event.Outcome = entity.AuditOutcomeFailure
+		event.Reason = err.Error()
+	} else {
+		event.Payload, _ = json.Marshal(map[string]interface{}{
+			"reportId":       report.ID,
+			"walletsChecked": report.WalletsChecked,
+			"driftedWallets": report.DriftedWallets,
+		})
+	}
+
+	if auditErr := uc.repo.RecordAuditEvent(ctx, event); auditErr != nil {
+		auditErr = fmt.Errorf("WalletWorkerUseCase - Reconcile - w.repo.RecordAuditEvent: %w", auditErr)
+
+		return errors.Join(err, auditErr)
+	}
+
+	return err
+}
+
+func (uc *WalletWorkerUseCase) runReconciliation(ctx context.Context) (*entity.ReconciliationReport, error) {
+	startedAt := time.Now()
+
+	report, err := uc.repo.ReconcileBalances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - Reconcile - w.repo.ReconcileBalances: %w", err)
+	}
+
+	report.StartedAt = startedAt
+	report.FinishedAt = time.Now()
+
+	// The metrics are observed before the truncation, so they count all drifted wallets
+	if uc.reconciliationObserver != nil {
+		uc.reconciliationObserver.Observe(report)
+	}
+
+	if len(report.Drifts) > entity.MaxReconciliationDrifts {
+		report.Drifts = report.Drifts[:entity.MaxReconciliationDrifts]
+	}
+
+	if report.Drifts == nil {
+		report.Drifts = []entity.WalletDrift{}
+	}
+
+	if err := uc.repo.SaveReconciliationReport(ctx, report); err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - Reconcile - w.repo.SaveReconciliationReport: %w", err)
+	}
+
+	return report, nil
+}
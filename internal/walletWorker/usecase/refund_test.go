@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+const _refundID = "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21"
+
+func Test_RefundTransaction(t *testing.T) {
+	for _, test := range testsRefundTransaction {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			refund, err := NewWalletWorker(repo).RefundTransaction(context.Background(), test.transactionID, test.amount)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, refund, test.expectedRefund)
+		})
+	}
+}
+
+var testsRefundTransaction = []struct {
+	name           string
+	transactionID  string
+	amount         entity.Money
+	mockBehavior   func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedRefund *entity.Transaction
+	expectedError  error
+}{
+	{
+		name:          "Ok - remaining amount",
+		transactionID: _fundingID,
+		amount:        0,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().RefundTransaction(gomock.Any(), _fundingID, entity.Money(0)).
+				Return(&entity.Transaction{ID: _refundID, Type: entity.TransactionTypeRefund, ParentID: _fundingID}, nil)
+		},
+		expectedRefund: &entity.Transaction{ID: _refundID, Type: entity.TransactionTypeRefund, ParentID: _fundingID},
+		expectedError:  nil,
+	},
+	{
+		name:           "Negative amount",
+		transactionID:  _fundingID,
+		amount:         -1,
+		mockBehavior:   func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedRefund: nil,
+		expectedError:  entity.ErrWrongAmount,
+	},
+	{
+		name:           "Transaction id is not uuid",
+		transactionID:  "transaction",
+		amount:         0,
+		mockBehavior:   func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedRefund: nil,
+		expectedError:  entity.ErrTransactionNotFound,
+	},
+	{
+		name:          "Refund exceeds the amount",
+		transactionID: _fundingID,
+		amount:        1000,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().RefundTransaction(gomock.Any(), _fundingID, entity.Money(1000)).
+				Return(nil, entity.ErrRefundExceedsAmount)
+		},
+		expectedRefund: nil,
+		expectedError:  entity.ErrRefundExceedsAmount,
+	},
+}
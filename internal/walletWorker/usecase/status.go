@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+// Freezing the wallet, so its funds can not be moved.
+func (uc *WalletWorkerUseCase) FreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	wallet, err := uc.repo.SetWalletStatus(ctx, walletID, entity.WalletStatusFrozen)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - FreezeWallet - w.repo.SetWalletStatus: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Making the frozen wallet active again.
+func (uc *WalletWorkerUseCase) UnfreezeWallet(ctx context.Context, walletID string) (*entity.Wallet, error) {
+	wallet, err := uc.repo.SetWalletStatus(ctx, walletID, entity.WalletStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - UnfreezeWallet - w.repo.SetWalletStatus: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// Closing the wallet with the sweep of its remaining balance to another wallet.
+func (uc *WalletWorkerUseCase) CloseWallet(ctx context.Context, walletID string, to string) (*entity.Wallet, error) {
+	if walletID == to {
+		return nil, entity.ErrSenderIsReceiver
+	}
+
+	wallet, err := uc.repo.CloseWallet(ctx, walletID, to)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CloseWallet - w.repo.CloseWallet: %w", err)
+	}
+
+	return wallet, nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	mock_usecase "github.com/egor-denisov/wallet-rielta/internal/walletWorker/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_FreezeWallet(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	repo := mock_usecase.NewMockWalletWorkerRepo(c)
+	repo.EXPECT().SetWalletStatus(gomock.Any(), _sender, entity.WalletStatusFrozen).
+		Return(&entity.Wallet{ID: _sender, Status: entity.WalletStatusFrozen}, nil)
+
+	wallet, err := NewWalletWorker(repo).FreezeWallet(context.Background(), _sender)
+	if err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	assert.Equal(t, wallet.Status, entity.WalletStatusFrozen)
+}
+
+func Test_UnfreezeWallet(t *testing.T) {
+	c := gomock.NewController(t)
+	defer c.Finish()
+
+	repo := mock_usecase.NewMockWalletWorkerRepo(c)
+	// The closed wallet can not be active again
+	repo.EXPECT().SetWalletStatus(gomock.Any(), _sender, entity.WalletStatusActive).Return(nil, entity.ErrWalletClosed)
+
+	if _, err := NewWalletWorker(repo).UnfreezeWallet(context.Background(), _sender); !errors.Is(err, entity.ErrWalletClosed) {
+		t.Fatalf("expected %v, got %v", entity.ErrWalletClosed, err)
+	}
+}
+
+func Test_CloseWallet(t *testing.T) {
+	for _, test := range testsCloseWallet {
+		t.Run(test.name, func(t *testing.T) {
+			// Init Dependencies
+			c := gomock.NewController(t)
+			defer c.Finish()
+
+			repo := mock_usecase.NewMockWalletWorkerRepo(c)
+			test.mockBehavior(repo)
+
+			// Call function and check the result
+			wallet, err := NewWalletWorker(repo).CloseWallet(context.Background(), _sender, test.to)
+			if !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+
+			assert.Equal(t, wallet, test.expectedWallet)
+		})
+	}
+}
+
+var testsCloseWallet = []struct {
+	name           string
+	to             string
+	mockBehavior   func(r *mock_usecase.MockWalletWorkerRepo)
+	expectedWallet *entity.Wallet
+	expectedError  error
+}{
+	{
+		name: "Ok",
+		to:   _receiver,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().CloseWallet(gomock.Any(), _sender, _receiver).
+				Return(&entity.Wallet{ID: _sender, Status: entity.WalletStatusClosed}, nil)
+		},
+		expectedWallet: &entity.Wallet{ID: _sender, Status: entity.WalletStatusClosed},
+		expectedError:  nil,
+	},
+	{
+		name:           "Sweep to the same wallet",
+		to:             _sender,
+		mockBehavior:   func(_ *mock_usecase.MockWalletWorkerRepo) {},
+		expectedWallet: nil,
+		expectedError:  entity.ErrSenderIsReceiver,
+	},
+	{
+		name: "Wallet has holds",
+		to:   _receiver,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().CloseWallet(gomock.Any(), _sender, _receiver).Return(nil, entity.ErrWalletHasHolds)
+		},
+		expectedWallet: nil,
+		expectedError:  entity.ErrWalletHasHolds,
+	},
+	{
+		name: "Wallet has pending fundings",
+		to:   _receiver,
+		mockBehavior: func(r *mock_usecase.MockWalletWorkerRepo) {
+			r.EXPECT().CloseWallet(gomock.Any(), _sender, _receiver).Return(nil, entity.ErrWalletHasFunding)
+		},
+		expectedWallet: nil,
+		expectedError:  entity.ErrWalletHasFunding,
+	},
+}
@@ -2,26 +2,89 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/egor-denisov/wallet-rielta/internal/entity"
+	"github.com/google/uuid"
+)
+
+const (
+	_defaultHoldTTL    = 24 * time.Hour
+	_defaultHoldMaxTTL = 30 * 24 * time.Hour
 )
 
 type WalletWorkerUseCase struct {
-	repo WalletWorkerRepo
+	repo       WalletWorkerRepo
+	holdTTL    time.Duration
+	holdMaxTTL time.Duration
+	funding    FundingProvider
+	fees       *entity.FeeSchedule
+
+	webhooks       WebhookSender
+	webhookRetries entity.WebhookRetryPolicy
+
+	events EventPublisher
+
+	reconciliationObserver ReconciliationObserver
+	// Only one reconciliation runs on the replica at once
+	reconciling sync.Mutex
 }
 
-func NewWalletWorker(r WalletWorkerRepo) *WalletWorkerUseCase {
-	return &WalletWorkerUseCase{
-		repo: r,
+func NewWalletWorker(r WalletWorkerRepo, opts ...Option) *WalletWorkerUseCase {
+	uc := &WalletWorkerUseCase{
+		repo:       r,
+		holdTTL:    _defaultHoldTTL,
+		holdMaxTTL: _defaultHoldMaxTTL,
+
+		webhookRetries: _defaultWebhookRetries,
+	}
+
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 // Creating a new wallet with balance in repository.
-func (uc *WalletWorkerUseCase) CreateNewWalletWithBalance(ctx context.Context, balance uint) (*entity.Wallet, error) {
+// If the type is empty, then the personal wallet is created. If the owner is empty, then the wallet is anonymous.
+func (uc *WalletWorkerUseCase) CreateNewWalletWithBalance(
+	ctx context.Context,
+	balance entity.Money,
+	currency string,
+	walletType string,
+	ownerID string,
+) (*entity.Wallet, error) {
+	if balance < 0 {
+		return nil, entity.ErrWrongAmount
+	}
+
+	if !entity.IsValidCurrency(currency) {
+		return nil, entity.ErrWrongCurrency
+	}
+
+	if walletType == "" {
+		walletType = entity.WalletTypePersonal
+	}
+
+	if !entity.IsValidWalletType(walletType) {
+		return nil, entity.ErrWrongWalletType
+	}
+
+	if ownerID != "" && !isUUID(ownerID) {
+		return nil, entity.ErrCustomerNotFound
+	}
 	// Create a new instance of the wallet with default balance
 	defaultWallet := &entity.Wallet{
-		Balance: balance,
+		Balance:  balance,
+		Currency: currency,
+		Type:     walletType,
+		OwnerID:  ownerID,
 	}
 
 	wallet, err := uc.repo.CreateNewWallet(ctx, defaultWallet)
@@ -33,32 +96,114 @@ func (uc *WalletWorkerUseCase) CreateNewWalletWithBalance(ctx context.Context, b
 }
 
 // Sending funds through wallets in repository.
-func (uc *WalletWorkerUseCase) SendFunds(ctx context.Context, from string, to string, amount uint) error {
+// Requests with the same idempotency key are executed only once.
+// The fee of the transfer is charged from the sender in the same db transaction.
+func (uc *WalletWorkerUseCase) SendFunds(
+	ctx context.Context,
+	from string,
+	to string,
+	amount entity.Money,
+	details entity.TransferDetails,
+	idempotencyKey string,
+) (*entity.Transaction, error) {
+	if from == to {
+		return nil, entity.ErrSenderIsReceiver
+	}
+
+	if !details.IsValid() {
+		return nil, entity.ErrWrongTransferDetails
+	}
+
 	transaction := &entity.Transaction{
+		Type:   entity.TransactionTypeTransfer,
 		From:   from,
 		To:     to,
 		Amount: amount,
+
+		TransferDetails: details,
 	}
 
-	err := uc.repo.SendFunds(ctx, transaction)
+	var key *entity.IdempotencyKey
+
+	if idempotencyKey != "" {
+		hash, err := requestHash(transaction)
+		if err != nil {
+			return nil, fmt.Errorf("WalletWorkerUseCase - SendFunds - requestHash: %w", err)
+		}
+
+		key = &entity.IdempotencyKey{
+			WalletID:    from,
+			Key:         idempotencyKey,
+			RequestHash: hash,
+		}
+	}
+
+	fee, err := uc.transferFee(ctx, transaction)
 	if err != nil {
-		return fmt.Errorf("WalletWorkerUseCase - SendFunds - w.repo.SendFunds: %w", err)
+		return nil, fmt.Errorf("WalletWorkerUseCase - SendFunds - uc.transferFee: %w", err)
 	}
 
-	return nil
+	err = uc.repo.SendFunds(ctx, transaction, fee, key)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - SendFunds - w.repo.SendFunds: %w", err)
+	}
+
+	return transaction, nil
 }
 
-// Getting wallet history by id from repository.
+// Getting the transaction by its id from repository.
+func (uc *WalletWorkerUseCase) GetTransactionByID(ctx context.Context, transactionID string) (*entity.Transaction, error) {
+	if !isUUID(transactionID) {
+		return nil, entity.ErrTransactionNotFound
+	}
+
+	transaction, err := uc.repo.GetTransactionByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetTransactionByID - w.repo.GetTransactionByID: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// Refunding the transfer by the linked transaction in the opposite direction.
+// If the amount is zero, then the whole remaining amount is refunded.
+func (uc *WalletWorkerUseCase) RefundTransaction(
+	ctx context.Context,
+	transactionID string,
+	amount entity.Money,
+) (*entity.Transaction, error) {
+	if amount < 0 {
+		return nil, entity.ErrWrongAmount
+	}
+
+	if !isUUID(transactionID) {
+		return nil, entity.ErrTransactionNotFound
+	}
+
+	refund, err := uc.repo.RefundTransaction(ctx, transactionID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - RefundTransaction - w.repo.RefundTransaction: %w", err)
+	}
+
+	return refund, nil
+}
+
+// Getting the page of the wallet history by id from repository.
 func (uc *WalletWorkerUseCase) GetWalletHistoryByID(
 	ctx context.Context,
 	walletID string,
-) ([]entity.Transaction, error) {
-	transactions, err := uc.repo.GetWalletHistoryByID(ctx, walletID)
+	filter entity.HistoryFilter,
+) (*entity.HistoryPage, error) {
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongHistoryFilter
+	}
+
+	page, err := uc.repo.GetWalletHistoryByID(ctx, walletID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("WalletWorkerUseCase - GetWalletHistoryByID - w.repo.GetWalletHistoryByID: %w", err)
 	}
 
-	return transactions, nil
+	return page, nil
 }
 
 // Getting wallet info by id from repository.
@@ -70,3 +215,20 @@ func (uc *WalletWorkerUseCase) GetWalletByID(ctx context.Context, walletID strin
 
 	return wallet, nil
 }
+
+// Getting the hash of the request payload to compare requests with the same idempotency key.
+func requestHash(payload interface{}) (string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	hash := sha256.Sum256(body)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Checking that the id is uuid, otherwise such entity does not exist.
+func isUUID(id string) bool {
+	return uuid.Validate(id) == nil
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/egor-denisov/wallet-rielta/internal/entity"
+)
+
+const (
+	// Deliveries, which are sent concurrently by one run of the job.
+	_webhookDeliveriesBatch = 20
+	// Time, during which the claimed delivery is not sent by another replica.
+	_webhookDeliveryLease = 2 * time.Minute
+	_webhookSecretSize    = 32
+	_webhookSecretPrefix  = "whsec_"
+)
+
+var _defaultWebhookRetries = entity.WebhookRetryPolicy{
+	MaxAttempts: 8,
+	MinBackoff:  30 * time.Second,
+	MaxBackoff:  6 * time.Hour,
+}
+
+// Creating a new webhook subscription with the generated signing secret.
+// The secret is returned only once, when the subscription is created.
+func (uc *WalletWorkerUseCase) CreateWebhook(
+	ctx context.Context,
+	subscription *entity.WebhookSubscription,
+) (*entity.WebhookSubscription, error) {
+	if !subscription.IsValid() || !entity.IsValidSubject(subscription.Owner) {
+		return nil, entity.ErrWrongWebhook
+	}
+
+	secret := make([]byte, _webhookSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CreateWebhook - rand.Read: %w", err)
+	}
+
+	newSubscription := &entity.WebhookSubscription{
+		Owner:      subscription.Owner,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		WalletID:   subscription.WalletID,
+		Secret:     _webhookSecretPrefix + hex.EncodeToString(secret),
+	}
+
+	subscription, err := uc.repo.CreateWebhook(ctx, newSubscription)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - CreateWebhook - w.repo.CreateWebhook: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// Getting all webhook subscriptions of the owner from repository.
+func (uc *WalletWorkerUseCase) GetWebhooks(ctx context.Context, owner string) ([]entity.WebhookSubscription, error) {
+	subscriptions, err := uc.repo.GetWebhooks(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetWebhooks - w.repo.GetWebhooks: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// Getting the webhook subscription of the owner from repository.
+func (uc *WalletWorkerUseCase) GetWebhookByID(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+) (*entity.WebhookSubscription, error) {
+	if !isUUID(webhookID) {
+		return nil, entity.ErrWebhookNotFound
+	}
+
+	subscription, err := uc.repo.GetWebhookByID(ctx, owner, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetWebhookByID - w.repo.GetWebhookByID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// Removing the webhook subscription of the owner with its delivery log.
+func (uc *WalletWorkerUseCase) DeleteWebhook(ctx context.Context, owner, webhookID string) error {
+	if !isUUID(webhookID) {
+		return entity.ErrWebhookNotFound
+	}
+
+	if err := uc.repo.DeleteWebhook(ctx, owner, webhookID); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - DeleteWebhook - w.repo.DeleteWebhook: %w", err)
+	}
+
+	return nil
+}
+
+// Getting the delivery log of the webhook subscription of the owner from repository.
+func (uc *WalletWorkerUseCase) GetWebhookDeliveries(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	filter entity.WebhookDeliveryFilter,
+) ([]entity.WebhookDelivery, error) {
+	if !filter.IsValid() {
+		return nil, entity.ErrWrongWebhookDeliveryFilter
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = entity.DefaultWebhookDeliveriesLimit
+	}
+
+	if _, err := uc.GetWebhookByID(ctx, owner, webhookID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.repo.GetWebhookDeliveries(ctx, webhookID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - GetWebhookDeliveries - w.repo.GetWebhookDeliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// Sending the delivery of the webhook subscription of the owner once again.
+func (uc *WalletWorkerUseCase) ReplayWebhookDelivery(
+	ctx context.Context,
+	owner string,
+	webhookID string,
+	deliveryID int64,
+) (*entity.WebhookDelivery, error) {
+	if _, err := uc.GetWebhookByID(ctx, owner, webhookID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.repo.ReplayWebhookDelivery(ctx, webhookID, deliveryID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("WalletWorkerUseCase - ReplayWebhookDelivery - w.repo.ReplayWebhookDelivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// DeliverWebhooks - sending the deliveries, which are due, and scheduling the retries of the failed ones.
+// It is called periodically by the scheduler.
+func (uc *WalletWorkerUseCase) DeliverWebhooks(ctx context.Context) error {
+	// The deliveries are kept until the sender is set
+	if uc.webhooks == nil {
+		return nil
+	}
+
+	deliveries, err := uc.repo.ClaimWebhookDeliveries(ctx, time.Now(), _webhookDeliveryLease, _webhookDeliveriesBatch)
+	if err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - DeliverWebhooks - w.repo.ClaimWebhookDeliveries: %w", err)
+	}
+
+	errs := make([]error, len(deliveries))
+
+	var wg sync.WaitGroup
+
+	for i := range deliveries {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = uc.deliverWebhook(ctx, &deliveries[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("WalletWorkerUseCase - DeliverWebhooks - uc.deliverWebhook: %w", err)
+	}
+
+	return nil
+}
+
+// Making the attempt of the delivery and saving its outcome.
+func (uc *WalletWorkerUseCase) deliverWebhook(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	statusCode, err := uc.webhooks.Send(ctx, delivery)
+	if err != nil {
+		delivery.Fail(time.Now(), statusCode, err.Error(), uc.webhookRetries)
+	} else {
+		delivery.Succeed(time.Now(), statusCode)
+	}
+
+	if err := uc.repo.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("w.repo.UpdateWebhookDelivery: %w", err)
+	}
+
+	return nil
+}
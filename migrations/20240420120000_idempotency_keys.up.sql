@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    wallet_id TEXT NOT NULL REFERENCES wallets(id),
+    key TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (wallet_id, key)
+);
@@ -0,0 +1,9 @@
+DROP VIEW IF EXISTS ledger_balances;
+
+DROP TABLE IF EXISTS postings;
+
+DROP FUNCTION IF EXISTS check_journal_entry_balance();
+
+DROP TABLE IF EXISTS journal_entries;
+
+DROP TABLE IF EXISTS ledger_accounts;
@@ -0,0 +1,75 @@
+CREATE TABLE IF NOT EXISTS ledger_accounts
+(
+    id TEXT PRIMARY KEY,
+    type TEXT NOT NULL CHECK (type IN ('wallet', 'system'))
+);
+
+-- System accounts for money entering and leaving the system
+INSERT INTO ledger_accounts (id, type)
+VALUES ('system:issuance', 'system'), ('system:redemption', 'system')
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS journal_entries
+(
+    id BIGSERIAL PRIMARY KEY,
+    time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    type TEXT NOT NULL
+);
+
+-- Debit postings have negative amount, credit postings have positive one
+CREATE TABLE IF NOT EXISTS postings
+(
+    id BIGSERIAL PRIMARY KEY,
+    entry_id BIGINT NOT NULL REFERENCES journal_entries(id),
+    account_id TEXT NOT NULL REFERENCES ledger_accounts(id) ON UPDATE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS postings_entry_id_idx ON postings (entry_id);
+
+CREATE INDEX IF NOT EXISTS postings_account_id_idx ON postings (account_id);
+
+-- Every journal entry must be balanced at the end of the db transaction
+CREATE OR REPLACE FUNCTION check_journal_entry_balance() RETURNS trigger AS $$
+BEGIN
+    IF (SELECT SUM(amount) FROM postings WHERE entry_id = NEW.entry_id) <> 0 THEN
+        RAISE EXCEPTION 'journal entry % is not balanced', NEW.entry_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE PLPGSQL;
+
+DROP TRIGGER IF EXISTS postings_balance_check ON postings;
+
+CREATE CONSTRAINT TRIGGER postings_balance_check
+    AFTER INSERT ON postings
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_journal_entry_balance();
+
+-- Balances of the ledger accounts derived from the postings
+CREATE OR REPLACE VIEW ledger_balances AS
+SELECT a.id AS account_id, COALESCE(SUM(p.amount), 0) AS balance
+FROM ledger_accounts a
+LEFT JOIN postings p ON p.account_id = a.id
+GROUP BY a.id;
+
+-- Opening the ledger for the existing wallets with their current balances
+DO $$
+DECLARE
+    w RECORD;
+    new_entry_id BIGINT;
+BEGIN
+    FOR w IN
+        SELECT id, balance FROM wallets
+        WHERE NOT EXISTS (SELECT 1 FROM ledger_accounts WHERE ledger_accounts.id = wallets.id)
+    LOOP
+        INSERT INTO ledger_accounts (id, type) VALUES (w.id, 'wallet');
+
+        IF w.balance > 0 THEN
+            INSERT INTO journal_entries (type) VALUES ('opening') RETURNING id INTO new_entry_id;
+            INSERT INTO postings (entry_id, account_id, amount)
+            VALUES (new_entry_id, 'system:issuance', -w.balance), (new_entry_id, w.id, w.balance);
+        END IF;
+    END LOOP;
+END;
+$$;
@@ -0,0 +1,10 @@
+UPDATE ledger_accounts SET id = regexp_replace(id, ':[A-Z]{3}$', '')
+WHERE type = 'system' AND currency = 'USD';
+
+DELETE FROM ledger_accounts WHERE type = 'system' AND id LIKE 'system:%:%';
+
+ALTER TABLE ledger_accounts DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE wallets DROP COLUMN IF EXISTS currency;
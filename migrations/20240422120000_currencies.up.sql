@@ -0,0 +1,9 @@
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE ledger_accounts ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD';
+
+-- System accounts are opened for every currency separately
+UPDATE ledger_accounts SET id = id || ':' || currency
+WHERE type = 'system' AND id NOT LIKE 'system:%:%';
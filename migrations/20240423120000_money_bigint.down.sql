@@ -0,0 +1,3 @@
+ALTER TABLE transactions ALTER COLUMN amount TYPE INTEGER;
+
+ALTER TABLE wallets ALTER COLUMN balance TYPE INTEGER;
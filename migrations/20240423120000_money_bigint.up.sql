@@ -0,0 +1,3 @@
+ALTER TABLE wallets ALTER COLUMN balance TYPE BIGINT;
+
+ALTER TABLE transactions ALTER COLUMN amount TYPE BIGINT;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS holds;
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_held_check;
+ALTER TABLE wallets DROP COLUMN IF EXISTS held;
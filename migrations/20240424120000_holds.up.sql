@@ -0,0 +1,21 @@
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS held BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_held_check;
+ALTER TABLE wallets ADD CONSTRAINT wallets_held_check CHECK (held >= 0 AND held <= balance);
+
+CREATE TABLE IF NOT EXISTS holds
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wallet_id TEXT NOT NULL REFERENCES wallets(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    status TEXT NOT NULL DEFAULT 'authorized'
+        CHECK (status IN ('authorized', 'captured', 'voided', 'expired')),
+    captured_to TEXT REFERENCES wallets(id),
+    captured_amount BIGINT CHECK (captured_amount > 0 AND captured_amount <= amount),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS holds_wallet_id_idx ON holds (wallet_id);
+CREATE INDEX IF NOT EXISTS holds_expires_at_idx ON holds (expires_at) WHERE status = 'authorized';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS transactions_parent_id_idx;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS parent_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS type;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_pkey;
+ALTER TABLE transactions DROP COLUMN IF EXISTS id;
@@ -0,0 +1,18 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS id UUID NOT NULL DEFAULT gen_random_uuid();
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'transactions_pkey') THEN
+        ALTER TABLE transactions ADD CONSTRAINT transactions_pkey PRIMARY KEY (id);
+    END IF;
+END $$;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'transfer';
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check CHECK (type IN ('transfer', 'refund'));
+
+-- Refunds are linked to the original transfer
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES transactions(id);
+
+CREATE INDEX IF NOT EXISTS transactions_parent_id_idx ON transactions (parent_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transactions_from_wallet_id_time_idx;
+
+DROP TABLE IF EXISTS wallet_limits;
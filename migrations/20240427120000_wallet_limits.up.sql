@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS wallet_limits
+(
+    wallet_id TEXT PRIMARY KEY REFERENCES wallets(id),
+    per_transaction BIGINT NOT NULL DEFAULT 0 CHECK (per_transaction >= 0),
+    daily BIGINT NOT NULL DEFAULT 0 CHECK (daily >= 0),
+    monthly BIGINT NOT NULL DEFAULT 0 CHECK (monthly >= 0)
+);
+
+-- Outgoing transfers of the wallet are summed over the rolling windows
+CREATE INDEX IF NOT EXISTS transactions_from_wallet_id_time_idx ON transactions (from_wallet_id, time);
@@ -0,0 +1,14 @@
+DELETE FROM transactions WHERE type IN ('deposit', 'withdrawal');
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_wallets_check;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check CHECK (type IN ('transfer', 'refund', 'sweep'));
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS provider_reference;
+ALTER TABLE transactions DROP COLUMN IF EXISTS provider;
+ALTER TABLE transactions DROP COLUMN IF EXISTS status;
+
+ALTER TABLE transactions ALTER COLUMN to_wallet_id SET NOT NULL;
+ALTER TABLE transactions ALTER COLUMN from_wallet_id SET NOT NULL;
@@ -0,0 +1,23 @@
+-- Deposits have no sender and withdrawals have no receiver wallet
+ALTER TABLE transactions ALTER COLUMN from_wallet_id DROP NOT NULL;
+ALTER TABLE transactions ALTER COLUMN to_wallet_id DROP NOT NULL;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'completed';
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS provider TEXT;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS provider_reference TEXT;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_status_check CHECK (status IN ('pending', 'completed', 'failed'));
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('transfer', 'refund', 'sweep', 'deposit', 'withdrawal'));
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_wallets_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_wallets_check CHECK (
+    CASE type
+        WHEN 'deposit' THEN from_wallet_id IS NULL AND to_wallet_id IS NOT NULL
+        WHEN 'withdrawal' THEN from_wallet_id IS NOT NULL AND to_wallet_id IS NULL
+        ELSE from_wallet_id IS NOT NULL AND to_wallet_id IS NOT NULL
+    END
+);
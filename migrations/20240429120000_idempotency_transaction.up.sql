@@ -0,0 +1,2 @@
+-- Repeated request with the same key returns the original transaction
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS transaction_id UUID REFERENCES transactions(id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS transactions_reference_idx;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS metadata;
+ALTER TABLE transactions DROP COLUMN IF EXISTS reference;
+ALTER TABLE transactions DROP COLUMN IF EXISTS description;
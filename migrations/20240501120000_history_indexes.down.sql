@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS transactions_to_wallet_id_time_id_idx;
+DROP INDEX IF EXISTS transactions_from_wallet_id_time_id_idx;
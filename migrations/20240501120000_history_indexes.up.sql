@@ -0,0 +1,3 @@
+-- Keyset pagination of the wallet history sorted by time and id in both directions
+CREATE INDEX IF NOT EXISTS transactions_from_wallet_id_time_id_idx ON transactions (from_wallet_id, time DESC, id DESC);
+CREATE INDEX IF NOT EXISTS transactions_to_wallet_id_time_id_idx ON transactions (to_wallet_id, time DESC, id DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS journal_entries_time_idx;
+
+DROP TABLE IF EXISTS wallet_balance_snapshots;
@@ -0,0 +1,11 @@
+-- Balances of the wallets saved periodically to restore the balances in the past
+CREATE TABLE IF NOT EXISTS wallet_balance_snapshots
+(
+    wallet_id TEXT NOT NULL REFERENCES wallets(id),
+    time TIMESTAMP WITH TIME ZONE NOT NULL,
+    balance BIGINT NOT NULL,
+    PRIMARY KEY (wallet_id, time)
+);
+
+-- Postings of the wallet are summed over the period after the snapshot
+CREATE INDEX IF NOT EXISTS journal_entries_time_idx ON journal_entries (time);
@@ -0,0 +1,11 @@
+DELETE FROM transactions WHERE type = 'fee';
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('transfer', 'refund', 'sweep', 'deposit', 'withdrawal'));
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_fee_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS fee;
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_type_check;
+ALTER TABLE wallets DROP COLUMN IF EXISTS type;
@@ -0,0 +1,15 @@
+-- Transfer fees are chosen by the type of the sender wallet
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'personal';
+
+ALTER TABLE wallets DROP CONSTRAINT IF EXISTS wallets_type_check;
+ALTER TABLE wallets ADD CONSTRAINT wallets_type_check CHECK (type IN ('personal', 'business', 'merchant'));
+
+-- The fee is stored with the transfer and charged by the linked fee transaction
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fee BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_fee_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_fee_check CHECK (fee >= 0);
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('transfer', 'refund', 'sweep', 'deposit', 'withdrawal', 'fee'));
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS customers
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type TEXT NOT NULL CHECK (type IN ('individual', 'company')),
+    name TEXT NOT NULL CHECK (name <> ''),
+    email TEXT,
+    phone TEXT,
+    country CHAR(2),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every wallet belongs to one customer at most, the existing wallets stay anonymous
+ALTER TABLE wallets ADD COLUMN IF NOT EXISTS owner_id UUID REFERENCES customers(id);
+
+CREATE INDEX IF NOT EXISTS wallets_owner_id_idx ON wallets (owner_id);
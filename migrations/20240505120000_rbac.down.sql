@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS audit_events;
+
+DROP TABLE IF EXISTS role_assignments;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS roles
+(
+    name VARCHAR(64) PRIMARY KEY CHECK (name ~ '^[a-z0-9_-]+$'),
+    permissions TEXT[] NOT NULL CHECK (cardinality(permissions) > 0)
+);
+
+CREATE TABLE IF NOT EXISTS role_assignments
+(
+    subject VARCHAR(255) NOT NULL,
+    role VARCHAR(64) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (subject, role)
+);
+
+-- Built-in roles, which can be changed through the admin api
+INSERT INTO roles (name, permissions) VALUES
+    ('support', ARRAY['wallet:read', 'wallet:freeze']),
+    ('auditor', ARRAY['wallet:read', 'audit:read']),
+    ('administrator', ARRAY['wallet:read', 'wallet:freeze', 'wallet:close', 'ledger:adjust', 'audit:read', 'roles:manage'])
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id BIGSERIAL PRIMARY KEY,
+    time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    actor VARCHAR(255) NOT NULL,
+    action TEXT NOT NULL,
+    resource TEXT NOT NULL,
+    outcome VARCHAR(16) NOT NULL,
+    reason TEXT
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_time_idx ON audit_events (time);
@@ -0,0 +1,5 @@
+UPDATE roles
+SET permissions = array_remove(permissions, 'ledger:reconcile')
+WHERE name = 'administrator';
+
+DROP TABLE IF EXISTS reconciliation_reports;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS reconciliation_reports
+(
+    id BIGSERIAL PRIMARY KEY,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    wallets_checked INTEGER NOT NULL,
+    drifted_wallets INTEGER NOT NULL,
+    drifts JSONB NOT NULL DEFAULT '[]'
+);
+
+-- The administrators are allowed to start the reconciliation
+UPDATE roles
+SET permissions = array_append(permissions, 'ledger:reconcile')
+WHERE name = 'administrator' AND NOT ('ledger:reconcile' = ANY (permissions));
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS reject_audit_events_change();
+
+DROP INDEX IF EXISTS audit_events_request_id_idx;
+DROP INDEX IF EXISTS audit_events_actor_idx;
+
+ALTER TABLE audit_events DROP COLUMN IF EXISTS payload;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS ip;
+ALTER TABLE audit_events DROP COLUMN IF EXISTS request_id;
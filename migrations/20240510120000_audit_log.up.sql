@@ -0,0 +1,23 @@
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS request_id VARCHAR(64);
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS ip VARCHAR(45);
+ALTER TABLE audit_events ADD COLUMN IF NOT EXISTS payload JSONB;
+
+CREATE INDEX IF NOT EXISTS audit_events_actor_idx ON audit_events (actor, id);
+CREATE INDEX IF NOT EXISTS audit_events_request_id_idx ON audit_events (request_id) WHERE request_id IS NOT NULL;
+
+-- The audit log is append-only: the written events can not be changed or removed
+CREATE OR REPLACE FUNCTION reject_audit_events_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE PLPGSQL;
+
+DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_events_change();
+
+DROP TRIGGER IF EXISTS audit_events_no_truncate ON audit_events;
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_events_change();
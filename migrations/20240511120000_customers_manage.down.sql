@@ -0,0 +1,3 @@
+UPDATE roles
+SET permissions = array_remove(permissions, 'customers:manage')
+WHERE name = 'administrator';
@@ -0,0 +1,4 @@
+-- The administrators are allowed to onboard the customers and to open their wallets
+UPDATE roles
+SET permissions = array_append(permissions, 'customers:manage')
+WHERE name = 'administrator' AND NOT ('customers:manage' = ANY (permissions));
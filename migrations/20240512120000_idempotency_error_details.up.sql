@@ -0,0 +1,2 @@
+-- Details of the failed outcome, e.g. the remaining allowance of the exceeded limit
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS error_details TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,19 @@
+-- Time the account is opened in the ledger. The transactions booked before it are already in the opening balance.
+-- The existing accounts are opened by their first posting, the accounts without postings have no later transactions
+ALTER TABLE ledger_accounts ADD COLUMN IF NOT EXISTS opened_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE ledger_accounts AS a
+SET opened_at = COALESCE(
+    (
+        SELECT MIN(e.time)
+        FROM postings AS p
+        JOIN journal_entries AS e ON e.id = p.entry_id
+        WHERE p.account_id = a.id
+    ),
+    CURRENT_TIMESTAMP
+)
+WHERE a.opened_at IS NULL;
+
+ALTER TABLE ledger_accounts
+    ALTER COLUMN opened_at SET DEFAULT CURRENT_TIMESTAMP,
+    ALTER COLUMN opened_at SET NOT NULL;
@@ -0,0 +1 @@
+-- The removed accounts had no postings, there is nothing to restore
@@ -0,0 +1,10 @@
+-- The replayed ledger migration could leave the system accounts without the currency suffix.
+-- They are renamed, if the account of the currency is not opened yet
+UPDATE ledger_accounts AS a SET id = a.id || ':' || a.currency
+WHERE a.type = 'system' AND a.id NOT LIKE 'system:%:%'
+    AND NOT EXISTS (SELECT 1 FROM ledger_accounts AS b WHERE b.id = a.id || ':' || a.currency);
+
+-- The rest of them are not used
+DELETE FROM ledger_accounts AS a
+WHERE a.type = 'system' AND a.id NOT LIKE 'system:%:%'
+    AND NOT EXISTS (SELECT 1 FROM postings AS p WHERE p.account_id = a.id);
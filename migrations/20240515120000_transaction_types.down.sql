@@ -0,0 +1 @@
+-- The same check is defined by the fees migration, there is nothing to revert
@@ -0,0 +1,5 @@
+-- The transaction types are checked by the full list of them:
+-- the sweeps of the closed wallets, deposits, withdrawals and fees included
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('transfer', 'refund', 'sweep', 'deposit', 'withdrawal', 'fee'));
@@ -0,0 +1,277 @@
+// Package jwt implements verification of the JSON Web Tokens signed with a shared secret (HS256, HS384, HS512)
+// or with the public keys of the JSON Web Key Set (RS256, RS384, RS512, ES256, ES384, ES512).
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed      = errors.New("malformed token")
+	ErrUnsupportedAlg = errors.New("unsupported signing algorithm")
+	ErrUnknownKey     = errors.New("unknown signing key")
+	ErrSignature      = errors.New("invalid token signature")
+	ErrExpired        = errors.New("token is expired")
+	ErrNotValidYet    = errors.New("token is not valid yet")
+	ErrWrongIssuer    = errors.New("wrong token issuer")
+	ErrWrongAudience  = errors.New("wrong token audience")
+)
+
+// Claims - registered claims of the token and the scopes of the access token.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	// Scopes are set as the space separated string or as the list.
+	Scope  string   `json:"scope"`
+	Scopes []string `json:"scp"`
+}
+
+// Getting all scopes of the token.
+func (c *Claims) AllScopes() []string {
+	return append(strings.Fields(c.Scope), c.Scopes...)
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type Verifier struct {
+	keys     map[string]interface{}
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+// Creating the verifier of the tokens signed with the shared secret.
+func NewHMAC(secret []byte, opts ...Option) (*Verifier, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("jwt - NewHMAC: empty secret")
+	}
+
+	return newVerifier(map[string]interface{}{"": secret}, opts), nil
+}
+
+// Creating the verifier of the tokens signed with the keys of the JSON Web Key Set.
+func NewJWKS(data []byte, opts ...Option) (*Verifier, error) {
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("jwt - NewJWKS - parseJWKS: %w", err)
+	}
+
+	return newVerifier(keys, opts), nil
+}
+
+func newVerifier(keys map[string]interface{}, opts []Option) *Verifier {
+	v := &Verifier{
+		keys: keys,
+		now:  time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verifying the signature and the registered claims of the token.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	if err = v.verifySignature(h, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+
+	if err = v.validate(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *Verifier) verifySignature(h header, signed string, signature []byte) error {
+	// The algorithm is the family and the size of the hash, e.g. RS256
+	if len(h.Alg) != 5 {
+		return ErrUnsupportedAlg
+	}
+
+	hash, ok := hashes[h.Alg[2:]]
+	if !ok {
+		return ErrUnsupportedAlg
+	}
+
+	key, err := v.key(h.Kid)
+	if err != nil {
+		return err
+	}
+
+	digest := hash.New()
+	digest.Write([]byte(signed))
+
+	// The algorithm family must match the type of the key
+	switch key := key.(type) {
+	case []byte:
+		if !strings.HasPrefix(h.Alg, "HS") {
+			return ErrUnsupportedAlg
+		}
+
+		mac := hmac.New(hash.New, key)
+		mac.Write([]byte(signed))
+
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return ErrSignature
+		}
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(h.Alg, "RS") {
+			return ErrUnsupportedAlg
+		}
+
+		if rsa.VerifyPKCS1v15(key, hash, digest.Sum(nil), signature) != nil {
+			return ErrSignature
+		}
+	case *ecdsa.PublicKey:
+		// Each ES algorithm is bound to its own curve
+		if ecdsaAlgs[key.Curve.Params().Name] != h.Alg {
+			return ErrUnsupportedAlg
+		}
+
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return ErrSignature
+		}
+
+		r, s := new(big.Int).SetBytes(signature[:size]), new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(key, digest.Sum(nil), r, s) {
+			return ErrSignature
+		}
+	default:
+		return ErrUnsupportedAlg
+	}
+
+	return nil
+}
+
+// Getting the key by ID. The only key is used for the tokens without the key ID.
+func (v *Verifier) key(kid string) (interface{}, error) {
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+
+	return nil, ErrUnknownKey
+}
+
+func (v *Verifier) validate(claims *Claims) error {
+	now := v.now()
+
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)) {
+		return ErrExpired
+	}
+
+	if claims.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return ErrNotValidYet
+	}
+
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return ErrWrongIssuer
+	}
+
+	if v.audience != "" && !claims.Audience.contains(v.audience) {
+		return ErrWrongAudience
+	}
+
+	return nil
+}
+
+var ecdsaAlgs = map[string]string{
+	"P-256": "ES256",
+	"P-384": "ES384",
+	"P-521": "ES512",
+}
+
+var hashes = map[string]crypto.Hash{
+	"256": crypto.SHA256,
+	"384": crypto.SHA384,
+	"512": crypto.SHA512,
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrMalformed
+	}
+
+	if err = json.Unmarshal(data, v); err != nil {
+		return ErrMalformed
+	}
+
+	return nil
+}
+
+// Audience of the token is set as the string or as the list.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	*a = list
+
+	return nil
+}
+
+func (a audience) contains(value string) bool {
+	for _, aud := range a {
+		if aud == value {
+			return true
+		}
+	}
+
+	return false
+}
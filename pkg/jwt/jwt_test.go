@@ -0,0 +1,258 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+var (
+	testNow    = time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC)
+	testSecret = []byte("secret")
+)
+
+func encode(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHMAC(header, claims map[string]interface{}, secret []byte) string {
+	signed := encode(header) + "." + encode(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRSA(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	signed := encode(map[string]interface{}{"alg": "RS256", "kid": kid}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signECDSA(t *testing.T, key *ecdsa.PrivateKey, alg, kid string, claims map[string]interface{}) string {
+	signed := encode(map[string]interface{}{"alg": alg, "kid": kid}) + "." + encode(claims)
+	digest := hashes[alg[2:]].New()
+	digest.Write([]byte(signed))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest.Sum(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := (key.Curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	r.FillBytes(signature[:size])
+	s.FillBytes(signature[size:])
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21",
+		"iss":   "https://auth.example.com",
+		"aud":   []string{"wallet", "other"},
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"scope": "wallet:send admin",
+	}
+}
+
+func Test_VerifyHMAC(t *testing.T) {
+	for _, test := range testsVerifyHMAC {
+		t.Run(test.name, func(t *testing.T) {
+			claims := validClaims()
+			test.modify(claims)
+
+			verifier, err := NewHMAC(testSecret, Issuer("https://auth.example.com"), Audience("wallet"), Leeway(time.Minute))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			verifier.now = func() time.Time { return testNow }
+
+			result, err := verifier.Verify(signHMAC(map[string]interface{}{"alg": test.alg}, claims, test.secret))
+			if !errors.Is(err, test.expectedError) {
+				t.Fatalf("expected %v, got %v", test.expectedError, err)
+			}
+
+			if err == nil {
+				assert.Equal(t, result.Subject, "9b2f4a36-8c0e-4b8e-9a55-0c7c1d9f5e21")
+				assert.Equal(t, result.AllScopes(), []string{"wallet:send", "admin"})
+			}
+		})
+	}
+}
+
+var testsVerifyHMAC = []struct {
+	name          string
+	alg           string
+	secret        []byte
+	modify        func(claims map[string]interface{})
+	expectedError error
+}{
+	{
+		name:          "Ok",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(map[string]interface{}) {},
+		expectedError: nil,
+	},
+	{
+		name:          "Ok - expired within leeway",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { c["exp"] = testNow.Add(-30 * time.Second).Unix() },
+		expectedError: nil,
+	},
+	{
+		name:          "Wrong secret",
+		alg:           "HS256",
+		secret:        []byte("other"),
+		modify:        func(map[string]interface{}) {},
+		expectedError: ErrSignature,
+	},
+	{
+		name:          "Algorithm none",
+		alg:           "none",
+		secret:        testSecret,
+		modify:        func(map[string]interface{}) {},
+		expectedError: ErrUnsupportedAlg,
+	},
+	{
+		name:          "Expired",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { c["exp"] = testNow.Add(-time.Hour).Unix() },
+		expectedError: ErrExpired,
+	},
+	{
+		name:          "Without expiration",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { delete(c, "exp") },
+		expectedError: ErrExpired,
+	},
+	{
+		name:          "Not valid yet",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { c["nbf"] = testNow.Add(time.Hour).Unix() },
+		expectedError: ErrNotValidYet,
+	},
+	{
+		name:          "Wrong issuer",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { c["iss"] = "https://evil.example.com" },
+		expectedError: ErrWrongIssuer,
+	},
+	{
+		name:          "Wrong audience",
+		alg:           "HS256",
+		secret:        testSecret,
+		modify:        func(c map[string]interface{}) { c["aud"] = "other" },
+		expectedError: ErrWrongAudience,
+	},
+}
+
+func Test_VerifyJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys":[
+		{"kty":"RSA","kid":"rsa","use":"sig","n":%q,"e":%q},
+		{"kty":"EC","kid":"ec","crv":"P-256","x":%q,"y":%q},
+		{"kty":"RSA","kid":"enc","use":"enc","n":"AQ","e":"AQ"}
+	]}`,
+		base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		base64.RawURLEncoding.EncodeToString(ecKey.X.Bytes()),
+		base64.RawURLEncoding.EncodeToString(ecKey.Y.Bytes()),
+	)
+
+	verifier, err := NewJWKS([]byte(jwks))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier.now = func() time.Time { return testNow }
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		expectedError error
+	}{
+		{name: "Ok - RSA", token: signRSA(t, rsaKey, "rsa", validClaims())},
+		{name: "Ok - ECDSA", token: signECDSA(t, ecKey, "ES256", "ec", validClaims())},
+		{name: "Curve of other alg", token: signECDSA(t, ecKey, "ES384", "ec", validClaims()), expectedError: ErrUnsupportedAlg},
+		{name: "Unknown key", token: signRSA(t, rsaKey, "other", validClaims()), expectedError: ErrUnknownKey},
+		{name: "Wrong key", token: signRSA(t, otherKey, "rsa", validClaims()), expectedError: ErrSignature},
+		{name: "Key of other type", token: signRSA(t, rsaKey, "ec", validClaims()), expectedError: ErrUnsupportedAlg},
+		{
+			name:          "HMAC with public key",
+			token:         signHMAC(map[string]interface{}{"alg": "HS256", "kid": "rsa"}, validClaims(), rsaKey.N.Bytes()),
+			expectedError: ErrUnsupportedAlg,
+		},
+		{name: "Malformed", token: "not.a.token", expectedError: ErrMalformed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := verifier.Verify(test.token); !errors.Is(err, test.expectedError) {
+				t.Errorf("expected %v, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
+
+func Test_NewJWKS_WithoutSigningKeys(t *testing.T) {
+	if _, err := NewJWKS([]byte(`{"keys":[]}`)); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
+
+func Test_NewJWKS_ShortRSAKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"rsa","n":%q,"e":"AQAB"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()))
+
+	if _, err := NewJWKS([]byte(jwks)); err == nil {
+		t.Error("expected error, got nil")
+	}
+}
@@ -0,0 +1,28 @@
+package jwt
+
+import (
+	"time"
+)
+
+type Option func(*Verifier)
+
+// Issuer - the expected value of the "iss" claim.
+func Issuer(issuer string) Option {
+	return func(v *Verifier) {
+		v.issuer = issuer
+	}
+}
+
+// Audience - the value, which the "aud" claim must contain.
+func Audience(audience string) Option {
+	return func(v *Verifier) {
+		v.audience = audience
+	}
+}
+
+// Leeway - the allowed clock skew of the time claims.
+func Leeway(leeway time.Duration) Option {
+	return func(v *Verifier) {
+		v.leeway = leeway
+	}
+}
@@ -4,14 +4,23 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/go-pg/pg/v10"
 )
 
-const _defaultMaxPoolSize = 2
+const (
+	_defaultMaxPoolSize = 2
+	// Key of the advisory lock, which serializes the migrations of the replicas starting at once.
+	_migrationsLockKey = 7180251
+)
 
 var ErrNoRows = pg.ErrNoRows
 
+var In = pg.In
+
+type Tx = pg.Tx
+
 type Postgres struct {
 	maxPoolSize int
 	DB          *pg.DB
@@ -45,15 +54,48 @@ func New(url string, opts ...Option) (*Postgres, error) {
 	return res, nil
 }
 
+// Migrate - applying the migration file once. The applied files are recorded by their names
+// in schema_migrations, in the same db transaction as the migration itself.
 func (pg *Postgres) Migrate(filePath string) error {
 	c, err := os.ReadFile(filePath)
 	if err != nil {
 		return fmt.Errorf("postgres - Migrate - os.ReadFile: %w", err)
 	}
 
-	_, err = pg.DB.Exec(string(c))
+	ctx := context.Background()
+
+	err = pg.DB.RunInTransaction(ctx, func(tx *Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(?)", _migrationsLockKey); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		_, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations
+(
+    name TEXT PRIMARY KEY,
+    applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`)
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		res, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (name) VALUES (?) ON CONFLICT DO NOTHING",
+			filepath.Base(filePath))
+		if err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+		// The migration is already applied
+		if res.RowsAffected() == 0 {
+			return nil
+		}
+
+		if _, err := tx.ExecContext(ctx, string(c)); err != nil {
+			return fmt.Errorf("tx: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("postgres - Migrate - pg.DB.Exec: %w", err)
+		return fmt.Errorf("postgres - Migrate - pg.DB.RunInTransaction: %w", err)
 	}
 
 	return nil
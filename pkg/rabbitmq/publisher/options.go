@@ -0,0 +1,24 @@
+package publisher
+
+import "time"
+
+type Option func(*Publisher)
+
+// Timeout - the time of waiting for the confirmation of the message.
+func Timeout(timeout time.Duration) Option {
+	return func(p *Publisher) {
+		p.timeout = timeout
+	}
+}
+
+func ConnWaitTime(timeout time.Duration) Option {
+	return func(p *Publisher) {
+		p.waitTime = timeout
+	}
+}
+
+func ConnAttempts(attempts int) Option {
+	return func(p *Publisher) {
+		p.attempts = attempts
+	}
+}
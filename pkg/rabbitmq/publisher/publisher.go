@@ -0,0 +1,207 @@
+// Package publisher implements publishing of the messages to the topic exchange with the publisher confirms.
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+var (
+	ErrNotConfirmed = errors.New("rmq publisher - message is not confirmed by the broker")
+	ErrClosed       = errors.New("rmq publisher - publisher is closed")
+)
+
+const (
+	_defaultWaitTime = 2 * time.Second
+	_defaultAttempts = 10
+	_defaultTimeout  = 5 * time.Second
+)
+
+type Message struct {
+	RoutingKey  string
+	MessageID   string
+	Type        string
+	ContentType string
+	Timestamp   time.Time
+	Body        []byte
+}
+
+// Publisher - the publisher to the durable topic exchange. Every message is persistent
+// and the publishing returns only when the message is confirmed by the broker.
+// The connection is reopened at the next publishing, if it is lost.
+type Publisher struct {
+	url      string
+	exchange string
+
+	waitTime time.Duration
+	attempts int
+	timeout  time.Duration
+
+	mu       sync.Mutex
+	conn     *amqp.Connection
+	channel  *amqp.Channel
+	confirms chan amqp.Confirmation
+	closed   bool
+}
+
+func New(url, exchange string, opts ...Option) (*Publisher, error) {
+	p := &Publisher{
+		url:      url,
+		exchange: exchange,
+		waitTime: _defaultWaitTime,
+		attempts: _defaultAttempts,
+		timeout:  _defaultTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if err := p.attemptConnect(); err != nil {
+		return nil, fmt.Errorf("rmq publisher - New - p.attemptConnect: %w", err)
+	}
+
+	return p, nil
+}
+
+// Publish - publishing the message and waiting for its confirmation.
+func (p *Publisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	if p.conn == nil || p.conn.IsClosed() {
+		if err := p.connect(); err != nil {
+			return fmt.Errorf("rmq publisher - Publish - p.connect: %w", err)
+		}
+	}
+
+	err := p.channel.Publish(p.exchange, msg.RoutingKey, false, false, amqp.Publishing{
+		DeliveryMode: amqp.Persistent,
+		ContentType:  msg.ContentType,
+		MessageId:    msg.MessageID,
+		Type:         msg.Type,
+		Timestamp:    msg.Timestamp,
+		Body:         msg.Body,
+	})
+	if err != nil {
+		p.reset()
+		return fmt.Errorf("rmq publisher - Publish - p.channel.Publish: %w", err)
+	}
+
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+
+	select {
+	case confirm, ok := <-p.confirms:
+		if !ok {
+			p.reset()
+			return fmt.Errorf("rmq publisher - Publish: %w: channel is closed", ErrNotConfirmed)
+		}
+
+		if !confirm.Ack {
+			return fmt.Errorf("rmq publisher - Publish: %w: nack", ErrNotConfirmed)
+		}
+
+		return nil
+	case <-timer.C:
+		// The late confirmation can not be matched with the next message, so the channel is reopened
+		p.reset()
+		return fmt.Errorf("rmq publisher - Publish: %w: timeout", ErrNotConfirmed)
+	case <-ctx.Done():
+		p.reset()
+		return fmt.Errorf("rmq publisher - Publish: %w: %w", ErrNotConfirmed, ctx.Err())
+	}
+}
+
+// Close - closing the connection, the publisher can not be used after it.
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+
+	if p.conn == nil || p.conn.IsClosed() {
+		return nil
+	}
+
+	if err := p.conn.Close(); err != nil {
+		return fmt.Errorf("rmq publisher - Close - p.conn.Close: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Publisher) attemptConnect() error {
+	var err error
+	for i := p.attempts; i > 0; i-- {
+		if err = p.connect(); err == nil {
+			break
+		}
+
+		log.Printf("RabbitMQ publisher is trying to connect, attempts left: %d", i)
+		time.Sleep(p.waitTime)
+	}
+
+	return err
+}
+
+func (p *Publisher) connect() error {
+	p.reset()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return fmt.Errorf("amqp.Dial: %w", err)
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("conn.Channel: %w", err)
+	}
+
+	err = channel.ExchangeDeclare(
+		p.exchange,
+		"topic",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("channel.ExchangeDeclare: %w", err)
+	}
+
+	if err = channel.Confirm(false); err != nil {
+		conn.Close()
+		return fmt.Errorf("channel.Confirm: %w", err)
+	}
+
+	p.conn = conn
+	p.channel = channel
+	p.confirms = channel.NotifyPublish(make(chan amqp.Confirmation, 1))
+
+	return nil
+}
+
+// Dropping the connection, it is reopened at the next publishing.
+func (p *Publisher) reset() {
+	if p.conn != nil && !p.conn.IsClosed() {
+		p.conn.Close()
+	}
+
+	p.conn = nil
+	p.channel = nil
+	p.confirms = nil
+}
@@ -165,7 +165,10 @@ func (c *Client) handleCallStatus(call *pendingCall, response interface{}) error
 		return rmqrpc.ErrNotFound
 	}
 
-	return fmt.Errorf("%w: %v", rmqrpc.ErrCallStatus, call.status)
+	return &rmqrpc.CallError{
+		Status:  call.status,
+		Details: call.body,
+	}
 }
 
 func (c *Client) consumer() {
@@ -15,3 +15,23 @@ var (
 )
 
 const Success = "success"
+
+// DetailedError - the error, which details are passed to the client in the body of the response.
+type DetailedError interface {
+	error
+	Details() interface{}
+}
+
+// CallError - the failed call status with the details of the error.
+type CallError struct {
+	Status  string
+	Details []byte
+}
+
+func (e *CallError) Error() string {
+	return ErrCallStatus.Error() + ": " + e.Status
+}
+
+func (e *CallError) Unwrap() error {
+	return ErrCallStatus
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -97,7 +98,7 @@ func (s *Server) serveCall(d *amqp.Delivery) {
 
 	response, err := callHandler(d)
 	if err != nil {
-		s.publish(d, nil, err.Error())
+		s.publish(d, s.errorDetails(err), err.Error())
 
 		return
 	}
@@ -110,6 +111,21 @@ func (s *Server) serveCall(d *amqp.Delivery) {
 	s.publish(d, body, rmqrpc.Success)
 }
 
+// Getting the details of the error, which are passed in the body of the response.
+func (s *Server) errorDetails(err error) []byte {
+	var detailed rmqrpc.DetailedError
+	if !errors.As(err, &detailed) {
+		return nil
+	}
+
+	body, err := json.Marshal(detailed.Details())
+	if err != nil {
+		s.logger.Error("rmq_rpc server - Server - errorDetails - json.Marshal", sl.Err(err))
+	}
+
+	return body
+}
+
 func (s *Server) publish(d *amqp.Delivery, body []byte, status string) {
 	err := s.conn.Channel.Publish(d.ReplyTo, "", false, false,
 		amqp.Publishing{
@@ -0,0 +1,208 @@
+// Package subscriber implements consuming of the messages from the topic exchange by the routing keys.
+package subscriber
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	_defaultWaitTime = 2 * time.Second
+	_defaultAttempts = 10
+)
+
+// Handler - the handler of the message with its routing key and id.
+type Handler func(routingKey, messageID string, body []byte)
+
+// Subscriber - the consumer of the topic exchange. Every subscriber has its own exclusive queue,
+// so each replica of the application receives all messages of the binding keys.
+// The messages, which are published while the subscriber is disconnected, are lost.
+type Subscriber struct {
+	url         string
+	exchange    string
+	bindingKeys []string
+	handler     Handler
+
+	waitTime time.Duration
+	attempts int
+
+	mu       sync.Mutex
+	conn     *amqp.Connection
+	delivery <-chan amqp.Delivery
+
+	error    chan error
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func New(url, exchange string, bindingKeys []string, handler Handler, opts ...Option) (*Subscriber, error) {
+	s := &Subscriber{
+		url:         url,
+		exchange:    exchange,
+		bindingKeys: bindingKeys,
+		handler:     handler,
+		waitTime:    _defaultWaitTime,
+		attempts:    _defaultAttempts,
+		error:       make(chan error, 1),
+		stop:        make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if err := s.attemptConnect(); err != nil {
+		return nil, fmt.Errorf("rmq subscriber - New - s.attemptConnect: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *Subscriber) MustRun() {
+	go s.consumer()
+}
+
+func (s *Subscriber) consumer() {
+	for {
+		select {
+		case <-s.stop:
+			return
+		case d, opened := <-s.delivery:
+			if !opened {
+				if !s.reconnect() {
+					return
+				}
+
+				continue
+			}
+
+			s.handler(d.RoutingKey, d.MessageId, d.Body)
+		}
+	}
+}
+
+// Reconnecting after the loss of the connection. Returns false, if the subscriber is stopped.
+func (s *Subscriber) reconnect() bool {
+	select {
+	case <-s.stop:
+		return false
+	default:
+	}
+
+	if err := s.attemptConnect(); err != nil {
+		s.error <- err
+		close(s.error)
+
+		return false
+	}
+
+	return true
+}
+
+func (s *Subscriber) Notify() <-chan error {
+	return s.error
+}
+
+func (s *Subscriber) Shutdown() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil || s.conn.IsClosed() {
+		return nil
+	}
+
+	if err := s.conn.Close(); err != nil {
+		return fmt.Errorf("rmq subscriber - Shutdown - s.conn.Close: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Subscriber) attemptConnect() error {
+	var err error
+	for i := s.attempts; i > 0; i-- {
+		if err = s.connect(); err == nil {
+			break
+		}
+
+		log.Printf("RabbitMQ subscriber is trying to connect, attempts left: %d", i)
+		time.Sleep(s.waitTime)
+	}
+
+	return err
+}
+
+func (s *Subscriber) connect() error {
+	conn, err := amqp.Dial(s.url)
+	if err != nil {
+		return fmt.Errorf("amqp.Dial: %w", err)
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("conn.Channel: %w", err)
+	}
+
+	err = channel.ExchangeDeclare(
+		s.exchange,
+		"topic",
+		true,
+		false,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("channel.ExchangeDeclare: %w", err)
+	}
+
+	queue, err := channel.QueueDeclare(
+		"",
+		false,
+		true,
+		true,
+		false,
+		nil,
+	)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("channel.QueueDeclare: %w", err)
+	}
+
+	for _, key := range s.bindingKeys {
+		if err = channel.QueueBind(queue.Name, key, s.exchange, false, nil); err != nil {
+			conn.Close()
+			return fmt.Errorf("channel.QueueBind: %w", err)
+		}
+	}
+
+	delivery, err := channel.Consume(
+		queue.Name,
+		"",
+		true,
+		true,
+		false,
+		false,
+		nil,
+	)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("channel.Consume: %w", err)
+	}
+
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	s.delivery = delivery
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Package scheduler implements periodic running of background jobs.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	sl "github.com/egor-denisov/wallet-rielta/pkg/logger"
+)
+
+const _defaultShutdownTimeout = 5 * time.Second
+
+// Job - the function, which is called periodically.
+type Job func(ctx context.Context) error
+
+type job struct {
+	name   string
+	period time.Duration
+	run    Job
+}
+
+type Scheduler struct {
+	log             *slog.Logger
+	jobs            []job
+	shutdownTimeout time.Duration
+	stop            chan struct{}
+	wg              sync.WaitGroup
+}
+
+func New(log *slog.Logger) *Scheduler {
+	return &Scheduler{
+		log:             log,
+		shutdownTimeout: _defaultShutdownTimeout,
+		stop:            make(chan struct{}),
+	}
+}
+
+// Add - registering the job, which is called every period. It must be called before Run.
+func (s *Scheduler) Add(name string, period time.Duration, run Job) {
+	s.jobs = append(s.jobs, job{
+		name:   name,
+		period: period,
+		run:    run,
+	})
+}
+
+// Run - starting all jobs and blocking until the scheduler is stopped.
+func (s *Scheduler) Run() {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+
+		go s.loop(j)
+	}
+
+	s.log.Info("scheduler started", slog.Int("jobs", len(s.jobs)))
+
+	s.wg.Wait()
+}
+
+// Shutdown - stopping the jobs and waiting for the running ones.
+func (s *Scheduler) Shutdown() error {
+	const op = "scheduler.Shutdown"
+
+	close(s.stop)
+
+	done := make(chan struct{})
+
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(s.shutdownTimeout):
+		return fmt.Errorf("%s: %w", op, context.DeadlineExceeded)
+	}
+}
+
+// Calling the job every period until the scheduler is stopped.
+func (s *Scheduler) loop(j job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.runOnce(j)
+		}
+	}
+}
+
+// Calling the job once with the timeout of its period.
+func (s *Scheduler) runOnce(j job) {
+	ctx, cancel := context.WithTimeout(context.Background(), j.period)
+	defer cancel()
+
+	if err := j.run(ctx); err != nil {
+		s.log.Error("scheduler - job failed", slog.String("job", j.name), sl.Err(err))
+	}
+}